@@ -18,17 +18,27 @@ package aws
 
 import (
 	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
 	"sync"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/graph"
 )
 
@@ -44,6 +54,34 @@ type oncer struct {
 	err    error
 }
 
+// newIAMAPI returns the IAM client of the account of a session
+var newIAMAPI = func(sess *session.Session) iamiface.IAMAPI {
+	return iam.New(sess)
+}
+
+// accountRolesGraph returns the roles of the account of a service, fetched once per service
+// to resolve the roles referenced by ARN in its resources
+func accountRolesGraph(srv cloud.Service) (*graph.Graph, error) {
+	var once *oncer
+	var sess *session.Session
+	switch s := srv.(type) {
+	case *Lambda:
+		once, sess = &s.once, s.sess
+	case *Container:
+		once, sess = &s.once, s.sess
+	default:
+		return nil, fmt.Errorf("aws fetch: cannot resolve roles from service %T", srv)
+	}
+	once.Do(func() {
+		access := &Access{IAMAPI: newIAMAPI(sess)}
+		once.result, _, once.err = access.fetch_all_role_graph()
+	})
+	if once.err != nil {
+		return nil, once.err
+	}
+	return once.result.(*graph.Graph), nil
+}
+
 type security struct {
 	stsiface.STSAPI
 }
@@ -88,7 +126,7 @@ func (s *Access) fetch_all_user_graph() (*graph.Graph, []*iam.UserDetail, error)
 				for _, output := range out.UserDetailList {
 					userDetails = append(userDetails, output)
 					var res *graph.Resource
-					res, badResErr = newResource(output)
+					res, badResErr = newResource(s, output)
 					if badResErr != nil {
 						return false
 					}
@@ -112,7 +150,7 @@ func (s *Access) fetch_all_user_graph() (*graph.Graph, []*iam.UserDetail, error)
 
 		err := s.ListUsersPages(&iam.ListUsersInput{}, func(page *iam.ListUsersOutput, lastPage bool) bool {
 			for _, user := range page.Users {
-				res, badResErr := newResource(user)
+				res, badResErr := newResource(s, user)
 				if badResErr != nil {
 					return false
 				}
@@ -150,7 +188,7 @@ func (s *Storage) fetch_all_bucket_graph() (*graph.Graph, []*s3.Bucket, error) {
 		bucketM.Lock()
 		buckets = append(buckets, b)
 		bucketM.Unlock()
-		res, err := newResource(b)
+		res, err := newResource(s, b)
 		g.AddResource(res)
 		if err != nil {
 			return fmt.Errorf("build resource for bucket `%s`: %s", awssdk.StringValue(b.Name), err)
@@ -172,26 +210,28 @@ func (s *Storage) fetch_all_storageobject_graph() (*graph.Graph, []*s3.Object, e
 }
 
 func (s *Storage) fetchObjectsForBucket(bucket *s3.Bucket, g *graph.Graph) error {
-	out, err := s.ListObjects(&s3.ListObjectsInput{Bucket: bucket.Name})
+	var badResErr error
+	err := s.ListObjectsPages(&s3.ListObjectsInput{Bucket: bucket.Name}, func(out *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, output := range out.Contents {
+			var res *graph.Resource
+			if res, badResErr = newResource(s, output); badResErr != nil {
+				return false
+			}
+			res.Properties["BucketName"] = awssdk.StringValue(bucket.Name)
+			g.AddResource(res)
+			var parent *graph.Resource
+			if parent, badResErr = initResource(bucket); badResErr != nil {
+				return false
+			}
+			g.AddParentRelation(parent, res)
+		}
+		return true
+	})
 	if err != nil {
 		return err
 	}
 
-	for _, output := range out.Contents {
-		res, err := newResource(output)
-		if err != nil {
-			return err
-		}
-		res.Properties["BucketName"] = awssdk.StringValue(bucket.Name)
-		g.AddResource(res)
-		parent, err := initResource(bucket)
-		if err != nil {
-			return err
-		}
-		g.AddParentRelation(parent, res)
-	}
-
-	return nil
+	return badResErr
 }
 
 func (s *Storage) getBucketsPerRegion() ([]*s3.Bucket, error) {
@@ -311,6 +351,10 @@ func (s *Queue) fetch_all_queue_graph() (*graph.Graph, []*string, error) {
 				res.Properties[k] = awssdk.StringValue(v)
 			}
 			g.AddResource(res)
+			// queues encrypted server-side reference their KMS key (ex: alias/aws/sqs)
+			if err = addKeyRelation(g, awssdk.StringValue(attrs.Attributes["KmsMasterKeyId"]), res); err != nil {
+				errc <- err
+			}
 		}(output)
 
 	}
@@ -330,6 +374,138 @@ func (s *Queue) fetch_all_queue_graph() (*graph.Graph, []*string, error) {
 
 }
 
+// DNS
+
+// zoneRecords are the records of a zone, or the error listing them
+type zoneRecords struct {
+	zone    string
+	records []*route53.ResourceRecordSet
+	err     error
+}
+
+func (s *Dns) fetch_all_record_graph() (*graph.Graph, []*route53.ResourceRecordSet, error) {
+	g := graph.NewGraph()
+	var cloudResources []*route53.ResourceRecordSet
+	var zones []*route53.HostedZone
+	err := s.ListHostedZonesPages(&route53.ListHostedZonesInput{},
+		func(out *route53.ListHostedZonesOutput, lastPage bool) (shouldContinue bool) {
+			zones = append(zones, out.HostedZones...)
+			return out.NextMarker != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	// each zone sends once on the buffered channel: no goroutine is left blocked on an early return
+	resultc := make(chan zoneRecords, len(zones))
+	for _, zone := range zones {
+		go func(zone *route53.HostedZone) {
+			zr := zoneRecords{zone: awssdk.StringValue(zone.Id)}
+			zr.err = s.ListResourceRecordSetsPages(&route53.ListResourceRecordSetsInput{HostedZoneId: zone.Id},
+				func(out *route53.ListResourceRecordSetsOutput, lastPage bool) (shouldContinue bool) {
+					zr.records = append(zr.records, out.ResourceRecordSets...)
+					return awssdk.BoolValue(out.IsTruncated)
+				})
+			resultc <- zr
+		}(zone)
+	}
+
+	loadBalancers := make(map[string]map[string]string)
+	for range zones {
+		zr := <-resultc
+		if zr.err != nil {
+			return g, cloudResources, zr.err
+		}
+		for _, record := range zr.records {
+			cloudResources = append(cloudResources, record)
+			res, err := s.newRecordResource(zr.zone, record)
+			if err != nil {
+				return g, cloudResources, err
+			}
+			g.AddResource(res)
+			g.AddParentRelation(graph.InitResource(zr.zone, graph.Zone), res)
+
+			if alias := record.AliasTarget; alias != nil {
+				dnsName := normalizeDNSName(awssdk.StringValue(alias.DNSName))
+				region, ok := loadBalancerRegion(dnsName)
+				if !ok {
+					continue
+				}
+				if _, done := loadBalancers[region]; !done {
+					if loadBalancers[region], err = s.loadBalancersPerDNSName(region); err != nil {
+						return g, cloudResources, err
+					}
+				}
+				if arn, ok := loadBalancers[region][dnsName]; ok {
+					g.AddAppliesOnRelation(res, graph.InitResource(arn, graph.LoadBalancer))
+				}
+			}
+		}
+	}
+	return g, cloudResources, nil
+}
+
+// newRecordResource builds a record resource identified within its zone
+func (s *Dns) newRecordResource(zone string, record *route53.ResourceRecordSet) (*graph.Resource, error) {
+	props, err := newResource(s, record)
+	if err != nil {
+		return nil, err
+	}
+	res := graph.InitResource(recordId(zone, record), graph.Record)
+	res.Properties = props.Properties
+	res.Properties["Id"] = res.Id()
+	res.Properties["Zone"] = zone
+	return res, nil
+}
+
+func recordId(zone string, record *route53.ResourceRecordSet) string {
+	h := fnv.New64a()
+	for _, s := range []string{zone, awssdk.StringValue(record.Name), awssdk.StringValue(record.Type), awssdk.StringValue(record.SetIdentifier)} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("awls-%x", h.Sum64())
+}
+
+// newELBV2API returns the load balancing client of the account of a session in a region
+var newELBV2API = func(sess *session.Session, region string) elbv2iface.ELBV2API {
+	return elbv2.New(sess, &awssdk.Config{Region: awssdk.String(region)})
+}
+
+// loadBalancersPerDNSName returns the ARNs of the load balancers of a region per DNS name,
+// listed with the credentials of the account of the DNS service
+func (s *Dns) loadBalancersPerDNSName(region string) (map[string]string, error) {
+	arns := make(map[string]string)
+	err := newELBV2API(s.sess, region).DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{},
+		func(out *elbv2.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool) {
+			for _, lb := range out.LoadBalancers {
+				arns[normalizeDNSName(awssdk.StringValue(lb.DNSName))] = awssdk.StringValue(lb.LoadBalancerArn)
+			}
+			return out.NextMarker != nil
+		})
+	return arns, err
+}
+
+var loadBalancerRegionRegex = regexp.MustCompile(`\.([a-z]{2}(-gov)?-[a-z]+-\d)\.elb\.amazonaws\.com$|\.elb\.([a-z]{2}(-gov)?-[a-z]+-\d)\.amazonaws\.com$`)
+
+// loadBalancerRegion returns the region of a load balancer from its DNS name,
+// ex: lb-1-123456.eu-west-1.elb.amazonaws.com or lb-2-123456.elb.eu-west-1.amazonaws.com
+func loadBalancerRegion(dnsName string) (string, bool) {
+	m := loadBalancerRegionRegex.FindStringSubmatch(dnsName)
+	switch {
+	case m == nil:
+		return "", false
+	case m[1] != "":
+		return m[1], true
+	default:
+		return m[3], true
+	}
+}
+
+func normalizeDNSName(name string) string {
+	return strings.TrimPrefix(strings.TrimSuffix(strings.ToLower(name), "."), "dualstack.")
+}
+
 func (s *Infra) fetch_all_listener_graph() (*graph.Graph, []*elbv2.Listener, error) {
 	g := graph.NewGraph()
 	errc := make(chan error)
@@ -337,7 +513,7 @@ func (s *Infra) fetch_all_listener_graph() (*graph.Graph, []*elbv2.Listener, err
 	var wg sync.WaitGroup
 	var cloudResources []*elbv2.Listener
 
-	err := s.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{},
+	err := s.ELBV2API.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{},
 		func(out *elbv2.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool) {
 			wg.Add(1)
 			go func() {
@@ -377,7 +553,342 @@ func (s *Infra) fetch_all_listener_graph() (*graph.Graph, []*elbv2.Listener, err
 				return g, cloudResources, nil
 			}
 			cloudResources = append(cloudResources, listener)
-			res, err := newResource(listener)
+			res, err := newResource(s, listener)
+			if err != nil {
+				return g, cloudResources, err
+			}
+			g.AddResource(res)
+		}
+	}
+}
+
+// CONTAINER
+
+// ECS describes at most 100 clusters, tasks or container instances, and 10 services, per call
+const (
+	maxECSDescribe        = 100
+	maxECSServiceDescribe = 10
+)
+
+func (s *Container) listClusterArns() ([]*string, error) {
+	var arns []*string
+	err := s.ListClustersPages(&ecs.ListClustersInput{},
+		func(out *ecs.ListClustersOutput, lastPage bool) (shouldContinue bool) {
+			arns = append(arns, out.ClusterArns...)
+			return out.NextToken != nil
+		})
+	return arns, err
+}
+
+// chunksOf splits ARNs in slices of at most size elements, as expected by the ECS describe calls
+func chunksOf(arns []*string, size int) (chunks [][]*string) {
+	for len(arns) > size {
+		chunks = append(chunks, arns[:size])
+		arns = arns[size:]
+	}
+	if len(arns) > 0 {
+		chunks = append(chunks, arns)
+	}
+	return
+}
+
+func (s *Container) fetch_all_cluster_graph() (*graph.Graph, []*ecs.Cluster, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ecs.Cluster
+	arns, err := s.listClusterArns()
+	if err != nil {
+		return g, cloudResources, err
+	}
+	for _, chunk := range chunksOf(arns, maxECSDescribe) {
+		out, err := s.DescribeClusters(&ecs.DescribeClustersInput{Clusters: chunk})
+		if err != nil {
+			return g, cloudResources, err
+		}
+		for _, cluster := range out.Clusters {
+			cloudResources = append(cloudResources, cluster)
+			res, err := newResource(s, cluster)
+			if err != nil {
+				return g, cloudResources, err
+			}
+			g.AddResource(res)
+		}
+	}
+	return g, cloudResources, nil
+}
+
+func (s *Container) fetch_all_service_graph() (*graph.Graph, []*ecs.Service, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ecs.Service
+	clusters, err := s.listClusterArns()
+	if err != nil {
+		return g, cloudResources, err
+	}
+	for _, cluster := range clusters {
+		var arns []*string
+		err := s.ListServicesPages(&ecs.ListServicesInput{Cluster: cluster},
+			func(out *ecs.ListServicesOutput, lastPage bool) (shouldContinue bool) {
+				arns = append(arns, out.ServiceArns...)
+				return out.NextToken != nil
+			})
+		if err != nil {
+			return g, cloudResources, err
+		}
+		for _, chunk := range chunksOf(arns, maxECSServiceDescribe) {
+			out, err := s.DescribeServices(&ecs.DescribeServicesInput{Cluster: cluster, Services: chunk})
+			if err != nil {
+				return g, cloudResources, err
+			}
+			for _, service := range out.Services {
+				cloudResources = append(cloudResources, service)
+				res, err := newResource(s, service)
+				if err != nil {
+					return g, cloudResources, err
+				}
+				g.AddResource(res)
+			}
+		}
+	}
+	return g, cloudResources, nil
+}
+
+// fetch_all_taskdefinition_graph fetches the active revisions of the task definitions
+func (s *Container) fetch_all_taskdefinition_graph() (*graph.Graph, []*ecs.TaskDefinition, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ecs.TaskDefinition
+	var arns []*string
+	err := s.ListTaskDefinitionsPages(&ecs.ListTaskDefinitionsInput{Status: awssdk.String(ecs.TaskDefinitionStatusActive)},
+		func(out *ecs.ListTaskDefinitionsOutput, lastPage bool) (shouldContinue bool) {
+			arns = append(arns, out.TaskDefinitionArns...)
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	errc := make(chan error)
+	resultc := make(chan *ecs.TaskDefinition)
+	var wg sync.WaitGroup
+
+	for _, arn := range arns {
+		wg.Add(1)
+		go func(arn *string) {
+			defer wg.Done()
+			out, err := s.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{TaskDefinition: arn})
+			if err != nil {
+				errc <- err
+				return
+			}
+			resultc <- out.TaskDefinition
+		}(arn)
+	}
+
+	go func() {
+		wg.Wait()
+		close(resultc)
+	}()
+
+	for {
+		select {
+		case err := <-errc:
+			if err != nil {
+				return g, cloudResources, err
+			}
+		case def, ok := <-resultc:
+			if !ok {
+				return g, cloudResources, nil
+			}
+			cloudResources = append(cloudResources, def)
+			res, err := newResource(s, def)
+			if err != nil {
+				return g, cloudResources, err
+			}
+			g.AddResource(res)
+		}
+	}
+}
+
+func (s *Container) fetch_all_task_graph() (*graph.Graph, []*ecs.Task, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ecs.Task
+	clusters, err := s.listClusterArns()
+	if err != nil {
+		return g, cloudResources, err
+	}
+	for _, cluster := range clusters {
+		var arns []*string
+		err := s.ListTasksPages(&ecs.ListTasksInput{Cluster: cluster},
+			func(out *ecs.ListTasksOutput, lastPage bool) (shouldContinue bool) {
+				arns = append(arns, out.TaskArns...)
+				return out.NextToken != nil
+			})
+		if err != nil {
+			return g, cloudResources, err
+		}
+		for _, chunk := range chunksOf(arns, maxECSDescribe) {
+			out, err := s.DescribeTasks(&ecs.DescribeTasksInput{Cluster: cluster, Tasks: chunk})
+			if err != nil {
+				return g, cloudResources, err
+			}
+			for _, task := range out.Tasks {
+				cloudResources = append(cloudResources, task)
+				res, err := newResource(s, task)
+				if err != nil {
+					return g, cloudResources, err
+				}
+				g.AddResource(res)
+			}
+		}
+	}
+	return g, cloudResources, nil
+}
+
+// fetch_all_containerinstance_graph adds the container instances as children of their cluster,
+// which their description does not reference
+func (s *Container) fetch_all_containerinstance_graph() (*graph.Graph, []*ecs.ContainerInstance, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ecs.ContainerInstance
+	clusters, err := s.listClusterArns()
+	if err != nil {
+		return g, cloudResources, err
+	}
+	for _, cluster := range clusters {
+		var arns []*string
+		err := s.ListContainerInstancesPages(&ecs.ListContainerInstancesInput{Cluster: cluster},
+			func(out *ecs.ListContainerInstancesOutput, lastPage bool) (shouldContinue bool) {
+				arns = append(arns, out.ContainerInstanceArns...)
+				return out.NextToken != nil
+			})
+		if err != nil {
+			return g, cloudResources, err
+		}
+		for _, chunk := range chunksOf(arns, maxECSDescribe) {
+			out, err := s.DescribeContainerInstances(&ecs.DescribeContainerInstancesInput{Cluster: cluster, ContainerInstances: chunk})
+			if err != nil {
+				return g, cloudResources, err
+			}
+			for _, instance := range out.ContainerInstances {
+				cloudResources = append(cloudResources, instance)
+				res, err := newResource(s, instance)
+				if err != nil {
+					return g, cloudResources, err
+				}
+				res.Properties["Cluster"] = awssdk.StringValue(cluster)
+				g.AddResource(res)
+				g.AddParentRelation(graph.InitResource(awssdk.StringValue(cluster), graph.Cluster), res)
+			}
+		}
+	}
+	return g, cloudResources, nil
+}
+
+// NOSQL
+
+func (s *Nosql) fetch_all_table_graph() (*graph.Graph, []*dynamodb.TableDescription, error) {
+	g := graph.NewGraph()
+	var cloudResources []*dynamodb.TableDescription
+	var names []*string
+	err := s.ListTablesPages(&dynamodb.ListTablesInput{},
+		func(out *dynamodb.ListTablesOutput, lastPage bool) (shouldContinue bool) {
+			names = append(names, out.TableNames...)
+			return out.LastEvaluatedTableName != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	errc := make(chan error)
+	resultc := make(chan *dynamodb.TableDescription)
+	var wg sync.WaitGroup
+
+	for _, name := range names {
+		wg.Add(1)
+		go func(name *string) {
+			defer wg.Done()
+			out, err := s.DescribeTable(&dynamodb.DescribeTableInput{TableName: name})
+			if err != nil {
+				errc <- err
+				return
+			}
+			resultc <- out.Table
+		}(name)
+	}
+
+	go func() {
+		wg.Wait()
+		close(resultc)
+	}()
+
+	for {
+		select {
+		case err := <-errc:
+			if err != nil {
+				return g, cloudResources, err
+			}
+		case table, ok := <-resultc:
+			if !ok {
+				return g, cloudResources, nil
+			}
+			cloudResources = append(cloudResources, table)
+			res, err := newResource(s, table)
+			if err != nil {
+				return g, cloudResources, err
+			}
+			g.AddResource(res)
+		}
+	}
+}
+
+// ENCRYPTION
+
+func (s *Encryption) fetch_all_key_graph() (*graph.Graph, []*kms.KeyMetadata, error) {
+	g := graph.NewGraph()
+	var cloudResources []*kms.KeyMetadata
+	var ids []*string
+	err := s.ListKeysPages(&kms.ListKeysInput{},
+		func(out *kms.ListKeysOutput, lastPage bool) (shouldContinue bool) {
+			for _, key := range out.Keys {
+				ids = append(ids, key.KeyId)
+			}
+			return out.NextMarker != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	errc := make(chan error)
+	resultc := make(chan *kms.KeyMetadata)
+	var wg sync.WaitGroup
+
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id *string) {
+			defer wg.Done()
+			out, err := s.DescribeKey(&kms.DescribeKeyInput{KeyId: id})
+			if err != nil {
+				errc <- err
+				return
+			}
+			resultc <- out.KeyMetadata
+		}(id)
+	}
+
+	go func() {
+		wg.Wait()
+		close(resultc)
+	}()
+
+	for {
+		select {
+		case err := <-errc:
+			if err != nil {
+				return g, cloudResources, err
+			}
+		case key, ok := <-resultc:
+			if !ok {
+				return g, cloudResources, nil
+			}
+			cloudResources = append(cloudResources, key)
+			res, err := newResource(s, key)
 			if err != nil {
 				return g, cloudResources, err
 			}
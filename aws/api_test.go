@@ -25,10 +25,22 @@ import (
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/wallix/awless/graph"
 )
 
 func TestBuildAccessRdfGraph(t *testing.T) {
@@ -197,10 +209,44 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 
 	igws := []*ec2.InternetGateway{
 		{InternetGatewayId: awssdk.String("igw_1"), Attachments: []*ec2.InternetGatewayAttachment{{VpcId: awssdk.String("vpc_2")}}},
+		{InternetGatewayId: awssdk.String("igw-2")},
 	}
 
 	routeTables := []*ec2.RouteTable{
 		{RouteTableId: awssdk.String("rt_1"), VpcId: awssdk.String("vpc_1"), Associations: []*ec2.RouteTableAssociation{{RouteTableId: awssdk.String("rt_1"), SubnetId: awssdk.String("subnet_1")}}},
+		{RouteTableId: awssdk.String("rt_2"), VpcId: awssdk.String("vpc_2"), Routes: []*ec2.Route{
+			{DestinationCidrBlock: awssdk.String("10.0.0.0/16"), GatewayId: awssdk.String("local")},
+			{DestinationCidrBlock: awssdk.String("0.0.0.0/0"), GatewayId: awssdk.String("igw-2")},
+		}},
+		{RouteTableId: awssdk.String("rt_3"), VpcId: awssdk.String("vpc_2"), Routes: []*ec2.Route{
+			{DestinationCidrBlock: awssdk.String("0.0.0.0/0"), NatGatewayId: awssdk.String("nat_1")},
+			{DestinationCidrBlock: awssdk.String("192.168.0.0/16"), InstanceId: awssdk.String("inst_4")},
+		}},
+	}
+
+	natGateways := []*ec2.NatGateway{
+		{NatGatewayId: awssdk.String("nat_1"), VpcId: awssdk.String("vpc_2"), SubnetId: awssdk.String("sub_3"), State: awssdk.String("available"), NatGatewayAddresses: []*ec2.NatGatewayAddress{{AllocationId: awssdk.String("eip_1"), PublicIp: awssdk.String("1.2.3.4"), PrivateIp: awssdk.String("10.0.3.10")}}},
+	}
+
+	addresses := []*ec2.Address{
+		{AllocationId: awssdk.String("eip_1"), PublicIp: awssdk.String("1.2.3.4"), Domain: awssdk.String("vpc"), NetworkInterfaceId: awssdk.String("eni_1")},
+		{AllocationId: awssdk.String("eip_2"), PublicIp: awssdk.String("5.6.7.8"), Domain: awssdk.String("vpc"), InstanceId: awssdk.String("inst_1"), AssociationId: awssdk.String("eipassoc_1")},
+	}
+
+	volumes := []*ec2.Volume{
+		{VolumeId: awssdk.String("vol_1"), Size: awssdk.Int64(8), State: awssdk.String("available"), Encrypted: awssdk.Bool(true), KmsKeyId: awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab")},
+	}
+
+	images := []*ec2.Image{
+		{ImageId: awssdk.String("ami_1"), Name: awssdk.String("my_image"), State: awssdk.String("available"), CreationDate: awssdk.String("2017-03-01T10:20:30.000Z"), VirtualizationType: awssdk.String("hvm"), BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+			{DeviceName: awssdk.String("/dev/xvda"), Ebs: &ec2.EbsBlockDevice{SnapshotId: awssdk.String("snap_1")}},
+			{DeviceName: awssdk.String("/dev/xvdb"), VirtualName: awssdk.String("ephemeral0")},
+		}},
+	}
+
+	snapshots := []*ec2.Snapshot{
+		{SnapshotId: awssdk.String("snap_1"), VolumeId: awssdk.String("vol_1"), State: awssdk.String("completed"), VolumeSize: awssdk.Int64(8)},
+		{SnapshotId: awssdk.String("snap_2"), VolumeId: awssdk.String("vol_1"), State: awssdk.String("pending"), Progress: awssdk.String("42%"), Tags: []*ec2.Tag{{Key: awssdk.String("Name"), Value: awssdk.String("my_snapshot")}}},
 	}
 
 	//ELB
@@ -222,10 +268,22 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"tg_2": {{Target: &elbv2.TargetDescription{Id: awssdk.String("inst_2"), Port: awssdk.Int64(80)}}, {Target: &elbv2.TargetDescription{Id: awssdk.String("inst_3"), Port: awssdk.Int64(80)}}},
 	}
 
-	mock := &mockEc2{vpcs: vpcs, securityGroups: securityGroups, subnets: subnets, instances: instances, keyPairs: keypairs, internetGateways: igws, routeTables: routeTables}
+	classicLbs := []*elb.LoadBalancerDescription{
+		{
+			LoadBalancerName:     awssdk.String("classic_lb_1"),
+			VPCId:                awssdk.String("vpc_1"),
+			Subnets:              []*string{awssdk.String("sub_1"), awssdk.String("sub_2")},
+			SecurityGroups:       []*string{awssdk.String("secgroup_1")},
+			Instances:            []*elb.Instance{{InstanceId: awssdk.String("inst_1")}, {InstanceId: awssdk.String("inst_2")}},
+			ListenerDescriptions: []*elb.ListenerDescription{{Listener: &elb.Listener{Protocol: awssdk.String("HTTP"), LoadBalancerPort: awssdk.Int64(80), InstanceProtocol: awssdk.String("HTTP"), InstancePort: awssdk.Int64(8080)}}},
+			HealthCheck:          &elb.HealthCheck{Target: awssdk.String("HTTP:8080/health")},
+		},
+		{LoadBalancerName: awssdk.String("classic_lb_2")}, // EC2-Classic load balancer (no vpc)
+	}
+
+	mock := &mockEc2{vpcs: vpcs, securityGroups: securityGroups, subnets: subnets, instances: instances, keyPairs: keypairs, internetGateways: igws, routeTables: routeTables, natGateways: natGateways, addresses: addresses, volumes: volumes, images: images, snapshots: snapshots}
 	mockLb := &mockELB{loadBalancerPages: lbPages, targetGroups: targetGroups, listeners: listeners, targetHealths: targetHealths}
-	infra := Infra{EC2API: mock, ELBV2API: mockLb, region: "eu-west-1"}
-	InfraService = &infra
+	infra := Infra{EC2API: mock, ELBV2API: mockLb, ELBAPI: &mockClassicELB{loadBalancers: classicLbs}, region: "eu-west-1"}
 
 	g, err := infra.FetchResources()
 	if err != nil {
@@ -266,7 +324,7 @@ func TestBuildStorageRdfGraph(t *testing.T) {
 			{Key: awssdk.String("obj_3")},
 		},
 		"bucket_eu_1": {
-			{Key: awssdk.String("obj_4")},
+			{Key: awssdk.String("obj_4"), Size: awssdk.Int64(10), ETag: awssdk.String(`"781e5e245d69b566979b86e28d23f2c7"`)},
 		},
 		"bucket_eu_2": {
 			{Key: awssdk.String("obj_5")},
@@ -285,11 +343,30 @@ func TestBuildStorageRdfGraph(t *testing.T) {
 		},
 		"bucket_eu_2": {
 			{Permission: awssdk.String("Write"), Grantee: &s3.Grantee{URI: awssdk.String("usr_1")}},
+			{Permission: awssdk.String("READ"), Grantee: &s3.Grantee{URI: awssdk.String("http://acs.amazonaws.com/groups/global/AllUsers")}},
+		},
+	}
+	bucketsPolicy := map[string]string{
+		"bucket_us_1": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket_us_1/*"}]}`,
+		"bucket_eu_1": `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:PutObject","Resource":"arn:aws:s3:::bucket_eu_1/*"}]}`,
+	}
+	bucketsVersioning := map[string]string{"bucket_us_1": "Enabled", "bucket_eu_1": "Suspended"}
+	bucketsLifecycle := map[string][]*s3.LifecycleRule{
+		"bucket_eu_1": {
+			{ID: awssdk.String("logs"), Status: awssdk.String("Enabled"), Prefix: awssdk.String("logs/"), Expiration: &s3.LifecycleExpiration{Days: awssdk.Int64(30)}, Transitions: []*s3.Transition{{Days: awssdk.Int64(7), StorageClass: awssdk.String("GLACIER")}}},
 		},
 	}
 
-	mocks3 := &mockS3{bucketsPerRegion: buckets, objectsPerBucket: objects, bucketsACL: bucketsACL}
-	StorageService = mocks3
+	bucketsEncryption := map[string]*s3.ServerSideEncryptionByDefault{
+		"bucket_eu_1": {SSEAlgorithm: awssdk.String("aws:kms"), KMSMasterKeyID: awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/key_1")},
+		"bucket_eu_2": {SSEAlgorithm: awssdk.String("AES256")},
+	}
+
+	bucketsAccess := map[string]*s3.PublicAccessBlockConfiguration{
+		"bucket_eu_1": {BlockPublicAcls: awssdk.Bool(true), IgnorePublicAcls: awssdk.Bool(false), RestrictPublicBuckets: awssdk.Bool(true)},
+	}
+
+	mocks3 := &mockS3{bucketsPerRegion: buckets, objectsPerBucket: objects, bucketsACL: bucketsACL, bucketsPolicy: bucketsPolicy, bucketsVersioning: bucketsVersioning, bucketsLifecycle: bucketsLifecycle, bucketsEncryption: bucketsEncryption, bucketsAccess: bucketsAccess}
 	storage := Storage{S3API: mocks3, region: "eu-west-1"}
 
 	g, err := storage.FetchResources()
@@ -309,6 +386,546 @@ func TestBuildStorageRdfGraph(t *testing.T) {
 	}
 }
 
+func TestBuildDatabaseRdfGraph(t *testing.T) {
+	subnetGroup := &rds.DBSubnetGroup{
+		DBSubnetGroupName: awssdk.String("sgroup_1"),
+		VpcId:             awssdk.String("vpc_1"),
+		SubnetGroupStatus: awssdk.String("Complete"),
+		Subnets: []*rds.Subnet{
+			{SubnetIdentifier: awssdk.String("sub_1")},
+			{SubnetIdentifier: awssdk.String("sub_2")},
+		},
+	}
+	mock := &mockRDS{
+		dbInstances: []*rds.DBInstance{
+			{
+				DBInstanceIdentifier: awssdk.String("db_1"),
+				DBInstanceClass:      awssdk.String("db.t2.micro"),
+				DBInstanceStatus:     awssdk.String("available"),
+				Engine:               awssdk.String("postgres"),
+				Endpoint:             &rds.Endpoint{Address: awssdk.String("db_1.rds.amazonaws.com"), Port: awssdk.Int64(5432)},
+				DBSubnetGroup:        subnetGroup,
+				VpcSecurityGroups:    []*rds.VpcSecurityGroupMembership{{VpcSecurityGroupId: awssdk.String("secgroup_1")}},
+				DBParameterGroups:    []*rds.DBParameterGroupStatus{{DBParameterGroupName: awssdk.String("pgroup_1")}},
+			},
+			{
+				DBInstanceIdentifier: awssdk.String("db_2"),
+				Engine:               awssdk.String("mysql"),
+			},
+		},
+		dbSubnetGroups: []*rds.DBSubnetGroup{subnetGroup},
+		dbParamsGroups: []*rds.DBParameterGroup{
+			{DBParameterGroupName: awssdk.String("pgroup_1"), DBParameterGroupFamily: awssdk.String("postgres9.6")},
+		},
+	}
+	database := Database{RDSAPI: mock, region: "eu-west-1"}
+
+	g, err := database.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := g.MustMarshal()
+
+	expectContent, err := ioutil.ReadFile(filepath.Join("testdata", "database.rdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := diffText(result, string(expectContent)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildLambdaRdfGraph(t *testing.T) {
+	mockIam := &mockIam{roles: []*iam.RoleDetail{
+		{RoleId: awssdk.String("role_1"), RoleName: awssdk.String("lambda_exec"), Arn: awssdk.String("arn:aws:iam::123456789012:role/lambda_exec")},
+	}}
+	defer mockIAMAPI(mockIam)()
+	mock := &mockLambda{functions: []*lambda.FunctionConfiguration{
+		{
+			FunctionArn:  awssdk.String("arn:aws:lambda:eu-west-1:123456789012:function:func_1"),
+			FunctionName: awssdk.String("func_1"),
+			Runtime:      awssdk.String("python2.7"),
+			MemorySize:   awssdk.Int64(128),
+			Role:         awssdk.String("arn:aws:iam::123456789012:role/lambda_exec"),
+			LastModified: awssdk.String("2017-03-10T09:31:14.123+0100"),
+			VpcConfig: &lambda.VpcConfigResponse{
+				VpcId:            awssdk.String("vpc_1"),
+				SubnetIds:        []*string{awssdk.String("sub_1"), awssdk.String("sub_2")},
+				SecurityGroupIds: []*string{awssdk.String("secgroup_1")},
+			},
+		},
+		{
+			FunctionArn:  awssdk.String("arn:aws:lambda:eu-west-1:123456789012:function:func_2"),
+			FunctionName: awssdk.String("func_2"),
+			Role:         awssdk.String("arn:aws:iam::123456789012:role/service-role/deleted_role"),
+		},
+	}}
+	lambdaService := Lambda{LambdaAPI: mock, region: "eu-west-1"}
+
+	g, err := lambdaService.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := g.MustMarshal()
+
+	expectContent, err := ioutil.ReadFile(filepath.Join("testdata", "lambda.rdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := diffText(result, string(expectContent)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildDnsRdfGraph(t *testing.T) {
+	defer mockELBV2API(map[string]elbv2iface.ELBV2API{"eu-west-1": &mockELB{loadBalancerPages: [][]*elbv2.LoadBalancer{
+		{{LoadBalancerArn: awssdk.String("lb_1"), DNSName: awssdk.String("lb-1-123456.eu-west-1.elb.amazonaws.com")}},
+	}}})()
+	mock := &mockRoute53{
+		zones: []*route53.HostedZone{
+			{Id: awssdk.String("/hostedzone/ZONE1"), Name: awssdk.String("example.com."), CallerReference: awssdk.String("ref_1"), ResourceRecordSetCount: awssdk.Int64(3), Config: &route53.HostedZoneConfig{Comment: awssdk.String("main zone"), PrivateZone: awssdk.Bool(false)}},
+			{Id: awssdk.String("/hostedzone/ZONE2"), Name: awssdk.String("internal.example.com."), CallerReference: awssdk.String("ref_2"), ResourceRecordSetCount: awssdk.Int64(0), Config: &route53.HostedZoneConfig{PrivateZone: awssdk.Bool(true)}},
+		},
+		records: map[string][]*route53.ResourceRecordSet{
+			"/hostedzone/ZONE1": {
+				{Name: awssdk.String("example.com."), Type: awssdk.String("NS"), TTL: awssdk.Int64(172800), ResourceRecords: []*route53.ResourceRecord{{Value: awssdk.String("ns1.example.com.")}, {Value: awssdk.String("ns2.example.com.")}}},
+				{Name: awssdk.String("www.example.com."), Type: awssdk.String("A"), TTL: awssdk.Int64(300), ResourceRecords: []*route53.ResourceRecord{{Value: awssdk.String("1.2.3.4")}}},
+				{Name: awssdk.String("app.example.com."), Type: awssdk.String("A"), AliasTarget: &route53.AliasTarget{DNSName: awssdk.String("dualstack.lb-1-123456.eu-west-1.elb.amazonaws.com."), HostedZoneId: awssdk.String("Z32O12XQLNTSW2")}},
+			},
+		},
+	}
+	dns := Dns{Route53API: mock, region: "eu-west-1"}
+
+	g, err := dns.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := g.MustMarshal()
+
+	expectContent, err := ioutil.ReadFile(filepath.Join("testdata", "dns.rdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := diffText(result, string(expectContent)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFetchRelationsWithClientsOfFetchingRegion(t *testing.T) {
+	InfraService = &Infra{ELBV2API: &wrongRegionELB{region: "eu-west-1"}, region: "eu-west-1"}
+	StackService = &Stack{CloudFormationAPI: &wrongRegionCloudformation{region: "eu-west-1"}, region: "eu-west-1"}
+	defer func() { InfraService, StackService = nil, nil }()
+
+	lb := &mockELB{
+		loadBalancerPages: [][]*elbv2.LoadBalancer{{{LoadBalancerArn: awssdk.String("lb_1"), DNSName: awssdk.String("lb-1-123456.us-west-2.elb.amazonaws.com")}}},
+		targetGroups:      []*elbv2.TargetGroup{{TargetGroupArn: awssdk.String("tg_1"), LoadBalancerArns: []*string{awssdk.String("lb_1")}}},
+		targetHealths:     map[string][]*elbv2.TargetHealthDescription{"tg_1": {{Target: &elbv2.TargetDescription{Id: awssdk.String("inst_1")}}}},
+	}
+	defer mockELBV2API(map[string]elbv2iface.ELBV2API{"us-west-2": lb})()
+
+	t.Run("infra", func(t *testing.T) {
+		infra := Infra{EC2API: &mockEc2{instances: []*ec2.Instance{{InstanceId: awssdk.String("inst_1")}}}, ELBV2API: lb, ELBAPI: &mockClassicELB{}, region: "us-west-2"}
+		g, err := infra.FetchResources()
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst, err := g.GetResource(graph.Instance, "inst_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		dependings, err := g.ListResourcesDependingOn(inst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(dependings), 1; got != want {
+			t.Fatalf("got %d, want %d resources depending on instance", got, want)
+		}
+	})
+
+	t.Run("stack", func(t *testing.T) {
+		stackID := "arn:aws:cloudformation:us-west-2:123456789012:stack/stack_1/1234"
+		stack := Stack{CloudFormationAPI: &mockCloudformation{
+			stacks:    []*cloudformation.Stack{{StackId: awssdk.String(stackID), StackName: awssdk.String("stack_1")}},
+			resources: map[string][]*cloudformation.StackResourceSummary{stackID: {{ResourceType: awssdk.String("AWS::EC2::Instance"), PhysicalResourceId: awssdk.String("inst_1")}}},
+		}, region: "us-west-2"}
+		if _, err := stack.FetchResources(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("dns", func(t *testing.T) {
+		dns := Dns{Route53API: &mockRoute53{
+			zones: []*route53.HostedZone{{Id: awssdk.String("/hostedzone/ZONE1"), Name: awssdk.String("example.com.")}},
+			records: map[string][]*route53.ResourceRecordSet{"/hostedzone/ZONE1": {
+				{Name: awssdk.String("app.example.com."), Type: awssdk.String("A"), AliasTarget: &route53.AliasTarget{DNSName: awssdk.String("dualstack.lb-1-123456.us-west-2.elb.amazonaws.com.")}},
+			}},
+		}, region: "eu-west-1"}
+		g, err := dns.FetchResources()
+		if err != nil {
+			t.Fatal(err)
+		}
+		lb, err := g.GetResource(graph.LoadBalancer, "lb_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		dependings, err := g.ListResourcesDependingOn(lb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(dependings), 1; got != want {
+			t.Fatalf("got %d, want %d records depending on load balancer", got, want)
+		}
+	})
+}
+
+func TestBuildAutoscalingRdfGraph(t *testing.T) {
+	mock := &mockAutoscaling{
+		launchConfigs: []*autoscaling.LaunchConfiguration{
+			{
+				LaunchConfigurationName: awssdk.String("launchconfig_1"),
+				LaunchConfigurationARN:  awssdk.String("arn:aws:autoscaling:eu-west-1:123456789012:launchConfiguration:1234:launchConfigurationName/launchconfig_1"),
+				ImageId:                 awssdk.String("ami-123456"),
+				InstanceType:            awssdk.String("t2.micro"),
+				KeyName:                 awssdk.String("my_key_pair"),
+				SecurityGroups:          []*string{awssdk.String("secgroup_1")},
+			},
+		},
+		groups: []*autoscaling.Group{
+			{
+				AutoScalingGroupName:    awssdk.String("scalinggroup_1"),
+				AutoScalingGroupARN:     awssdk.String("arn:aws:autoscaling:eu-west-1:123456789012:autoScalingGroup:5678:autoScalingGroupName/scalinggroup_1"),
+				LaunchConfigurationName: awssdk.String("launchconfig_1"),
+				MinSize:                 awssdk.Int64(1),
+				MaxSize:                 awssdk.Int64(3),
+				DesiredCapacity:         awssdk.Int64(2),
+				HealthCheckType:         awssdk.String("ELB"),
+				VPCZoneIdentifier:       awssdk.String("sub_1,sub_2"),
+				TargetGroupARNs:         []*string{awssdk.String("tg_1")},
+				Instances: []*autoscaling.Instance{
+					{InstanceId: awssdk.String("inst_1"), LifecycleState: awssdk.String("InService")},
+					{InstanceId: awssdk.String("inst_2"), LifecycleState: awssdk.String("InService")},
+				},
+			},
+		},
+	}
+	autoscalingService := Autoscaling{AutoScalingAPI: mock, region: "eu-west-1"}
+
+	g, err := autoscalingService.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := g.MustMarshal()
+
+	expectContent, err := ioutil.ReadFile(filepath.Join("testdata", "autoscaling.rdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := diffText(result, string(expectContent)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildMonitoringRdfGraph(t *testing.T) {
+	mock := &mockCloudwatch{
+		alarms: []*cloudwatch.MetricAlarm{
+			{
+				AlarmName:          awssdk.String("alarm_1"),
+				AlarmArn:           awssdk.String("arn:aws:cloudwatch:eu-west-1:123456789012:alarm:alarm_1"),
+				StateValue:         awssdk.String("OK"),
+				MetricName:         awssdk.String("CPUUtilization"),
+				Namespace:          awssdk.String("AWS/EC2"),
+				Statistic:          awssdk.String("Average"),
+				ComparisonOperator: awssdk.String("GreaterThanThreshold"),
+				Threshold:          awssdk.Float64(80.5),
+				Period:             awssdk.Int64(300),
+				EvaluationPeriods:  awssdk.Int64(2),
+				Dimensions:         []*cloudwatch.Dimension{{Name: awssdk.String("InstanceId"), Value: awssdk.String("inst_1")}},
+				AlarmActions:       []*string{awssdk.String("arn:aws:sns:eu-west-1:123456789012:topic_1")},
+				OKActions:          []*string{awssdk.String("arn:aws:sns:eu-west-1:123456789012:topic_1")},
+			},
+			{
+				AlarmName:          awssdk.String("alarm_2"),
+				AlarmArn:           awssdk.String("arn:aws:cloudwatch:eu-west-1:123456789012:alarm:alarm_2"),
+				StateValue:         awssdk.String("ALARM"),
+				MetricName:         awssdk.String("ApproximateNumberOfMessagesVisible"),
+				Namespace:          awssdk.String("AWS/SQS"),
+				Statistic:          awssdk.String("Maximum"),
+				ComparisonOperator: awssdk.String("GreaterThanOrEqualToThreshold"),
+				Threshold:          awssdk.Float64(100),
+				Period:             awssdk.Int64(60),
+				EvaluationPeriods:  awssdk.Int64(1),
+				Dimensions:         []*cloudwatch.Dimension{{Name: awssdk.String("QueueName"), Value: awssdk.String("queue_1")}},
+			},
+			{
+				AlarmName:          awssdk.String("alarm_3"),
+				AlarmArn:           awssdk.String("arn:aws:cloudwatch:eu-west-1:123456789012:alarm:alarm_3"),
+				StateValue:         awssdk.String("INSUFFICIENT_DATA"),
+				MetricName:         awssdk.String("HTTPCode_ELB_5XX_Count"),
+				Namespace:          awssdk.String("AWS/ApplicationELB"),
+				Statistic:          awssdk.String("Sum"),
+				ComparisonOperator: awssdk.String("GreaterThanThreshold"),
+				Threshold:          awssdk.Float64(10),
+				Period:             awssdk.Int64(60),
+				EvaluationPeriods:  awssdk.Int64(5),
+				Dimensions:         []*cloudwatch.Dimension{{Name: awssdk.String("LoadBalancer"), Value: awssdk.String("app/lb_1/1234")}},
+			},
+		},
+	}
+	monitoringService := Monitoring{CloudWatchAPI: mock, region: "eu-west-1"}
+
+	g, err := monitoringService.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := g.MustMarshal()
+
+	expectContent, err := ioutil.ReadFile(filepath.Join("testdata", "monitoring.rdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := diffText(result, string(expectContent)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildStackRdfGraph(t *testing.T) {
+	resource := func(typ, logical, physical string) *cloudformation.StackResourceSummary {
+		return &cloudformation.StackResourceSummary{ResourceType: awssdk.String(typ), LogicalResourceId: awssdk.String(logical), PhysicalResourceId: awssdk.String(physical)}
+	}
+	mock := &mockCloudformation{
+		stacks: []*cloudformation.Stack{
+			{
+				StackId:      awssdk.String("arn:aws:cloudformation:eu-west-1:123456789012:stack/stack_1/1234"),
+				StackName:    awssdk.String("stack_1"),
+				Description:  awssdk.String("web servers"),
+				StackStatus:  awssdk.String("CREATE_COMPLETE"),
+				CreationTime: awssdk.Time(time.Unix(1486716000, 0)),
+				Capabilities: []*string{awssdk.String("CAPABILITY_IAM")},
+				Parameters:   []*cloudformation.Parameter{{ParameterKey: awssdk.String("InstanceType"), ParameterValue: awssdk.String("t2.micro")}},
+				Outputs:      []*cloudformation.Output{{OutputKey: awssdk.String("URL"), OutputValue: awssdk.String("http://lb_1.eu-west-1.elb.amazonaws.com")}},
+			},
+			{
+				StackId:           awssdk.String("arn:aws:cloudformation:eu-west-1:123456789012:stack/stack_2/5678"),
+				StackName:         awssdk.String("stack_2"),
+				StackStatus:       awssdk.String("ROLLBACK_COMPLETE"),
+				StackStatusReason: awssdk.String("The following resource(s) failed to create: [Queue]."),
+				CreationTime:      awssdk.Time(time.Unix(1486716000, 0)),
+			},
+		},
+		resources: map[string][]*cloudformation.StackResourceSummary{
+			"arn:aws:cloudformation:eu-west-1:123456789012:stack/stack_1/1234": {
+				resource("AWS::EC2::Instance", "WebServer", "inst_1"),
+				resource("AWS::EC2::SecurityGroup", "WebGroup", "securitygroup_1"),
+				resource("AWS::ElasticLoadBalancingV2::LoadBalancer", "LoadBalancer", "arn:aws:elasticloadbalancing:eu-west-1:123456789012:loadbalancer/app/lb_1/1234"),
+				resource("AWS::IAM::Role", "WebRole", "stack_1-WebRole-ABCDEF"),
+				resource("AWS::CloudFormation::Stack", "Database", "arn:aws:cloudformation:eu-west-1:123456789012:stack/stack_2/5678"),
+			},
+		},
+	}
+	stackService := &Stack{CloudFormationAPI: mock, region: "eu-west-1"}
+
+	g, err := stackService.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := g.MustMarshal()
+
+	expectContent, err := ioutil.ReadFile(filepath.Join("testdata", "stack.rdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := diffText(result, string(expectContent)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildContainerRdfGraph(t *testing.T) {
+	mockIam := &mockIam{roles: []*iam.RoleDetail{
+		{RoleId: awssdk.String("role_1"), RoleName: awssdk.String("ecsServiceRole"), Arn: awssdk.String("arn:aws:iam::123456789012:role/ecsServiceRole")},
+		{RoleId: awssdk.String("role_2"), RoleName: awssdk.String("nginx_task"), Arn: awssdk.String("arn:aws:iam::123456789012:role/nginx_task")},
+	}}
+	defer mockIAMAPI(mockIam)()
+
+	cluster := "arn:aws:ecs:eu-west-1:123456789012:cluster/cluster_1"
+	nginx := "arn:aws:ecs:eu-west-1:123456789012:task-definition/nginx:2"
+	mock := &mockEcs{
+		clusters: []*ecs.Cluster{
+			{ClusterArn: awssdk.String(cluster), ClusterName: awssdk.String("cluster_1"), Status: awssdk.String("ACTIVE"), ActiveServicesCount: awssdk.Int64(1), RunningTasksCount: awssdk.Int64(2), PendingTasksCount: awssdk.Int64(0), RegisteredContainerInstancesCount: awssdk.Int64(1)},
+		},
+		services: []*ecs.Service{
+			{
+				ServiceArn:              awssdk.String("arn:aws:ecs:eu-west-1:123456789012:service/nginx"),
+				ServiceName:             awssdk.String("nginx"),
+				ClusterArn:              awssdk.String(cluster),
+				Status:                  awssdk.String("ACTIVE"),
+				TaskDefinition:          awssdk.String(nginx),
+				DesiredCount:            awssdk.Int64(1),
+				RunningCount:            awssdk.Int64(1),
+				RoleArn:                 awssdk.String("arn:aws:iam::123456789012:role/ecsServiceRole"),
+				LoadBalancers:           []*ecs.LoadBalancer{{TargetGroupArn: awssdk.String("tg_1"), ContainerName: awssdk.String("nginx"), ContainerPort: awssdk.Int64(80)}},
+				DeploymentConfiguration: &ecs.DeploymentConfiguration{MaximumPercent: awssdk.Int64(200), MinimumHealthyPercent: awssdk.Int64(50)},
+				CreatedAt:               awssdk.Time(time.Unix(1486716000, 0)),
+			},
+		},
+		taskDefinitions: []*ecs.TaskDefinition{
+			{
+				TaskDefinitionArn:    awssdk.String(nginx),
+				Family:               awssdk.String("nginx"),
+				Revision:             awssdk.Int64(2),
+				Status:               awssdk.String("ACTIVE"),
+				TaskRoleArn:          awssdk.String("arn:aws:iam::123456789012:role/nginx_task"),
+				ContainerDefinitions: []*ecs.ContainerDefinition{{Name: awssdk.String("nginx"), Image: awssdk.String("nginx:1.11")}},
+			},
+		},
+		tasks: []*ecs.Task{
+			{
+				TaskArn:              awssdk.String("arn:aws:ecs:eu-west-1:123456789012:task/task_1"),
+				ClusterArn:           awssdk.String(cluster),
+				TaskDefinitionArn:    awssdk.String(nginx),
+				ContainerInstanceArn: awssdk.String("arn:aws:ecs:eu-west-1:123456789012:container-instance/ci_1"),
+				LastStatus:           awssdk.String("RUNNING"),
+				DesiredStatus:        awssdk.String("RUNNING"),
+				StartedBy:            awssdk.String("ecs-svc/1234"),
+				StartedAt:            awssdk.Time(time.Unix(1486716000, 0)),
+			},
+		},
+		containerInstances: map[string][]*ecs.ContainerInstance{
+			cluster: {
+				{
+					ContainerInstanceArn: awssdk.String("arn:aws:ecs:eu-west-1:123456789012:container-instance/ci_1"),
+					Ec2InstanceId:        awssdk.String("inst_1"),
+					Status:               awssdk.String("ACTIVE"),
+					AgentConnected:       awssdk.Bool(true),
+					RunningTasksCount:    awssdk.Int64(1),
+					VersionInfo:          &ecs.VersionInfo{AgentVersion: awssdk.String("1.14.0")},
+				},
+			},
+		},
+	}
+	containerService := &Container{ECSAPI: mock, region: "eu-west-1"}
+
+	g, err := containerService.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := g.MustMarshal()
+
+	expectContent, err := ioutil.ReadFile(filepath.Join("testdata", "container.rdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := diffText(result, string(expectContent)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildNosqlRdfGraph(t *testing.T) {
+	mock := &mockDynamodb{
+		tables: []*dynamodb.TableDescription{
+			{
+				TableName:        awssdk.String("table_1"),
+				TableArn:         awssdk.String("arn:aws:dynamodb:eu-west-1:123456789012:table/table_1"),
+				TableStatus:      awssdk.String("ACTIVE"),
+				CreationDateTime: awssdk.Time(time.Unix(1486716000, 0)),
+				KeySchema: []*dynamodb.KeySchemaElement{
+					{AttributeName: awssdk.String("id"), KeyType: awssdk.String("HASH")},
+					{AttributeName: awssdk.String("timestamp"), KeyType: awssdk.String("RANGE")},
+				},
+				ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{ReadCapacityUnits: awssdk.Int64(5), WriteCapacityUnits: awssdk.Int64(10)},
+				ItemCount:             awssdk.Int64(42),
+				TableSizeBytes:        awssdk.Int64(2048),
+				StreamSpecification:   &dynamodb.StreamSpecification{StreamEnabled: awssdk.Bool(true), StreamViewType: awssdk.String("NEW_IMAGE")},
+				LatestStreamArn:       awssdk.String("arn:aws:dynamodb:eu-west-1:123456789012:table/table_1/stream/2017-02-10T08:40:00.000"),
+			},
+			{
+				TableName:             awssdk.String("table_2"),
+				TableArn:              awssdk.String("arn:aws:dynamodb:eu-west-1:123456789012:table/table_2"),
+				TableStatus:           awssdk.String("CREATING"),
+				KeySchema:             []*dynamodb.KeySchemaElement{{AttributeName: awssdk.String("name"), KeyType: awssdk.String("HASH")}},
+				ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{ReadCapacityUnits: awssdk.Int64(1), WriteCapacityUnits: awssdk.Int64(1)},
+			},
+		},
+	}
+	nosqlService := &Nosql{DynamoDBAPI: mock, region: "eu-west-1"}
+
+	g, err := nosqlService.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := g.MustMarshal()
+
+	expectContent, err := ioutil.ReadFile(filepath.Join("testdata", "nosql.rdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := diffText(result, string(expectContent)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildEncryptionRdfGraph(t *testing.T) {
+	mock := &mockKms{
+		keys: []*kms.KeyMetadata{
+			{
+				KeyId:        awssdk.String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+				Arn:          awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				Description:  awssdk.String("backups"),
+				KeyState:     awssdk.String("Enabled"),
+				Enabled:      awssdk.Bool(true),
+				KeyUsage:     awssdk.String("ENCRYPT_DECRYPT"),
+				Origin:       awssdk.String("AWS_KMS"),
+				CreationDate: awssdk.Time(time.Unix(1486716000, 0)),
+			},
+			{
+				KeyId:        awssdk.String("0987dcba-09fe-87dc-65ba-ab0987654321"),
+				Arn:          awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/0987dcba-09fe-87dc-65ba-ab0987654321"),
+				KeyState:     awssdk.String("PendingDeletion"),
+				Enabled:      awssdk.Bool(false),
+				KeyUsage:     awssdk.String("ENCRYPT_DECRYPT"),
+				Origin:       awssdk.String("AWS_KMS"),
+				DeletionDate: awssdk.Time(time.Unix(1487320800, 0)),
+			},
+		},
+		aliases: []*kms.AliasListEntry{
+			{AliasName: awssdk.String("alias/backups"), AliasArn: awssdk.String("arn:aws:kms:eu-west-1:123456789012:alias/backups"), TargetKeyId: awssdk.String("1234abcd-12ab-34cd-56ef-1234567890ab")},
+			{AliasName: awssdk.String("alias/aws/ebs"), AliasArn: awssdk.String("arn:aws:kms:eu-west-1:123456789012:alias/aws/ebs")},
+		},
+	}
+	encryptionService := &Encryption{KMSAPI: mock, region: "eu-west-1"}
+
+	g, err := encryptionService.FetchResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := g.MustMarshal()
+
+	expectContent, err := ioutil.ReadFile(filepath.Join("testdata", "encryption.rdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := diffText(result, string(expectContent)); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestBuildEmptyRdfGraphWhenNoData(t *testing.T) {
 	expect := `/region<eu-west-1>	"has_type"@[]	"/region"^^type:text`
 	access := Access{IAMAPI: &mockIam{}, region: "eu-west-1"}
@@ -323,7 +940,7 @@ func TestBuildEmptyRdfGraphWhenNoData(t *testing.T) {
 		t.Fatalf("got [%s]\nwant [%s]", result, expect)
 	}
 
-	infra := Infra{EC2API: &mockEc2{}, ELBV2API: &mockELB{}, region: "eu-west-1"}
+	infra := Infra{EC2API: &mockEc2{}, ELBV2API: &mockELB{}, ELBAPI: &mockClassicELB{}, region: "eu-west-1"}
 
 	g, err = infra.FetchResources()
 	if err != nil {
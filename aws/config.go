@@ -1,5 +1,12 @@
 package aws
 
+import (
+	"fmt"
+	"strings"
+
+	awsconfig "github.com/wallix/awless/aws/config"
+)
+
 type config map[string]interface{}
 
 func (c config) region() string {
@@ -16,6 +23,54 @@ func (c config) profile() string {
 	return ""
 }
 
+// Account is a named account context: its credentials are the ones of a profile, or of a role assumed with them
+type Account struct {
+	Name, Profile, Role, ExternalID, MFASerial string
+}
+
+func (c config) accountName() string {
+	if name, ok := c["aws.account"].(string); ok {
+		return name
+	}
+	return ""
+}
+
+// account returns the account context defined with the aws.accounts.<name>.profile|role|externalid|mfa keys
+func (c config) account(name string) (*Account, error) {
+	acc := &Account{Name: name}
+	var found bool
+	for k, v := range c {
+		if !strings.HasPrefix(k, awsconfig.AccountsPrefix) {
+			continue
+		}
+		accName, field, err := awsconfig.ParseAccountKey(k)
+		if err != nil {
+			return nil, err
+		}
+		if accName != name {
+			continue
+		}
+		found = true
+		switch field {
+		case "profile":
+			acc.Profile = fmt.Sprint(v)
+		case "role":
+			acc.Role = fmt.Sprint(v)
+		case "externalid":
+			acc.ExternalID = fmt.Sprint(v)
+		case "mfa":
+			acc.MFASerial = fmt.Sprint(v)
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("unknown account '%s'. Define it with `awless config set %s%s.profile` or `awless config set %s%s.role`", name, awsconfig.AccountsPrefix, name, awsconfig.AccountsPrefix, name)
+	}
+	if acc.Profile == "" {
+		acc.Profile = c.profile()
+	}
+	return acc, nil
+}
+
 func (c config) getBool(key string, def bool) bool {
 	if b, ok := c[key].(bool); ok {
 		return b
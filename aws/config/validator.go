@@ -18,6 +18,77 @@ func ParseRegion(i string) (interface{}, error) {
 	return i, nil
 }
 
+// AllRegionsValue is the value of a list of regions standing for all the regions of the AWS partition
+const AllRegionsValue = "all"
+
+// ParseRegions parses a comma separated list of regions, or 'all'
+func ParseRegions(i string) (interface{}, error) {
+	if strings.TrimSpace(i) == AllRegionsValue {
+		return AllRegionsValue, nil
+	}
+	var regions []string
+	for _, region := range strings.Split(i, ",") {
+		region = strings.TrimSpace(region)
+		if region == "" {
+			continue
+		}
+		if !IsValidRegion(region) {
+			return i, fmt.Errorf("'%s' is not a valid region", region)
+		}
+		regions = append(regions, region)
+	}
+	if len(regions) == 0 {
+		return i, fmt.Errorf("expected a comma separated list of regions or '%s', got '%s'", AllRegionsValue, i)
+	}
+	return strings.Join(regions, ","), nil
+}
+
+// ExpandRegions returns the regions of a value parsed by ParseRegions
+func ExpandRegions(value string) []string {
+	if value == AllRegionsValue {
+		return PartitionRegions()
+	}
+	var regions []string
+	for _, region := range strings.Split(value, ",") {
+		if region = strings.TrimSpace(region); region != "" {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// PartitionRegions returns the regions of the standard AWS partition (i.e. without China and GovCloud regions)
+func PartitionRegions() []string {
+	var regions []string
+	partition := endpoints.AwsPartition()
+	for id := range partition.Regions() {
+		regions = append(regions, id)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// AccountsPrefix prefixes the keys of named account contexts: aws.accounts.<name>.<field>
+const AccountsPrefix = "aws.accounts."
+
+// AccountFields are the fields of a named account context: the profile of its credentials,
+// or the role assumed with them (given an optional external id and MFA device serial number)
+var AccountFields = []string{"profile", "role", "externalid", "mfa"}
+
+// ParseAccountKey returns the name and the field of an account context key aws.accounts.<name>.<field>
+func ParseAccountKey(key string) (string, string, error) {
+	splits := strings.Split(strings.TrimPrefix(key, AccountsPrefix), ".")
+	if !strings.HasPrefix(key, AccountsPrefix) || len(splits) != 2 || splits[0] == "" {
+		return "", "", fmt.Errorf("invalid account key '%s', expected %s<name>.<field>", key, AccountsPrefix)
+	}
+	for _, field := range AccountFields {
+		if splits[1] == field {
+			return splits[0], field, nil
+		}
+	}
+	return "", "", fmt.Errorf("invalid account field '%s', expected one of: %s", splits[1], strings.Join(AccountFields, ", "))
+}
+
 func WarningChangeRegion(i interface{}) {
 	region := fmt.Sprint(i)
 	fmt.Fprintf(os.Stderr, "You changed your region to '%s'.\nYou might also want to update your default AMI with `awless config set instance.image %s`\n", region, AmiPerRegion[region])
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -27,6 +29,48 @@ func TestRegionsValid(t *testing.T) {
 	}
 }
 
+func TestParseRegions(t *testing.T) {
+	tcases := []struct {
+		in     string
+		expect interface{}
+		err    bool
+	}{
+		{in: "eu-west-1", expect: "eu-west-1"},
+		{in: "eu-west-1, us-east-1,", expect: "eu-west-1,us-east-1"},
+		{in: "all", expect: "all"},
+		{in: "eu-west-1,eu-test", err: true},
+		{in: ",", err: true},
+	}
+	for _, tcase := range tcases {
+		v, err := ParseRegions(tcase.in)
+		if tcase.err {
+			if err == nil {
+				t.Errorf("%s: expected error", tcase.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", tcase.in, err)
+		}
+		if got, want := v, tcase.expect; got != want {
+			t.Errorf("%s: got %v, want %v", tcase.in, got, want)
+		}
+	}
+
+	all := ExpandRegions("all")
+	if !stringInSlice("eu-west-1", all) || !stringInSlice("us-east-1", all) {
+		t.Fatalf("got %v, expected standard regions", all)
+	}
+	for _, region := range all {
+		if strings.HasPrefix(region, "cn-") || strings.HasPrefix(region, "us-gov-") {
+			t.Fatalf("got %v, expected only standard regions", all)
+		}
+	}
+	if got, want := ExpandRegions("eu-west-1,us-east-1"), []string{"eu-west-1", "us-east-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
 func TestInstanceTypeValid(t *testing.T) {
 	tcases := []struct {
 		str    string
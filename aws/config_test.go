@@ -0,0 +1,61 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAccountConfig(t *testing.T) {
+	conf := config{
+		"aws.profile":                  "default",
+		"aws.account":                  "prod",
+		"aws.accounts.prod.role":       "arn:aws:iam::123456789012:role/admin",
+		"aws.accounts.prod.externalid": "0042",
+		"aws.accounts.prod.mfa":        "arn:aws:iam::210987654321:mfa/jsmith",
+		"aws.accounts.dev.profile":     "dev",
+	}
+	if got, want := conf.accountName(), "prod"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	acc, err := conf.account("prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Account{Name: "prod", Profile: "default", Role: "arn:aws:iam::123456789012:role/admin", ExternalID: "0042", MFASerial: "arn:aws:iam::210987654321:mfa/jsmith"}
+	if got, want := acc, expected; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+
+	if acc, err = conf.account("dev"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := acc, (&Account{Name: "dev", Profile: "dev"}); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+
+	if _, err = conf.account("test"); err == nil {
+		t.Fatal("expected error for unknown account")
+	}
+
+	conf["aws.accounts.dev.region"] = "eu-west-1"
+	if _, err = conf.account("dev"); err == nil {
+		t.Fatal("expected error for unknown account field")
+	}
+}
@@ -17,22 +17,36 @@ limitations under the License.
 package aws
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/graph"
 )
@@ -44,9 +58,10 @@ func (d *IamDriver) Attach_Policy_DryRun(params map[string]interface{}) (interfa
 
 	_, hasUser := params["user"]
 	_, hasGroup := params["group"]
+	_, hasRole := params["role"]
 
-	if !hasUser && !hasGroup {
-		return nil, errors.New("attach policy: missing one of 'user, group' param")
+	if !hasUser && !hasGroup && !hasRole {
+		return nil, errors.New("attach policy: missing one of 'user, group, role' param")
 	}
 
 	d.logger.Verbose("params dry run: attach policy ok")
@@ -56,6 +71,7 @@ func (d *IamDriver) Attach_Policy_DryRun(params map[string]interface{}) (interfa
 func (d *IamDriver) Attach_Policy(params map[string]interface{}) (interface{}, error) {
 	user, hasUser := params["user"]
 	group, hasGroup := params["group"]
+	role, hasRole := params["role"]
 
 	switch {
 	case hasUser:
@@ -68,9 +84,14 @@ func (d *IamDriver) Attach_Policy(params map[string]interface{}) (interface{}, e
 			{val: params["arn"], fieldPath: "PolicyArn", fieldType: awsstr},
 			{val: group, fieldPath: "GroupName", fieldType: awsstr},
 		}...)
+	case hasRole:
+		return performCall(d, "attach role", &iam.AttachRolePolicyInput{}, d.AttachRolePolicy, []setter{
+			{val: params["arn"], fieldPath: "PolicyArn", fieldType: awsstr},
+			{val: role, fieldPath: "RoleName", fieldType: awsstr},
+		}...)
 	}
 
-	return nil, errors.New("missing one of 'user, group' param")
+	return nil, errors.New("missing one of 'user, group, role' param")
 }
 
 func (d *IamDriver) Detach_Policy_DryRun(params map[string]interface{}) (interface{}, error) {
@@ -80,9 +101,10 @@ func (d *IamDriver) Detach_Policy_DryRun(params map[string]interface{}) (interfa
 
 	_, hasUser := params["user"]
 	_, hasGroup := params["group"]
+	_, hasRole := params["role"]
 
-	if !hasUser && !hasGroup {
-		return nil, errors.New("detach policy: missing one of 'user, group' param")
+	if !hasUser && !hasGroup && !hasRole {
+		return nil, errors.New("detach policy: missing one of 'user, group, role' param")
 	}
 
 	d.logger.Verbose("params dry run: detach policy ok")
@@ -92,6 +114,7 @@ func (d *IamDriver) Detach_Policy_DryRun(params map[string]interface{}) (interfa
 func (d *IamDriver) Detach_Policy(params map[string]interface{}) (interface{}, error) {
 	user, hasUser := params["user"]
 	group, hasGroup := params["group"]
+	role, hasRole := params["role"]
 
 	switch {
 	case hasUser:
@@ -104,9 +127,193 @@ func (d *IamDriver) Detach_Policy(params map[string]interface{}) (interface{}, e
 			{val: params["arn"], fieldPath: "PolicyArn", fieldType: awsstr},
 			{val: group, fieldPath: "GroupName", fieldType: awsstr},
 		}...)
+	case hasRole:
+		return performCall(d, "detach role", &iam.DetachRolePolicyInput{}, d.DetachRolePolicy, []setter{
+			{val: params["arn"], fieldPath: "PolicyArn", fieldType: awsstr},
+			{val: role, fieldPath: "RoleName", fieldType: awsstr},
+		}...)
+	}
+
+	return nil, errors.New("missing one of 'user, group, role' param")
+}
+
+func (d *IamDriver) Create_Role_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("create role: missing required params 'name'")
+	}
+	if _, err := buildAssumeRolePolicyDocument(params); err != nil {
+		return nil, fmt.Errorf("create role: %s", err)
+	}
+	d.logger.Verbose("params dry run: create role ok")
+	return nil, nil
+}
+
+func (d *IamDriver) Create_Role(params map[string]interface{}) (interface{}, error) {
+	trust, err := buildAssumeRolePolicyDocument(params)
+	if err != nil {
+		return nil, fmt.Errorf("create role: %s", err)
+	}
+	input := &iam.CreateRoleInput{AssumeRolePolicyDocument: aws.String(trust)}
+
+	// Required params
+	if err = setFieldWithType(params["name"], input, "RoleName", awsstr); err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if err = setFieldWithType(params["path"], input, "Path", awsstr); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.CreateRole(input)
+	if err != nil {
+		d.logger.Errorf("create role error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("iam.CreateRole call took %s", time.Since(start))
+	id := aws.StringValue(output.Role.Arn)
+	d.logger.Verbosef("create role '%s' done", id)
+	return id, nil
+}
+
+// buildAssumeRolePolicyDocument returns the trust policy of a role either read from
+// the 'trustpolicy' file or allowing the 'principal' service or account to assume the role
+func buildAssumeRolePolicyDocument(params map[string]interface{}) (string, error) {
+	if file, ok := params["trustpolicy"]; ok {
+		return readPolicyDocument(fmt.Sprint(file))
+	}
+	principal, ok := params["principal"]
+	if !ok {
+		return "", errors.New("missing one of 'principal, trustpolicy' param")
+	}
+
+	kind := "Service"
+	if p := fmt.Sprint(principal); strings.HasPrefix(p, "arn:") || accountIdRegex.MatchString(p) {
+		kind = "AWS"
+	}
+	doc := &policyDocument{
+		Version: "2012-10-17",
+		Statement: []*policyStatement{
+			{Effect: "Allow", Action: "sts:AssumeRole", Principal: map[string]string{kind: fmt.Sprint(principal)}},
+		},
+	}
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+var accountIdRegex = regexp.MustCompile(`^\d{12}$`)
+
+type policyDocument struct {
+	Version   string
+	Statement []*policyStatement
+}
+
+type policyStatement struct {
+	Effect    string
+	Action    string
+	Principal map[string]string
+}
+
+// readPolicyDocument reads a JSON policy document from a local file
+func readPolicyDocument(path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	var doc interface{}
+	if err = json.Unmarshal(b, &doc); err != nil {
+		return "", fmt.Errorf("invalid JSON policy document '%s': %s", path, err)
+	}
+	return string(b), nil
+}
+
+func (d *IamDriver) Create_Accesskey_DryRun(params map[string]interface{}) (interface{}, error) {
+	const keyDirEnv = "__AWLESS_KEYS_DIR"
+	if os.Getenv(keyDirEnv) == "" {
+		err := fmt.Errorf("empty env var '%s'", keyDirEnv)
+		d.logger.Errorf("dry run: saving access key error: %s", err)
+		return nil, err
+	}
+	d.logger.Verbose("params dry run: create accesskey ok")
+	return nil, nil
+}
+
+// Create_Accesskey creates an access key and stores its secret in the local keys directory,
+// since AWS does not allow to retrieve it afterwards
+func (d *IamDriver) Create_Accesskey(params map[string]interface{}) (interface{}, error) {
+	input := &iam.CreateAccessKeyInput{}
+	if err := setFieldWithType(params["user"], input, "UserName", awsstr); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.CreateAccessKey(input)
+	if err != nil {
+		d.logger.Errorf("create accesskey error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("iam.CreateAccessKey call took %s", time.Since(start))
+
+	key := output.AccessKey
+	id := aws.StringValue(key.AccessKeyId)
+	keyPath := filepath.Join(os.Getenv("__AWLESS_KEYS_DIR"), fmt.Sprintf("%s-%s.credentials", aws.StringValue(key.UserName), id))
+	content := fmt.Sprintf("[%s]\naws_access_key_id = %s\naws_secret_access_key = %s\n", aws.StringValue(key.UserName), id, aws.StringValue(key.SecretAccessKey))
+	if err = ioutil.WriteFile(keyPath, []byte(content), 0400); err != nil {
+		d.logger.Errorf("saving access key error: %s", err)
+		return nil, err
+	}
+	fmt.Printf("secret of access key '%s' stored in '%s'\n", id, keyPath)
+
+	d.logger.Verbosef("create accesskey '%s' done", id)
+	return id, nil
+}
+
+func (d *IamDriver) Create_Policy_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, name := range []string{"name", "document"} {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("create policy: missing required params '%s'", name)
+		}
+	}
+	if _, err := readPolicyDocument(fmt.Sprint(params["document"])); err != nil {
+		return nil, fmt.Errorf("create policy: %s", err)
+	}
+	d.logger.Verbose("params dry run: create policy ok")
+	return nil, nil
+}
+
+func (d *IamDriver) Create_Policy(params map[string]interface{}) (interface{}, error) {
+	doc, err := readPolicyDocument(fmt.Sprint(params["document"]))
+	if err != nil {
+		return nil, fmt.Errorf("create policy: %s", err)
+	}
+	input := &iam.CreatePolicyInput{PolicyDocument: aws.String(doc)}
+
+	// Required params
+	if err = setFieldWithType(params["name"], input, "PolicyName", awsstr); err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	for name, field := range map[string]string{"description": "Description", "path": "Path"} {
+		if err = setFieldWithType(params[name], input, field, awsstr); err != nil {
+			return nil, err
+		}
 	}
 
-	return nil, errors.New("missing one of 'user, group' param")
+	start := time.Now()
+	output, err := d.CreatePolicy(input)
+	if err != nil {
+		d.logger.Errorf("create policy error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("iam.CreatePolicy call took %s", time.Since(start))
+	id := aws.StringValue(output.Policy.Arn)
+	d.logger.Verbosef("create policy '%s' done", id)
+	return id, nil
 }
 
 type setter struct {
@@ -231,6 +438,143 @@ func (d *Ec2Driver) Check_Instance(params map[string]interface{}) (interface{},
 	}
 }
 
+func (d *Ec2Driver) Check_Natgateway_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, val := range []string{"state", "id", "timeout"} {
+		if _, ok := params[val]; !ok {
+			err := fmt.Errorf("check natgateway error: missing required param '%s'", val)
+			d.logger.Errorf("%s", err)
+			return nil, err
+		}
+	}
+
+	if _, ok := params["timeout"].(int); !ok {
+		err := errors.New("check natgateway error: timeout param is not int")
+		d.logger.Errorf("%s", err)
+		return nil, err
+	}
+	d.logger.Verbose("params dry run: check natgateway ok")
+	return nil, nil
+}
+
+func (d *Ec2Driver) Check_Natgateway(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DescribeNatGatewaysInput{}
+
+	// Required params
+	err := setFieldWithType(params["id"], input, "NatGatewayIds", awsstringslice)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := time.Duration(params["timeout"].(int)) * time.Second
+	timer := time.NewTimer(timeout)
+	retry := 5 * time.Second
+	for {
+		select {
+		case <-time.After(retry):
+			output, err := d.DescribeNatGateways(input)
+			if err != nil {
+				d.logger.Errorf("check natgateway error: %s", err)
+				return nil, err
+			}
+
+			for _, nat := range output.NatGateways {
+				if aws.StringValue(nat.NatGatewayId) == params["id"] {
+					currentStatus := aws.StringValue(nat.State)
+					if currentStatus == params["state"] {
+						d.logger.Verbosef("check natgateway status '%s' done", params["state"])
+						timer.Stop()
+						return nil, nil
+					}
+					if currentStatus == ec2.NatGatewayStateFailed {
+						err := fmt.Errorf("check natgateway error: %s", aws.StringValue(nat.FailureMessage))
+						d.logger.Errorf("%s", err)
+						return nil, err
+					}
+					d.logger.Infof("natgateway status '%s', expect '%s', retry in %s (timeout %s).", currentStatus, params["state"], retry, timeout)
+				}
+			}
+
+		case <-timer.C:
+			err := fmt.Errorf("timeout of %s expired", timeout)
+			d.logger.Errorf("%s", err)
+			return nil, err
+		}
+	}
+}
+
+func (d *Ec2Driver) Delete_Image_DryRun(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeregisterImageInput{}
+	input.DryRun = aws.Bool(true)
+
+	// Required params
+	err := setFieldWithType(params["id"], input, "ImageId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = d.DeregisterImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("image")
+			d.logger.Verbose("full dry run: delete image ok")
+			return id, nil
+		}
+	}
+
+	d.logger.Errorf("dry run: delete image error: %s", err)
+	return nil, err
+}
+
+// Delete_Image deregisters the image then deletes the EBS snapshots backing it
+func (d *Ec2Driver) Delete_Image(params map[string]interface{}) (interface{}, error) {
+	describeInput := &ec2.DescribeImagesInput{}
+	err := setFieldWithType(params["id"], describeInput, "ImageIds", awsstringslice)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := d.DescribeImages(describeInput)
+	if err != nil {
+		d.logger.Errorf("delete image error: %s", err)
+		return nil, err
+	}
+
+	var snapshots []string
+	for _, img := range images.Images {
+		for _, mapping := range img.BlockDeviceMappings {
+			if mapping.Ebs != nil && aws.StringValue(mapping.Ebs.SnapshotId) != "" {
+				snapshots = append(snapshots, aws.StringValue(mapping.Ebs.SnapshotId))
+			}
+		}
+	}
+
+	input := &ec2.DeregisterImageInput{}
+	err = setFieldWithType(params["id"], input, "ImageId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.DeregisterImage(input)
+	if err != nil {
+		d.logger.Errorf("delete image error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ec2.DeregisterImage call took %s", time.Since(start))
+
+	for _, snap := range snapshots {
+		if _, err = d.DeleteSnapshot(&ec2.DeleteSnapshotInput{SnapshotId: aws.String(snap)}); err != nil {
+			d.logger.Errorf("delete image error: deleting snapshot '%s': %s", snap, err)
+			return nil, err
+		}
+		d.logger.Verbosef("delete snapshot '%s' of image done", snap)
+	}
+
+	d.logger.Verbose("delete image done")
+	return output, nil
+}
+
 func (d *Ec2Driver) Create_Tags_DryRun(params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateTagsInput{}
 
@@ -574,18 +918,1547 @@ func (d *S3Driver) Create_Storageobject(params map[string]interface{}) (interfac
 	return output, nil
 }
 
-func buildIpPermissionsFromParams(params map[string]interface{}) ([]*ec2.IpPermission, error) {
-	if _, ok := params["cidr"].(string); !ok {
-		return nil, fmt.Errorf("invalid cidr '%v'", params["cidr"])
+// bucketUpdateParams are the params of 'update bucket', applied in this order
+// (the public access block is lifted before setting a public ACL)
+var bucketUpdateParams = []string{"publicaccessblock", "acl", "versioning", "encryption", "policy", "lifecycle"}
+
+// noneValue deletes the encryption, policy or lifecycle configuration of a bucket
+const noneValue = "none"
+
+// bucketEncryptionAlgorithms maps the values of the 'encryption' param to the SSE algorithms
+var bucketEncryptionAlgorithms = map[string]string{
+	"aes256": s3.ServerSideEncryptionAes256,
+	"kms":    s3.ServerSideEncryptionAwsKms,
+}
+
+func (d *S3Driver) Update_Bucket_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("update bucket: missing required params 'name'")
 	}
-	ipPerm := &ec2.IpPermission{
-		IpRanges: []*ec2.IpRange{{CidrIp: aws.String(params["cidr"].(string))}},
+	var found bool
+	for _, p := range bucketUpdateParams {
+		if _, ok := params[p]; ok {
+			found = true
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("update bucket: missing one of '%s' param", strings.Join(bucketUpdateParams, ", "))
+	}
+	if encryption, ok := params["encryption"]; ok && encryption != noneValue {
+		if _, ok := bucketEncryptionAlgorithms[fmt.Sprint(encryption)]; !ok {
+			return nil, fmt.Errorf("update bucket: invalid encryption '%v' (expected aes256, kms or %s)", encryption, noneValue)
+		}
+	}
+	if _, ok := params["encryptionkey"]; ok && params["encryption"] != "kms" {
+		return nil, errors.New("update bucket: 'encryptionkey' param requires 'encryption=kms'")
+	}
+	if block, ok := params["publicaccessblock"]; ok {
+		if _, err := strconv.ParseBool(fmt.Sprint(block)); err != nil {
+			return nil, fmt.Errorf("update bucket: invalid publicaccessblock '%v' (expected true or false)", block)
+		}
+	}
+	if policy, ok := params["policy"]; ok && policy != noneValue {
+		if _, err := readPolicyDocument(fmt.Sprint(policy)); err != nil {
+			return nil, fmt.Errorf("update bucket: %s", err)
+		}
+	}
+	if lifecycle, ok := params["lifecycle"]; ok && lifecycle != noneValue {
+		if _, err := readLifecycleConfiguration(fmt.Sprint(params["name"]), fmt.Sprint(lifecycle)); err != nil {
+			return nil, fmt.Errorf("update bucket: %s", err)
+		}
+	}
+	d.logger.Verbose("params dry run: update bucket ok")
+	return nil, nil
+}
+
+// Update_Bucket sets the public access block, ACL, versioning, default encryption, policy and lifecycle rules of a bucket
+func (d *S3Driver) Update_Bucket(params map[string]interface{}) (interface{}, error) {
+	bucket := aws.String(fmt.Sprint(params["name"]))
+
+	for _, p := range bucketUpdateParams {
+		v, ok := params[p]
+		if !ok {
+			continue
+		}
+		value := fmt.Sprint(v)
+
+		var operation string
+		var err error
+		start := time.Now()
+		switch {
+		case p == "publicaccessblock":
+			var block bool
+			if block, err = strconv.ParseBool(value); err != nil {
+				break
+			}
+			if block {
+				operation = "PutPublicAccessBlock"
+				_, err = d.PutPublicAccessBlock(&s3.PutPublicAccessBlockInput{Bucket: bucket, PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+					BlockPublicAcls: aws.Bool(true), IgnorePublicAcls: aws.Bool(true), BlockPublicPolicy: aws.Bool(true), RestrictPublicBuckets: aws.Bool(true),
+				}})
+			} else {
+				operation = "DeletePublicAccessBlock"
+				_, err = d.DeletePublicAccessBlock(&s3.DeletePublicAccessBlockInput{Bucket: bucket})
+			}
+		case p == "acl":
+			operation = "PutBucketAcl"
+			_, err = d.PutBucketAcl(&s3.PutBucketAclInput{Bucket: bucket, ACL: aws.String(value)})
+		case p == "versioning":
+			operation = "PutBucketVersioning"
+			_, err = d.PutBucketVersioning(&s3.PutBucketVersioningInput{Bucket: bucket, VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(strings.Title(value))}})
+		case p == "encryption" && value == noneValue:
+			operation = "DeleteBucketEncryption"
+			_, err = d.DeleteBucketEncryption(&s3.DeleteBucketEncryptionInput{Bucket: bucket})
+		case p == "encryption":
+			operation = "PutBucketEncryption"
+			byDefault := &s3.ServerSideEncryptionByDefault{SSEAlgorithm: aws.String(bucketEncryptionAlgorithms[value])}
+			if key, ok := params["encryptionkey"]; ok {
+				byDefault.KMSMasterKeyID = aws.String(fmt.Sprint(key))
+			}
+			_, err = d.PutBucketEncryption(&s3.PutBucketEncryptionInput{Bucket: bucket, ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+				Rules: []*s3.ServerSideEncryptionRule{{ApplyServerSideEncryptionByDefault: byDefault}},
+			}})
+		case p == "policy" && value == noneValue:
+			operation = "DeleteBucketPolicy"
+			_, err = d.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{Bucket: bucket})
+		case p == "policy":
+			operation = "PutBucketPolicy"
+			var policy string
+			if policy, err = readPolicyDocument(value); err == nil {
+				_, err = d.PutBucketPolicy(&s3.PutBucketPolicyInput{Bucket: bucket, Policy: aws.String(policy)})
+			}
+		case p == "lifecycle" && value == noneValue:
+			operation = "DeleteBucketLifecycle"
+			_, err = d.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{Bucket: bucket})
+		case p == "lifecycle":
+			operation = "PutBucketLifecycleConfiguration"
+			var input *s3.PutBucketLifecycleConfigurationInput
+			if input, err = readLifecycleConfiguration(aws.StringValue(bucket), value); err == nil {
+				_, err = d.PutBucketLifecycleConfiguration(input)
+			}
+		}
+		if err != nil {
+			d.logger.Errorf("update bucket error: s3.%s: %s", operation, err)
+			return nil, err
+		}
+		d.logger.ExtraVerbosef("s3.%s call took %s", operation, time.Since(start))
+	}
+
+	d.logger.Verbosef("update bucket '%s' done", aws.StringValue(bucket))
+	return nil, nil
+}
+
+// readLifecycleConfiguration reads the lifecycle configuration of a bucket from a local JSON file
+// formatted as in the AWS API (ex: {"Rules": [{"ID": "logs", "Prefix": "logs/", "Status": "Enabled", "Expiration": {"Days": 30}}]})
+func readLifecycleConfiguration(bucket, path string) (*s3.PutBucketLifecycleConfigurationInput, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	conf := &s3.BucketLifecycleConfiguration{}
+	if err = json.Unmarshal(b, conf); err != nil {
+		return nil, fmt.Errorf("invalid JSON lifecycle configuration '%s': %s", path, err)
+	}
+	input := &s3.PutBucketLifecycleConfigurationInput{Bucket: aws.String(bucket), LifecycleConfiguration: conf}
+	if err = input.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid lifecycle configuration '%s': %s", path, err)
+	}
+	return input, nil
+}
+
+func (d *LambdaDriver) Create_Function_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, name := range []string{"name", "handler", "role", "runtime", "zipfile"} {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("create function: missing required params '%s'", name)
+		}
+	}
+	if _, err := os.Stat(fmt.Sprint(params["zipfile"])); err != nil {
+		return nil, fmt.Errorf("create function: %s", err)
+	}
+	d.logger.Verbose("params dry run: create function ok")
+	return nil, nil
+}
+
+func (d *LambdaDriver) Create_Function(params map[string]interface{}) (interface{}, error) {
+	input := &lambda.CreateFunctionInput{}
+	var err error
+
+	zipped, err := zipFunctionCode(fmt.Sprint(params["zipfile"]))
+	if err != nil {
+		return nil, err
+	}
+	input.Code = &lambda.FunctionCode{ZipFile: zipped}
+
+	// Required params
+	for name, field := range map[string]string{"name": "FunctionName", "handler": "Handler", "role": "Role", "runtime": "Runtime"} {
+		if err = setFieldWithType(params[name], input, field, awsstr); err != nil {
+			return nil, err
+		}
+	}
+
+	// Extra params
+	if err = setFunctionConfigurationParams(params, input); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["publish"], input, "Publish", awsbool); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["subnets"], input, "VpcConfig.SubnetIds", awsstringslice); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["groups"], input, "VpcConfig.SecurityGroupIds", awsstringslice); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.CreateFunction(input)
+	if err != nil {
+		d.logger.Errorf("create function error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("lambda.CreateFunction call took %s", time.Since(start))
+	id := aws.StringValue(output.FunctionArn)
+	d.logger.Verbosef("create function '%s' done", id)
+	return id, nil
+}
+
+func (d *LambdaDriver) Update_Function_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("update function: missing required params 'id'")
+	}
+	if zipfile, ok := params["zipfile"]; ok {
+		if _, err := os.Stat(fmt.Sprint(zipfile)); err != nil {
+			return nil, fmt.Errorf("update function: %s", err)
+		}
 	}
-	if _, ok := params["protocol"].(string); !ok {
+	d.logger.Verbose("params dry run: update function ok")
+	return nil, nil
+}
+
+func (d *LambdaDriver) Update_Function(params map[string]interface{}) (interface{}, error) {
+	var output *lambda.FunctionConfiguration
+
+	if zipfile, ok := params["zipfile"]; ok {
+		zipped, err := zipFunctionCode(fmt.Sprint(zipfile))
+		if err != nil {
+			return nil, err
+		}
+		input := &lambda.UpdateFunctionCodeInput{ZipFile: zipped}
+		if err = setFieldWithType(params["id"], input, "FunctionName", awsstr); err != nil {
+			return nil, err
+		}
+		if err = setFieldWithType(params["publish"], input, "Publish", awsbool); err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		if output, err = d.UpdateFunctionCode(input); err != nil {
+			d.logger.Errorf("update function code error: %s", err)
+			return nil, err
+		}
+		d.logger.ExtraVerbosef("lambda.UpdateFunctionCode call took %s", time.Since(start))
+	}
+
+	input := &lambda.UpdateFunctionConfigurationInput{}
+	if err := setFunctionConfigurationParams(params, input); err != nil {
+		return nil, err
+	}
+	for name, field := range map[string]string{"handler": "Handler", "role": "Role", "runtime": "Runtime"} {
+		if err := setFieldWithType(params[name], input, field, awsstr); err != nil {
+			return nil, err
+		}
+	}
+	if !reflect.DeepEqual(input, &lambda.UpdateFunctionConfigurationInput{}) {
+		if err := setFieldWithType(params["id"], input, "FunctionName", awsstr); err != nil {
+			return nil, err
+		}
+		var err error
+		start := time.Now()
+		if output, err = d.UpdateFunctionConfiguration(input); err != nil {
+			d.logger.Errorf("update function configuration error: %s", err)
+			return nil, err
+		}
+		d.logger.ExtraVerbosef("lambda.UpdateFunctionConfiguration call took %s", time.Since(start))
+	}
+
+	if output == nil {
+		return nil, errors.New("update function: nothing to update")
+	}
+	d.logger.Verbose("update function done")
+	return output, nil
+}
+
+// setFunctionConfigurationParams sets the params common to
+// the creation and the update of a function configuration
+func setFunctionConfigurationParams(params map[string]interface{}, input interface{}) error {
+	if err := setFieldWithType(params["description"], input, "Description", awsstr); err != nil {
+		return err
+	}
+	if err := setFieldWithType(params["memory"], input, "MemorySize", awsint64); err != nil {
+		return err
+	}
+	return setFieldWithType(params["timeout"], input, "Timeout", awsint64)
+}
+
+// zipFunctionCode returns the content of a zip file, or zips in memory the content of a directory
+func zipFunctionCode(path string) ([]byte, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !stat.IsDir() {
+		return ioutil.ReadFile(path)
+	}
+
+	var buff bytes.Buffer
+	w := zip.NewWriter(&buff)
+	err = filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(path, file)
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		header.Method = zip.Deflate
+		f, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(content)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("zipping function code: %s", err)
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+func (d *LambdaDriver) Create_Eventsource_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["function"]; !ok {
+		return nil, errors.New("create eventsource: missing required params 'function'")
+	}
+	if _, ok := params["queue"]; !ok {
+		return nil, errors.New("create eventsource: missing required params 'queue'")
+	}
+	if _, err := sqsQueueArn(fmt.Sprint(params["queue"])); err != nil {
+		return nil, err
+	}
+	d.logger.Verbose("params dry run: create eventsource ok")
+	return nil, nil
+}
+
+func (d *LambdaDriver) Create_Eventsource(params map[string]interface{}) (interface{}, error) {
+	input := &lambda.CreateEventSourceMappingInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["function"], input, "FunctionName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	queueArn, err := sqsQueueArn(fmt.Sprint(params["queue"]))
+	if err != nil {
+		return nil, err
+	}
+	input.EventSourceArn = aws.String(queueArn)
+
+	// Extra params
+	if err = setFieldWithType(params["batchsize"], input, "BatchSize", awsint64); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["enabled"], input, "Enabled", awsbool); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.CreateEventSourceMapping(input)
+	if err != nil {
+		d.logger.Errorf("create eventsource error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("lambda.CreateEventSourceMapping call took %s", time.Since(start))
+	id := aws.StringValue(output.UUID)
+	d.logger.Verbosef("create eventsource '%s' done", id)
+	return id, nil
+}
+
+// sqsQueueArn returns the ARN of a queue given its ARN or its url
+// (i.e. https://sqs.REGION.amazonaws.com/ACCOUNT/NAME)
+func sqsQueueArn(queue string) (string, error) {
+	if strings.HasPrefix(queue, "arn:") {
+		return queue, nil
+	}
+	u, err := url.Parse(queue)
+	if err != nil {
+		return "", fmt.Errorf("invalid queue '%s': %s", queue, err)
+	}
+	host, path := strings.Split(u.Host, "."), strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(host) < 3 || host[0] != "sqs" || len(path) != 2 {
+		return "", fmt.Errorf("invalid queue '%s': expecting an ARN or an url https://sqs.REGION.amazonaws.com/ACCOUNT/NAME", queue)
+	}
+	return fmt.Sprintf("arn:aws:sqs:%s:%s:%s", host[1], path[0], path[1]), nil
+}
+
+func (d *Route53Driver) Create_Record_DryRun(params map[string]interface{}) (interface{}, error) {
+	return nil, d.recordParamsDryRun("create", params)
+}
+
+func (d *Route53Driver) Create_Record(params map[string]interface{}) (interface{}, error) {
+	return d.changeRecord(route53.ChangeActionCreate, params)
+}
+
+func (d *Route53Driver) Update_Record_DryRun(params map[string]interface{}) (interface{}, error) {
+	return nil, d.recordParamsDryRun("update", params)
+}
+
+func (d *Route53Driver) Update_Record(params map[string]interface{}) (interface{}, error) {
+	return d.changeRecord(route53.ChangeActionUpsert, params)
+}
+
+func (d *Route53Driver) Delete_Record_DryRun(params map[string]interface{}) (interface{}, error) {
+	return nil, d.recordParamsDryRun("delete", params)
+}
+
+func (d *Route53Driver) Delete_Record(params map[string]interface{}) (interface{}, error) {
+	return d.changeRecord(route53.ChangeActionDelete, params)
+}
+
+func (d *Route53Driver) recordParamsDryRun(action string, params map[string]interface{}) error {
+	for _, name := range []string{"zone", "name", "type", "value", "ttl"} {
+		if _, ok := params[name]; !ok {
+			return fmt.Errorf("%s record: missing required params '%s'", action, name)
+		}
+	}
+	d.logger.Verbosef("params dry run: %s record ok", action)
+	return nil
+}
+
+// changeRecord submits a change batch of a single change on a record set
+// and returns the id of the change
+func (d *Route53Driver) changeRecord(action string, params map[string]interface{}) (interface{}, error) {
+	recordSet := &route53.ResourceRecordSet{}
+	var err error
+
+	if err = setFieldWithType(params["name"], recordSet, "Name", awsstr); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["type"], recordSet, "Type", awsstr); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["ttl"], recordSet, "TTL", awsint64); err != nil {
+		return nil, err
+	}
+	var values []string
+	switch vv := params["value"].(type) {
+	case []string:
+		values = vv
+	default:
+		values = []string{fmt.Sprint(vv)}
+	}
+	for _, v := range values {
+		recordSet.ResourceRecords = append(recordSet.ResourceRecords, &route53.ResourceRecord{Value: aws.String(v)})
+	}
+
+	input := &route53.ChangeResourceRecordSetsInput{
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{{Action: aws.String(action), ResourceRecordSet: recordSet}},
+		},
+	}
+	if err = setFieldWithType(params["zone"], input, "HostedZoneId", awsstr); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["comment"], input, "ChangeBatch.Comment", awsstr); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.ChangeResourceRecordSets(input)
+	if err != nil {
+		d.logger.Errorf("%s record error: %s", strings.ToLower(action), err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
+	id := aws.StringValue(output.ChangeInfo.Id)
+	d.logger.Verbosef("%s record '%s' submitted", strings.ToLower(action), id)
+	return id, nil
+}
+
+func (d *Route53Driver) Check_Record_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, val := range []string{"id", "timeout"} {
+		if _, ok := params[val]; !ok {
+			err := fmt.Errorf("check record error: missing required param '%s'", val)
+			d.logger.Errorf("%s", err)
+			return nil, err
+		}
+	}
+	if _, ok := params["timeout"].(int); !ok {
+		return nil, errors.New("check record: timeout param is not int")
+	}
+	d.logger.Verbose("params dry run: check record ok")
+	return nil, nil
+}
+
+func (d *Route53Driver) Check_Record(params map[string]interface{}) (interface{}, error) {
+	input := &route53.GetChangeInput{}
+
+	// Required params
+	err := setFieldWithType(params["id"], input, "Id", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := time.Duration(params["timeout"].(int)) * time.Second
+	timer := time.NewTimer(timeout)
+	retry := 5 * time.Second
+	for {
+		select {
+		case <-time.After(retry):
+			output, err := d.GetChange(input)
+			if err != nil {
+				d.logger.Errorf("check record error: %s", err)
+				return nil, err
+			}
+			status := aws.StringValue(output.ChangeInfo.Status)
+			if status == route53.ChangeStatusInsync {
+				d.logger.Verbosef("check record change status '%s' done", status)
+				timer.Stop()
+				return nil, nil
+			}
+			d.logger.Infof("record change status '%s', expect '%s', retry in %s (timeout %s).", status, route53.ChangeStatusInsync, retry, timeout)
+		case <-timer.C:
+			err := fmt.Errorf("timeout of %s expired", timeout)
+			d.logger.Errorf("%s", err)
+			return nil, err
+		}
+	}
+}
+
+// scalingGroupUpdates map the params of an update of a scaling group to their fields,
+// named the same in the update input and in the group
+var scalingGroupUpdates = []struct {
+	param, field string
+	typ          int
+}{
+	{"launchconfiguration", "LaunchConfigurationName", awsstr},
+	{"maxsize", "MaxSize", awsint64},
+	{"minsize", "MinSize", awsint64},
+	{"desiredcapacity", "DesiredCapacity", awsint64},
+	{"cooldown", "DefaultCooldown", awsint64},
+	{"healthcheckgraceperiod", "HealthCheckGracePeriod", awsint64},
+	{"healthchecktype", "HealthCheckType", awsstr},
+	{"newinstancesprotected", "NewInstancesProtectedFromScaleIn", awsbool},
+	{"subnets", "VPCZoneIdentifier", awscsvstr},
+}
+
+func (d *AutoscalingDriver) Update_Scalinggroup_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("update scalinggroup: missing required params 'name'")
+	}
+	d.logger.Verbose("params dry run: update scalinggroup ok")
+	return nil, nil
+}
+
+// Update_Scalinggroup returns the previous values of the updated params (ex: 'minsize=1 desiredcapacity=2')
+// so that the update can be reverted
+func (d *AutoscalingDriver) Update_Scalinggroup(params map[string]interface{}) (interface{}, error) {
+	group, err := d.getScalingGroup(fmt.Sprint(params["name"]))
+	if err != nil {
+		d.logger.Errorf("update scalinggroup error: %s", err)
+		return nil, err
+	}
+	if group == nil {
+		err = fmt.Errorf("update scalinggroup: scaling group '%v' not found", params["name"])
+		d.logger.Errorf("%s", err)
+		return nil, err
+	}
+
+	input := &autoscaling.UpdateAutoScalingGroupInput{AutoScalingGroupName: group.AutoScalingGroupName}
+	var previous []string
+	for _, u := range scalingGroupUpdates {
+		v, ok := params[u.param]
+		if !ok {
+			continue
+		}
+		if err = setFieldWithType(v, input, u.field, u.typ); err != nil {
+			return nil, err
+		}
+		if old := reflect.ValueOf(group).Elem().FieldByName(u.field); old.Kind() == reflect.Ptr && !old.IsNil() {
+			previous = append(previous, fmt.Sprintf("%s=%v", u.param, old.Elem().Interface()))
+		}
+	}
+
+	start := time.Now()
+	if _, err = d.UpdateAutoScalingGroup(input); err != nil {
+		d.logger.Errorf("update scalinggroup error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("autoscaling.UpdateAutoScalingGroup call took %s", time.Since(start))
+	d.logger.Verbosef("update scalinggroup '%s' done", aws.StringValue(group.AutoScalingGroupName))
+
+	return strings.Join(previous, " "), nil
+}
+
+func (d *AutoscalingDriver) Check_Scalinggroup_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, val := range []string{"id", "count", "timeout"} {
+		if _, ok := params[val]; !ok {
+			err := fmt.Errorf("check scalinggroup error: missing required param '%s'", val)
+			d.logger.Errorf("%s", err)
+			return nil, err
+		}
+	}
+	for _, val := range []string{"count", "timeout"} {
+		if _, ok := params[val].(int); !ok {
+			return nil, fmt.Errorf("check scalinggroup: %s param is not int", val)
+		}
+	}
+	d.logger.Verbose("params dry run: check scalinggroup ok")
+	return nil, nil
+}
+
+// Check_Scalinggroup waits for a scaling group to have count instances in service.
+// A group being deleted is only considered empty once it is gone.
+func (d *AutoscalingDriver) Check_Scalinggroup(params map[string]interface{}) (interface{}, error) {
+	name := fmt.Sprint(params["id"])
+	count := params["count"].(int)
+	timeout := time.Duration(params["timeout"].(int)) * time.Second
+	timer := time.NewTimer(timeout)
+	retry := 5 * time.Second
+	for {
+		select {
+		case <-time.After(retry):
+			group, err := d.getScalingGroup(name)
+			if err != nil {
+				d.logger.Errorf("check scalinggroup error: %s", err)
+				return nil, err
+			}
+			var inService int
+			if group != nil {
+				for _, inst := range group.Instances {
+					if aws.StringValue(inst.LifecycleState) == autoscaling.LifecycleStateInService {
+						inService++
+					}
+				}
+			}
+			if (group == nil && count == 0) || (group != nil && aws.StringValue(group.Status) == "" && inService == count) {
+				d.logger.Verbosef("check scalinggroup '%s' with %d instances in service done", name, count)
+				timer.Stop()
+				return nil, nil
+			}
+			if group == nil {
+				d.logger.Infof("scaling group '%s' not found, expect %d instances in service, retry in %s (timeout %s).", name, count, retry, timeout)
+			} else {
+				d.logger.Infof("scaling group '%s' has %d instances in service, expect %d, retry in %s (timeout %s).", name, inService, count, retry, timeout)
+			}
+		case <-timer.C:
+			err := fmt.Errorf("timeout of %s expired", timeout)
+			d.logger.Errorf("%s", err)
+			return nil, err
+		}
+	}
+}
+
+// getScalingGroup returns the scaling group of the given name, or nil when not found
+func (d *AutoscalingDriver) getScalingGroup(name string) (*autoscaling.Group, error) {
+	out, err := d.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{AutoScalingGroupNames: []*string{aws.String(name)}})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.AutoScalingGroups) == 0 {
+		return nil, nil
+	}
+	return out.AutoScalingGroups[0], nil
+}
+
+func (d *CloudwatchDriver) Create_Alarm_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, name := range []string{"name", "operator", "metric", "namespace", "evaluationperiods", "period", "statistic", "threshold"} {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("create alarm: missing required params '%s'", name)
+		}
+	}
+	if _, err := strconv.ParseFloat(fmt.Sprint(params["threshold"]), 64); err != nil {
+		return nil, fmt.Errorf("create alarm: invalid threshold '%v'", params["threshold"])
+	}
+	if _, err := alarmDimensions(params["dimensions"]); err != nil {
+		return nil, fmt.Errorf("create alarm: %s", err)
+	}
+	d.logger.Verbose("params dry run: create alarm ok")
+	return nil, nil
+}
+
+func (d *CloudwatchDriver) Create_Alarm(params map[string]interface{}) (interface{}, error) {
+	input := &cloudwatch.PutMetricAlarmInput{}
+	var err error
+
+	// Required params
+	for name, field := range map[string]string{"name": "AlarmName", "operator": "ComparisonOperator", "metric": "MetricName", "namespace": "Namespace", "statistic": "Statistic"} {
+		if err = setFieldWithType(params[name], input, field, awsstr); err != nil {
+			return nil, err
+		}
+	}
+	for name, field := range map[string]string{"evaluationperiods": "EvaluationPeriods", "period": "Period"} {
+		if err = setFieldWithType(params[name], input, field, awsint64); err != nil {
+			return nil, err
+		}
+	}
+	threshold, err := strconv.ParseFloat(fmt.Sprint(params["threshold"]), 64)
+	if err != nil {
+		return nil, fmt.Errorf("create alarm: invalid threshold '%v'", params["threshold"])
+	}
+	input.Threshold = aws.Float64(threshold)
+
+	// Extra params
+	if err = setFieldWithType(params["enabled"], input, "ActionsEnabled", awsbool); err != nil {
+		return nil, err
+	}
+	for name, field := range map[string]string{"alarmactions": "AlarmActions", "insufficientdataactions": "InsufficientDataActions", "okactions": "OKActions"} {
+		if err = setFieldWithType(params[name], input, field, awsstringslice); err != nil {
+			return nil, err
+		}
+	}
+	for name, field := range map[string]string{"description": "AlarmDescription", "unit": "Unit"} {
+		if err = setFieldWithType(params[name], input, field, awsstr); err != nil {
+			return nil, err
+		}
+	}
+	if input.Dimensions, err = alarmDimensions(params["dimensions"]); err != nil {
+		return nil, fmt.Errorf("create alarm: %s", err)
+	}
+
+	start := time.Now()
+	if _, err = d.PutMetricAlarm(input); err != nil {
+		d.logger.Errorf("create alarm error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("cloudwatch.PutMetricAlarm call took %s", time.Since(start))
+	id := aws.StringValue(input.AlarmName)
+	d.logger.Verbosef("create alarm '%s' done", id)
+	return id, nil
+}
+
+// alarmDimensions builds the dimensions of a metric given as Name:Value (ex: InstanceId:i-12345678)
+func alarmDimensions(v interface{}) ([]*cloudwatch.Dimension, error) {
+	var all []string
+	switch vv := v.(type) {
+	case nil:
+		return nil, nil
+	case []string:
+		all = vv
+	default:
+		all = []string{fmt.Sprint(v)}
+	}
+	var dimensions []*cloudwatch.Dimension
+	for _, dim := range all {
+		splits := strings.SplitN(dim, ":", 2)
+		if len(splits) != 2 || splits[0] == "" || splits[1] == "" {
+			return nil, fmt.Errorf("invalid dimension '%s': expecting Name:Value", dim)
+		}
+		dimensions = append(dimensions, &cloudwatch.Dimension{Name: aws.String(splits[0]), Value: aws.String(splits[1])})
+	}
+	return dimensions, nil
+}
+
+func (d *CloudformationDriver) Create_Stack_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, name := range []string{"name", "templatefile"} {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("create stack: missing required params '%s'", name)
+		}
+	}
+	if _, err := readStackTemplate(fmt.Sprint(params["templatefile"])); err != nil {
+		return nil, fmt.Errorf("create stack: %s", err)
+	}
+	if _, err := stackParameters(params["parameters"]); err != nil {
+		return nil, fmt.Errorf("create stack: %s", err)
+	}
+	d.logger.Verbose("params dry run: create stack ok")
+	return nil, nil
+}
+
+func (d *CloudformationDriver) Create_Stack(params map[string]interface{}) (interface{}, error) {
+	input := &cloudformation.CreateStackInput{}
+	var err error
+
+	// Required params
+	if err = setFieldWithType(params["name"], input, "StackName", awsstr); err != nil {
+		return nil, err
+	}
+	body, err := readStackTemplate(fmt.Sprint(params["templatefile"]))
+	if err != nil {
+		return nil, fmt.Errorf("create stack: %s", err)
+	}
+	input.TemplateBody = aws.String(body)
+
+	// Extra params
+	if input.Parameters, err = stackParameters(params["parameters"]); err != nil {
+		return nil, fmt.Errorf("create stack: %s", err)
+	}
+	if err = setStackConfigurationParams(params, input); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["disablerollback"], input, "DisableRollback", awsbool); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["timeout"], input, "TimeoutInMinutes", awsint64); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.CreateStack(input)
+	if err != nil {
+		d.logger.Errorf("create stack error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("cloudformation.CreateStack call took %s", time.Since(start))
+	id := aws.StringValue(output.StackId)
+	d.logger.Verbosef("create stack '%s' submitted", id)
+	return id, nil
+}
+
+func (d *CloudformationDriver) Update_Stack_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("update stack: missing required params 'id'")
+	}
+	if templatefile, ok := params["templatefile"]; ok {
+		if _, err := readStackTemplate(fmt.Sprint(templatefile)); err != nil {
+			return nil, fmt.Errorf("update stack: %s", err)
+		}
+	}
+	if _, err := stackParameters(params["parameters"]); err != nil {
+		return nil, fmt.Errorf("update stack: %s", err)
+	}
+	d.logger.Verbose("params dry run: update stack ok")
+	return nil, nil
+}
+
+// Update_Stack keeps the current template when no templatefile is given,
+// and the current value of the parameters not given
+func (d *CloudformationDriver) Update_Stack(params map[string]interface{}) (interface{}, error) {
+	input := &cloudformation.UpdateStackInput{}
+	var err error
+
+	// Required params
+	if err = setFieldWithType(params["id"], input, "StackName", awsstr); err != nil {
+		return nil, err
+	}
+	stack, err := d.getStack(aws.StringValue(input.StackName))
+	if err != nil {
+		d.logger.Errorf("update stack error: %s", err)
+		return nil, err
+	}
+
+	// Extra params
+	if templatefile, ok := params["templatefile"]; ok {
+		body, err := readStackTemplate(fmt.Sprint(templatefile))
+		if err != nil {
+			return nil, fmt.Errorf("update stack: %s", err)
+		}
+		input.TemplateBody = aws.String(body)
+	} else {
+		input.UsePreviousTemplate = aws.Bool(true)
+	}
+	if input.Parameters, err = stackParameters(params["parameters"]); err != nil {
+		return nil, fmt.Errorf("update stack: %s", err)
+	}
+	given := make(map[string]bool)
+	for _, p := range input.Parameters {
+		given[aws.StringValue(p.ParameterKey)] = true
+	}
+	for _, p := range stack.Parameters {
+		if key := aws.StringValue(p.ParameterKey); !given[key] {
+			input.Parameters = append(input.Parameters, &cloudformation.Parameter{ParameterKey: aws.String(key), UsePreviousValue: aws.Bool(true)})
+		}
+	}
+	if err = setStackConfigurationParams(params, input); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.UpdateStack(input)
+	if err != nil {
+		d.logger.Errorf("update stack error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("cloudformation.UpdateStack call took %s", time.Since(start))
+	id := aws.StringValue(output.StackId)
+	d.logger.Verbosef("update stack '%s' submitted", id)
+	return id, nil
+}
+
+// setStackConfigurationParams sets the params common to
+// the creation and the update of a stack
+func setStackConfigurationParams(params map[string]interface{}, input interface{}) error {
+	for name, field := range map[string]string{"capabilities": "Capabilities", "notifications": "NotificationARNs"} {
+		if err := setFieldWithType(params[name], input, field, awsstringslice); err != nil {
+			return err
+		}
+	}
+	return setFieldWithType(params["role"], input, "RoleARN", awsstr)
+}
+
+func (d *CloudformationDriver) Check_Stack_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, val := range []string{"id", "state", "timeout"} {
+		if _, ok := params[val]; !ok {
+			err := fmt.Errorf("check stack error: missing required param '%s'", val)
+			d.logger.Errorf("%s", err)
+			return nil, err
+		}
+	}
+	if _, ok := params["timeout"].(int); !ok {
+		return nil, errors.New("check stack: timeout param is not int")
+	}
+	d.logger.Verbose("params dry run: check stack ok")
+	return nil, nil
+}
+
+// Check_Stack waits for a stack to reach the expected state. It fails as soon as
+// the stack reaches another terminal state (ex: ROLLBACK_COMPLETE when CREATE_COMPLETE is expected).
+// A stack no longer found is considered in DELETE_COMPLETE state.
+func (d *CloudformationDriver) Check_Stack(params map[string]interface{}) (interface{}, error) {
+	id := fmt.Sprint(params["id"])
+	expected := fmt.Sprint(params["state"])
+	timeout := time.Duration(params["timeout"].(int)) * time.Second
+	timer := time.NewTimer(timeout)
+	retry := 5 * time.Second
+	for {
+		select {
+		case <-time.After(retry):
+			stack, err := d.getStack(id)
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ValidationError" && strings.Contains(awsErr.Message(), "does not exist") {
+				stack, err = &cloudformation.Stack{StackStatus: aws.String(cloudformation.StackStatusDeleteComplete)}, nil
+			}
+			if err != nil {
+				d.logger.Errorf("check stack error: %s", err)
+				return nil, err
+			}
+			status := aws.StringValue(stack.StackStatus)
+			if status == expected {
+				d.logger.Verbosef("check stack status '%s' done", status)
+				timer.Stop()
+				return nil, nil
+			}
+			if !strings.HasSuffix(status, "_IN_PROGRESS") {
+				err := fmt.Errorf("check stack error: stack '%s' is '%s', expect '%s'", id, status, expected)
+				if reason := aws.StringValue(stack.StackStatusReason); reason != "" {
+					err = fmt.Errorf("%s: %s", err, reason)
+				}
+				d.logger.Errorf("%s", err)
+				return nil, err
+			}
+			d.logger.Infof("stack status '%s', expect '%s', retry in %s (timeout %s).", status, expected, retry, timeout)
+		case <-timer.C:
+			err := fmt.Errorf("timeout of %s expired", timeout)
+			d.logger.Errorf("%s", err)
+			return nil, err
+		}
+	}
+}
+
+func (d *CloudformationDriver) getStack(id string) (*cloudformation.Stack, error) {
+	out, err := d.DescribeStacks(&cloudformation.DescribeStacksInput{StackName: aws.String(id)})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Stacks) == 0 {
+		return nil, fmt.Errorf("stack '%s' not found", id)
+	}
+	return out.Stacks[0], nil
+}
+
+// maxStackTemplateSize is the maximum size of a template passed in the body of a request
+const maxStackTemplateSize = 51200
+
+// readStackTemplate reads a local CloudFormation template, as JSON or YAML
+func readStackTemplate(path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	if len(b) > maxStackTemplateSize {
+		return "", fmt.Errorf("template '%s' exceeds %d bytes", path, maxStackTemplateSize)
+	}
+	return string(b), nil
+}
+
+// stackParameters builds the parameters of a stack given as Key:Value (ex: InstanceType:t2.micro)
+func stackParameters(v interface{}) ([]*cloudformation.Parameter, error) {
+	var all []string
+	switch vv := v.(type) {
+	case nil:
+		return nil, nil
+	case []string:
+		all = vv
+	default:
+		all = []string{fmt.Sprint(v)}
+	}
+	var parameters []*cloudformation.Parameter
+	for _, param := range all {
+		splits := strings.SplitN(param, ":", 2)
+		if len(splits) != 2 || splits[0] == "" {
+			return nil, fmt.Errorf("invalid parameter '%s': expecting Key:Value", param)
+		}
+		parameters = append(parameters, &cloudformation.Parameter{ParameterKey: aws.String(splits[0]), ParameterValue: aws.String(splits[1])})
+	}
+	return parameters, nil
+}
+
+func (d *EcsDriver) Create_Service_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, name := range []string{"cluster", "name", "taskdefinition", "desiredcount"} {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("create service: missing required params '%s'", name)
+		}
+	}
+	if _, ok := params["targetgroup"]; ok {
+		for _, name := range []string{"containername", "containerport"} {
+			if _, ok := params[name]; !ok {
+				return nil, fmt.Errorf("create service: missing params '%s' to register the containers in the target group", name)
+			}
+		}
+	}
+	d.logger.Verbose("params dry run: create service ok")
+	return nil, nil
+}
+
+func (d *EcsDriver) Create_Service(params map[string]interface{}) (interface{}, error) {
+	input := &ecs.CreateServiceInput{}
+	var err error
+
+	// Required params
+	if err = setFieldWithType(params["cluster"], input, "Cluster", awsstr); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["name"], input, "ServiceName", awsstr); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["taskdefinition"], input, "TaskDefinition", awsstr); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["desiredcount"], input, "DesiredCount", awsint64); err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if err = setFieldWithType(params["role"], input, "Role", awsstr); err != nil {
+		return nil, err
+	}
+	if targetGroup, ok := params["targetgroup"]; ok {
+		lb := &ecs.LoadBalancer{}
+		if err = setFieldWithType(targetGroup, lb, "TargetGroupArn", awsstr); err != nil {
+			return nil, err
+		}
+		if err = setFieldWithType(params["containername"], lb, "ContainerName", awsstr); err != nil {
+			return nil, err
+		}
+		if err = setFieldWithType(params["containerport"], lb, "ContainerPort", awsint64); err != nil {
+			return nil, err
+		}
+		input.LoadBalancers = []*ecs.LoadBalancer{lb}
+	}
+	if err = setFieldWithType(params["deploymentmaxpercent"], input, "DeploymentConfiguration.MaximumPercent", awsint64); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["deploymentminpercent"], input, "DeploymentConfiguration.MinimumHealthyPercent", awsint64); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.CreateService(input)
+	if err != nil {
+		d.logger.Errorf("create service error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ecs.CreateService call took %s", time.Since(start))
+	id := aws.StringValue(output.Service.ServiceArn)
+	d.logger.Verbosef("create service '%s' done", id)
+	return id, nil
+}
+
+func (d *EcsDriver) Delete_Service_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, name := range []string{"cluster", "id"} {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("delete service: missing required params '%s'", name)
+		}
+	}
+	d.logger.Verbose("params dry run: delete service ok")
+	return nil, nil
+}
+
+// Delete_Service scales the service down to 0 tasks first, as ECS refuses to delete a service running tasks
+func (d *EcsDriver) Delete_Service(params map[string]interface{}) (interface{}, error) {
+	cluster, service := aws.String(fmt.Sprint(params["cluster"])), aws.String(fmt.Sprint(params["id"]))
+
+	start := time.Now()
+	if _, err := d.UpdateService(&ecs.UpdateServiceInput{Cluster: cluster, Service: service, DesiredCount: aws.Int64(0)}); err != nil {
+		d.logger.Errorf("delete service error: scaling down: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ecs.UpdateService call took %s", time.Since(start))
+
+	start = time.Now()
+	if _, err := d.DeleteService(&ecs.DeleteServiceInput{Cluster: cluster, Service: service}); err != nil {
+		d.logger.Errorf("delete service error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ecs.DeleteService call took %s", time.Since(start))
+	d.logger.Verbosef("delete service '%s' done", aws.StringValue(service))
+	return nil, nil
+}
+
+func (d *EcsDriver) Start_Task_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, name := range []string{"cluster", "taskdefinition"} {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("start task: missing required params '%s'", name)
+		}
+	}
+	d.logger.Verbose("params dry run: start task ok")
+	return nil, nil
+}
+
+// Start_Task runs one task and fails when ECS cannot place it (ex: no container instance with enough resources)
+func (d *EcsDriver) Start_Task(params map[string]interface{}) (interface{}, error) {
+	input := &ecs.RunTaskInput{Count: aws.Int64(1)}
+	var err error
+
+	// Required params
+	if err = setFieldWithType(params["cluster"], input, "Cluster", awsstr); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["taskdefinition"], input, "TaskDefinition", awsstr); err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if err = setFieldWithType(params["group"], input, "Group", awsstr); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["startedby"], input, "StartedBy", awsstr); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.RunTask(input)
+	if err != nil {
+		d.logger.Errorf("start task error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ecs.RunTask call took %s", time.Since(start))
+	if len(output.Failures) > 0 {
+		failure := output.Failures[0]
+		err = fmt.Errorf("start task: %s: %s", aws.StringValue(failure.Reason), aws.StringValue(failure.Arn))
+		d.logger.Errorf("start task error: %s", err)
+		return nil, err
+	}
+	if len(output.Tasks) == 0 {
+		return nil, errors.New("start task: no task started")
+	}
+	id := aws.StringValue(output.Tasks[0].TaskArn)
+	d.logger.Verbosef("start task '%s' done", id)
+	return id, nil
+}
+
+func (d *DynamodbDriver) Create_Table_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, name := range []string{"name", "hashkey", "readcapacity", "writecapacity"} {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("create table: missing required params '%s'", name)
+		}
+	}
+	for _, name := range []string{"hashkey", "rangekey"} {
+		if key, ok := params[name]; ok {
+			if _, _, err := tableKey(key); err != nil {
+				return nil, fmt.Errorf("create table: %s", err)
+			}
+		}
+	}
+	d.logger.Verbose("params dry run: create table ok")
+	return nil, nil
+}
+
+func (d *DynamodbDriver) Create_Table(params map[string]interface{}) (interface{}, error) {
+	input := &dynamodb.CreateTableInput{}
+	var err error
+
+	// Required params
+	if err = setFieldWithType(params["name"], input, "TableName", awsstr); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["readcapacity"], input, "ProvisionedThroughput.ReadCapacityUnits", awsint64); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["writecapacity"], input, "ProvisionedThroughput.WriteCapacityUnits", awsint64); err != nil {
+		return nil, err
+	}
+	keys := []struct {
+		param, keyType string
+	}{
+		{"hashkey", dynamodb.KeyTypeHash},
+		{"rangekey", dynamodb.KeyTypeRange},
+	}
+	for _, key := range keys {
+		v, ok := params[key.param]
+		if !ok {
+			continue
+		}
+		name, attrType, err := tableKey(v)
+		if err != nil {
+			return nil, fmt.Errorf("create table: %s", err)
+		}
+		input.AttributeDefinitions = append(input.AttributeDefinitions, &dynamodb.AttributeDefinition{AttributeName: aws.String(name), AttributeType: aws.String(attrType)})
+		input.KeySchema = append(input.KeySchema, &dynamodb.KeySchemaElement{AttributeName: aws.String(name), KeyType: aws.String(key.keyType)})
+	}
+
+	// Extra params
+	if view, ok := params["streamview"]; ok {
+		input.StreamSpecification = &dynamodb.StreamSpecification{StreamEnabled: aws.Bool(true), StreamViewType: aws.String(fmt.Sprint(view))}
+	}
+
+	start := time.Now()
+	output, err := d.CreateTable(input)
+	if err != nil {
+		d.logger.Errorf("create table error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("dynamodb.CreateTable call took %s", time.Since(start))
+	id := aws.StringValue(output.TableDescription.TableName)
+	d.logger.Verbosef("create table '%s' done", id)
+	return id, nil
+}
+
+// tableKey parses a key of a table given as name:type (ex: id:S)
+func tableKey(v interface{}) (string, string, error) {
+	key := fmt.Sprint(v)
+	splits := strings.Split(key, ":")
+	if len(splits) != 2 || splits[0] == "" {
+		return "", "", fmt.Errorf("invalid key '%s': expecting name:type", key)
+	}
+	switch splits[1] {
+	case dynamodb.ScalarAttributeTypeS, dynamodb.ScalarAttributeTypeN, dynamodb.ScalarAttributeTypeB:
+		return splits[0], splits[1], nil
+	default:
+		return "", "", fmt.Errorf("invalid key '%s': type must be S, N or B", key)
+	}
+}
+
+func (d *DynamodbDriver) Check_Table_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, val := range []string{"id", "state", "timeout"} {
+		if _, ok := params[val]; !ok {
+			err := fmt.Errorf("check table error: missing required param '%s'", val)
+			d.logger.Errorf("%s", err)
+			return nil, err
+		}
+	}
+	if _, ok := params["timeout"].(int); !ok {
+		return nil, errors.New("check table: timeout param is not int")
+	}
+	d.logger.Verbose("params dry run: check table ok")
+	return nil, nil
+}
+
+// Check_Table waits for a table to reach the expected state.
+// A table no longer found is considered in 'not-found' state.
+func (d *DynamodbDriver) Check_Table(params map[string]interface{}) (interface{}, error) {
+	id := fmt.Sprint(params["id"])
+	expected := fmt.Sprint(params["state"])
+	timeout := time.Duration(params["timeout"].(int)) * time.Second
+	timer := time.NewTimer(timeout)
+	retry := 5 * time.Second
+	for {
+		select {
+		case <-time.After(retry):
+			var status string
+			out, err := d.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String(id)})
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeResourceNotFoundException {
+				status, err = "not-found", nil
+			} else if err == nil {
+				status = aws.StringValue(out.Table.TableStatus)
+			}
+			if err != nil {
+				d.logger.Errorf("check table error: %s", err)
+				return nil, err
+			}
+			if status == expected {
+				d.logger.Verbosef("check table status '%s' done", status)
+				timer.Stop()
+				return nil, nil
+			}
+			d.logger.Infof("table status '%s', expect '%s', retry in %s (timeout %s).", status, expected, retry, timeout)
+		case <-timer.C:
+			err := fmt.Errorf("timeout of %s expired", timeout)
+			d.logger.Errorf("%s", err)
+			return nil, err
+		}
+	}
+}
+
+func (d *ElbDriver) Attach_Classicloadbalancer_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, name := range []string{"name", "instances"} {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("attach classicloadbalancer: missing required params '%s'", name)
+		}
+	}
+	d.logger.Verbose("params dry run: attach classicloadbalancer ok")
+	return nil, nil
+}
+
+// Attach_Classicloadbalancer registers instances with a classic load balancer
+func (d *ElbDriver) Attach_Classicloadbalancer(params map[string]interface{}) (interface{}, error) {
+	input := &elb.RegisterInstancesWithLoadBalancerInput{Instances: elbInstances(params["instances"])}
+	if err := setFieldWithType(params["name"], input, "LoadBalancerName", awsstr); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.RegisterInstancesWithLoadBalancer(input)
+	if err != nil {
+		d.logger.Errorf("attach classicloadbalancer error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("elb.RegisterInstancesWithLoadBalancer call took %s", time.Since(start))
+	d.logger.Verbosef("attach classicloadbalancer '%s' done: %d registered instance(s)", aws.StringValue(input.LoadBalancerName), len(output.Instances))
+	return nil, nil
+}
+
+func (d *ElbDriver) Detach_Classicloadbalancer_DryRun(params map[string]interface{}) (interface{}, error) {
+	for _, name := range []string{"name", "instances"} {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("detach classicloadbalancer: missing required params '%s'", name)
+		}
+	}
+	d.logger.Verbose("params dry run: detach classicloadbalancer ok")
+	return nil, nil
+}
+
+// Detach_Classicloadbalancer deregisters instances from a classic load balancer
+func (d *ElbDriver) Detach_Classicloadbalancer(params map[string]interface{}) (interface{}, error) {
+	input := &elb.DeregisterInstancesFromLoadBalancerInput{Instances: elbInstances(params["instances"])}
+	if err := setFieldWithType(params["name"], input, "LoadBalancerName", awsstr); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.DeregisterInstancesFromLoadBalancer(input)
+	if err != nil {
+		d.logger.Errorf("detach classicloadbalancer error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("elb.DeregisterInstancesFromLoadBalancer call took %s", time.Since(start))
+	d.logger.Verbosef("detach classicloadbalancer '%s' done: %d remaining instance(s)", aws.StringValue(input.LoadBalancerName), len(output.Instances))
+	return nil, nil
+}
+
+// elbInstances builds the instances of a classic load balancer from one or several instance ids
+func elbInstances(v interface{}) []*elb.Instance {
+	var ids []string
+	switch vv := v.(type) {
+	case nil:
+		return nil
+	case []string:
+		ids = vv
+	default:
+		ids = strings.Split(fmt.Sprint(v), ",")
+	}
+	var instances []*elb.Instance
+	for _, id := range ids {
+		instances = append(instances, &elb.Instance{InstanceId: aws.String(strings.TrimSpace(id))})
+	}
+	return instances
+}
+
+var queueAttributeUpdates = []struct {
+	param, attribute string
+}{
+	{"delay", sqs.QueueAttributeNameDelaySeconds},
+	{"maxMsgSize", sqs.QueueAttributeNameMaximumMessageSize},
+	{"retentionPeriod", sqs.QueueAttributeNameMessageRetentionPeriod},
+	{"msgWait", sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds},
+	{"visibilityTimeout", sqs.QueueAttributeNameVisibilityTimeout},
+}
+
+func (d *SqsDriver) Update_Queue_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["url"]; !ok {
+		return nil, errors.New("update queue: missing required params 'url'")
+	}
+	if _, err := queueAttributes(params); err != nil {
+		return nil, fmt.Errorf("update queue: %s", err)
+	}
+	d.logger.Verbose("params dry run: update queue ok")
+	return nil, nil
+}
+
+// Update_Queue sets the attributes of a queue, its redrive policy being built from
+// the dead letter queue and the number of receives before moving messages to it
+func (d *SqsDriver) Update_Queue(params map[string]interface{}) (interface{}, error) {
+	input := &sqs.SetQueueAttributesInput{}
+	var err error
+	if err = setFieldWithType(params["url"], input, "QueueUrl", awsstr); err != nil {
+		return nil, err
+	}
+	if input.Attributes, err = queueAttributes(params); err != nil {
+		return nil, fmt.Errorf("update queue: %s", err)
+	}
+
+	start := time.Now()
+	if _, err = d.SetQueueAttributes(input); err != nil {
+		d.logger.Errorf("update queue error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("sqs.SetQueueAttributes call took %s", time.Since(start))
+	d.logger.Verbosef("update queue '%s' done", aws.StringValue(input.QueueUrl))
+	return nil, nil
+}
+
+// queueAttributes builds the attributes of a queue to update from the template params
+func queueAttributes(params map[string]interface{}) (map[string]*string, error) {
+	attributes := make(map[string]*string)
+	for _, u := range queueAttributeUpdates {
+		v, ok := params[u.param]
+		if !ok {
+			continue
+		}
+		n, err := castInt(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", u.param, err)
+		}
+		attributes[u.attribute] = aws.String(strconv.Itoa(n))
+	}
+
+	dlq, hasDlq := params["deadletterqueue"]
+	receives, hasReceives := params["maxreceive"]
+	switch {
+	case hasDlq && fmt.Sprint(dlq) == "none":
+		// an empty redrive policy stops moving messages to the dead letter queue
+		attributes[sqs.QueueAttributeNameRedrivePolicy] = aws.String("")
+	case hasDlq:
+		arn, err := sqsQueueArn(fmt.Sprint(dlq))
+		if err != nil {
+			return nil, err
+		}
+		policy := struct {
+			MaxReceiveCount     int    `json:"maxReceiveCount"`
+			DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+		}{MaxReceiveCount: 5, DeadLetterTargetArn: arn}
+		if hasReceives {
+			if policy.MaxReceiveCount, err = castInt(receives); err != nil {
+				return nil, fmt.Errorf("invalid maxreceive: %s", err)
+			}
+		}
+		b, err := json.Marshal(policy)
+		if err != nil {
+			return nil, err
+		}
+		attributes[sqs.QueueAttributeNameRedrivePolicy] = aws.String(string(b))
+	case hasReceives:
+		return nil, errors.New("maxreceive given without deadletterqueue")
+	}
+
+	if len(attributes) == 0 {
+		return nil, errors.New("no attribute to update")
+	}
+	return attributes, nil
+}
+
+func (d *SqsDriver) Receive_Queue_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["url"]; !ok {
+		return nil, errors.New("receive queue: missing required params 'url'")
+	}
+	if max, ok := params["max"]; ok {
+		if n, err := castInt(max); err != nil || n < 1 || n > 10 {
+			return nil, fmt.Errorf("receive queue: max must be between 1 and 10, got '%v'", max)
+		}
+	}
+	d.logger.Verbose("params dry run: receive queue ok")
+	return nil, nil
+}
+
+// Receive_Queue prints the body of the messages received from a queue, deleting them when asked to.
+// It returns the body of the message when only one is received, their bodies otherwise
+func (d *SqsDriver) Receive_Queue(params map[string]interface{}) (interface{}, error) {
+	input := &sqs.ReceiveMessageInput{MaxNumberOfMessages: aws.Int64(1)}
+	var err error
+	if err = setFieldWithType(params["url"], input, "QueueUrl", awsstr); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["max"], input, "MaxNumberOfMessages", awsint64); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["wait"], input, "WaitTimeSeconds", awsint64); err != nil {
+		return nil, err
+	}
+	if err = setFieldWithType(params["visibility"], input, "VisibilityTimeout", awsint64); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	output, err := d.ReceiveMessage(input)
+	if err != nil {
+		d.logger.Errorf("receive queue error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("sqs.ReceiveMessage call took %s", time.Since(start))
+
+	var bodies []string
+	var received []*sqs.DeleteMessageBatchRequestEntry
+	for i, msg := range output.Messages {
+		fmt.Println(aws.StringValue(msg.Body))
+		bodies = append(bodies, aws.StringValue(msg.Body))
+		received = append(received, &sqs.DeleteMessageBatchRequestEntry{Id: aws.String(strconv.Itoa(i)), ReceiptHandle: msg.ReceiptHandle})
+	}
+
+	if del, ok := params["delete"]; ok && len(received) > 0 {
+		if deleted, err := castBool(del); err != nil {
+			return nil, err
+		} else if deleted {
+			start = time.Now()
+			out, err := d.DeleteMessageBatch(&sqs.DeleteMessageBatchInput{QueueUrl: input.QueueUrl, Entries: received})
+			if err != nil {
+				d.logger.Errorf("receive queue error: deleting messages: %s", err)
+				return nil, err
+			}
+			d.logger.ExtraVerbosef("sqs.DeleteMessageBatch call took %s", time.Since(start))
+			for _, failed := range out.Failed {
+				d.logger.Errorf("receive queue: message %s not deleted: %s", aws.StringValue(failed.Id), aws.StringValue(failed.Message))
+			}
+		}
+	}
+	d.logger.Verbosef("receive queue '%s' done: %d message(s)", aws.StringValue(input.QueueUrl), len(bodies))
+
+	switch len(bodies) {
+	case 0:
+		return nil, nil
+	case 1:
+		return bodies[0], nil
+	default:
+		return bodies, nil
+	}
+}
+
+func buildIpPermissionsFromParams(params map[string]interface{}) ([]*ec2.IpPermission, error) {
+	if _, ok := params["cidr"].(string); !ok {
+		return nil, fmt.Errorf("invalid cidr '%v'", params["cidr"])
+	}
+	ipPerm := &ec2.IpPermission{
+		IpRanges: []*ec2.IpRange{{CidrIp: aws.String(params["cidr"].(string))}},
+	}
+	// IP protocol numbers (ex: 6 or -1) are parsed as integers
+	var p string
+	switch protocol := params["protocol"].(type) {
+	case string:
+		p = protocol
+	case int, int64:
+		p = fmt.Sprint(protocol)
+	default:
 		return nil, fmt.Errorf("invalid protocol '%v'", params["protocol"])
 	}
-	p := params["protocol"].(string)
-	if strings.Contains("any", p) {
+	if p == "any" || p == "-1" {
 		ipPerm.FromPort = aws.Int64(int64(-1))
 		ipPerm.ToPort = aws.Int64(int64(-1))
 		ipPerm.IpProtocol = aws.String("-1")
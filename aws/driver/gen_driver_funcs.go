@@ -24,9 +24,17 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
@@ -236,7 +244,7 @@ func (d *Ec2Driver) Update_Subnet(params map[string]interface{}) (interface{}, e
 
 	// Extra params
 	if _, ok := params["public"]; ok {
-		err = setFieldWithType(params["public"], input, "MapPublicIpOnLaunch", awsbool)
+		err = setFieldWithType(params["public"], input, "MapPublicIpOnLaunch", awsboolattribute)
 		if err != nil {
 			return nil, err
 		}
@@ -359,7 +367,7 @@ func (d *Ec2Driver) Create_Instance_DryRun(params map[string]interface{}) (inter
 		}
 	}
 	if _, ok := params["lock"]; ok {
-		err = setFieldWithType(params["lock"], input, "DisableApiTermination", awsboolattribute)
+		err = setFieldWithType(params["lock"], input, "DisableApiTermination", awsbool)
 		if err != nil {
 			return nil, err
 		}
@@ -443,7 +451,7 @@ func (d *Ec2Driver) Create_Instance(params map[string]interface{}) (interface{},
 		}
 	}
 	if _, ok := params["lock"]; ok {
-		err = setFieldWithType(params["lock"], input, "DisableApiTermination", awsboolattribute)
+		err = setFieldWithType(params["lock"], input, "DisableApiTermination", awsbool)
 		if err != nil {
 			return nil, err
 		}
@@ -488,7 +496,7 @@ func (d *Ec2Driver) Update_Instance_DryRun(params map[string]interface{}) (inter
 
 	// Extra params
 	if _, ok := params["type"]; ok {
-		err = setFieldWithType(params["type"], input, "InstanceType", awsstr)
+		err = setFieldWithType(params["type"], input, "InstanceType", awsstringattribute)
 		if err != nil {
 			return nil, err
 		}
@@ -533,7 +541,7 @@ func (d *Ec2Driver) Update_Instance(params map[string]interface{}) (interface{},
 
 	// Extra params
 	if _, ok := params["type"]; ok {
-		err = setFieldWithType(params["type"], input, "InstanceType", awsstr)
+		err = setFieldWithType(params["type"], input, "InstanceType", awsstringattribute)
 		if err != nil {
 			return nil, err
 		}
@@ -1009,6 +1017,305 @@ func (d *Ec2Driver) Attach_Volume(params map[string]interface{}) (interface{}, e
 	return aws.StringValue(output.VolumeId), nil
 }
 
+// This function was auto generated
+func (d *Ec2Driver) Create_Snapshot_DryRun(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateSnapshotInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["volume"], input, "VolumeId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = d.CreateSnapshot(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("snapshot")
+			d.logger.Verbose("full dry run: create snapshot ok")
+			return id, nil
+		}
+	}
+
+	d.logger.Errorf("dry run: create snapshot error: %s", err)
+	return nil, err
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Create_Snapshot(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateSnapshotInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["volume"], input, "VolumeId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *ec2.Snapshot
+	output, err = d.CreateSnapshot(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("create snapshot error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ec2.CreateSnapshot call took %s", time.Since(start))
+	id := aws.StringValue(output.SnapshotId)
+	d.logger.Verbosef("create snapshot '%s' done", id)
+	return aws.StringValue(output.SnapshotId), nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Delete_Snapshot_DryRun(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeleteSnapshotInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "SnapshotId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = d.DeleteSnapshot(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("snapshot")
+			d.logger.Verbose("full dry run: delete snapshot ok")
+			return id, nil
+		}
+	}
+
+	d.logger.Errorf("dry run: delete snapshot error: %s", err)
+	return nil, err
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Delete_Snapshot(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeleteSnapshotInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "SnapshotId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *ec2.DeleteSnapshotOutput
+	output, err = d.DeleteSnapshot(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete snapshot error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ec2.DeleteSnapshot call took %s", time.Since(start))
+	d.logger.Verbose("delete snapshot done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Create_Image_DryRun(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateImageInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["name"], input, "Name", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["noreboot"]; ok {
+		err = setFieldWithType(params["noreboot"], input, "NoReboot", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = d.CreateImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("image")
+			d.logger.Verbose("full dry run: create image ok")
+			return id, nil
+		}
+	}
+
+	d.logger.Errorf("dry run: create image error: %s", err)
+	return nil, err
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Create_Image(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateImageInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["name"], input, "Name", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["noreboot"]; ok {
+		err = setFieldWithType(params["noreboot"], input, "NoReboot", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *ec2.CreateImageOutput
+	output, err = d.CreateImage(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("create image error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ec2.CreateImage call took %s", time.Since(start))
+	id := aws.StringValue(output.ImageId)
+	d.logger.Verbosef("create image '%s' done", id)
+	return aws.StringValue(output.ImageId), nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Copy_Image_DryRun(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CopyImageInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "SourceImageId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["sourceregion"], input, "SourceRegion", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["name"], input, "Name", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["encrypted"]; ok {
+		err = setFieldWithType(params["encrypted"], input, "Encrypted", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = d.CopyImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("image")
+			d.logger.Verbose("full dry run: copy image ok")
+			return id, nil
+		}
+	}
+
+	d.logger.Errorf("dry run: copy image error: %s", err)
+	return nil, err
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Copy_Image(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CopyImageInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "SourceImageId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["sourceregion"], input, "SourceRegion", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["name"], input, "Name", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["encrypted"]; ok {
+		err = setFieldWithType(params["encrypted"], input, "Encrypted", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *ec2.CopyImageOutput
+	output, err = d.CopyImage(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("copy image error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ec2.CopyImage call took %s", time.Since(start))
+	id := aws.StringValue(output.ImageId)
+	d.logger.Verbosef("copy image '%s' done", id)
+	return aws.StringValue(output.ImageId), nil
+}
+
 // This function was auto generated
 func (d *Ec2Driver) Create_Internetgateway_DryRun(params map[string]interface{}) (interface{}, error) {
 	input := &ec2.CreateInternetGatewayInput{}
@@ -1439,9 +1746,25 @@ func (d *Ec2Driver) Create_Route_DryRun(params map[string]interface{}) (interfac
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["gateway"], input, "GatewayId", awsstr)
-	if err != nil {
-		return nil, err
+
+	// Extra params
+	if _, ok := params["gateway"]; ok {
+		err = setFieldWithType(params["gateway"], input, "GatewayId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["natgateway"]; ok {
+		err = setFieldWithType(params["natgateway"], input, "NatGatewayId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["instance"]; ok {
+		err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	_, err = d.CreateRoute(input)
@@ -1472,9 +1795,25 @@ func (d *Ec2Driver) Create_Route(params map[string]interface{}) (interface{}, er
 	if err != nil {
 		return nil, err
 	}
-	err = setFieldWithType(params["gateway"], input, "GatewayId", awsstr)
-	if err != nil {
-		return nil, err
+
+	// Extra params
+	if _, ok := params["gateway"]; ok {
+		err = setFieldWithType(params["gateway"], input, "GatewayId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["natgateway"]; ok {
+		err = setFieldWithType(params["natgateway"], input, "NatGatewayId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["instance"]; ok {
+		err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	start := time.Now()
@@ -1549,19 +1888,366 @@ func (d *Ec2Driver) Delete_Route(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *Ec2Driver) Delete_Keypair_DryRun(params map[string]interface{}) (interface{}, error) {
-	input := &ec2.DeleteKeyPairInput{}
-	input.DryRun = aws.Bool(true)
-	var err error
+func (d *Ec2Driver) Create_Natgateway_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["elasticip"]; !ok {
+		return nil, errors.New("create natgateway: missing required params 'elasticip'")
+	}
 
-	// Required params
-	err = setFieldWithType(params["id"], input, "KeyName", awsstr)
-	if err != nil {
-		return nil, err
+	if _, ok := params["subnet"]; !ok {
+		return nil, errors.New("create natgateway: missing required params 'subnet'")
 	}
 
-	_, err = d.DeleteKeyPair(input)
-	if awsErr, ok := err.(awserr.Error); ok {
+	d.logger.Verbose("params dry run: create natgateway ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Create_Natgateway(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.CreateNatGatewayInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["elasticip"], input, "AllocationId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["subnet"], input, "SubnetId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *ec2.CreateNatGatewayOutput
+	output, err = d.CreateNatGateway(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("create natgateway error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ec2.CreateNatGateway call took %s", time.Since(start))
+	id := aws.StringValue(output.NatGateway.NatGatewayId)
+	d.logger.Verbosef("create natgateway '%s' done", id)
+	return aws.StringValue(output.NatGateway.NatGatewayId), nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Delete_Natgateway_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete natgateway: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete natgateway ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Delete_Natgateway(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeleteNatGatewayInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "NatGatewayId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *ec2.DeleteNatGatewayOutput
+	output, err = d.DeleteNatGateway(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete natgateway error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ec2.DeleteNatGateway call took %s", time.Since(start))
+	d.logger.Verbose("delete natgateway done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Create_Elasticip_DryRun(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.AllocateAddressInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["domain"], input, "Domain", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = d.AllocateAddress(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("elasticip")
+			d.logger.Verbose("full dry run: create elasticip ok")
+			return id, nil
+		}
+	}
+
+	d.logger.Errorf("dry run: create elasticip error: %s", err)
+	return nil, err
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Create_Elasticip(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.AllocateAddressInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["domain"], input, "Domain", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *ec2.AllocateAddressOutput
+	output, err = d.AllocateAddress(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("create elasticip error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ec2.AllocateAddress call took %s", time.Since(start))
+	id := aws.StringValue(output.AllocationId)
+	d.logger.Verbosef("create elasticip '%s' done", id)
+	return aws.StringValue(output.AllocationId), nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Delete_Elasticip_DryRun(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.ReleaseAddressInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Extra params
+	if _, ok := params["id"]; ok {
+		err = setFieldWithType(params["id"], input, "AllocationId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["ip"]; ok {
+		err = setFieldWithType(params["ip"], input, "PublicIp", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = d.ReleaseAddress(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("elasticip")
+			d.logger.Verbose("full dry run: delete elasticip ok")
+			return id, nil
+		}
+	}
+
+	d.logger.Errorf("dry run: delete elasticip error: %s", err)
+	return nil, err
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Delete_Elasticip(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.ReleaseAddressInput{}
+	var err error
+
+	// Extra params
+	if _, ok := params["id"]; ok {
+		err = setFieldWithType(params["id"], input, "AllocationId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["ip"]; ok {
+		err = setFieldWithType(params["ip"], input, "PublicIp", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *ec2.ReleaseAddressOutput
+	output, err = d.ReleaseAddress(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete elasticip error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ec2.ReleaseAddress call took %s", time.Since(start))
+	d.logger.Verbose("delete elasticip done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Attach_Elasticip_DryRun(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.AssociateAddressInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AllocationId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["instance"]; ok {
+		err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["networkinterface"]; ok {
+		err = setFieldWithType(params["networkinterface"], input, "NetworkInterfaceId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["privateip"]; ok {
+		err = setFieldWithType(params["privateip"], input, "PrivateIpAddress", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["allowreassociation"]; ok {
+		err = setFieldWithType(params["allowreassociation"], input, "AllowReassociation", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = d.AssociateAddress(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("elasticip")
+			d.logger.Verbose("full dry run: attach elasticip ok")
+			return id, nil
+		}
+	}
+
+	d.logger.Errorf("dry run: attach elasticip error: %s", err)
+	return nil, err
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Attach_Elasticip(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.AssociateAddressInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AllocationId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["instance"]; ok {
+		err = setFieldWithType(params["instance"], input, "InstanceId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["networkinterface"]; ok {
+		err = setFieldWithType(params["networkinterface"], input, "NetworkInterfaceId", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["privateip"]; ok {
+		err = setFieldWithType(params["privateip"], input, "PrivateIpAddress", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["allowreassociation"]; ok {
+		err = setFieldWithType(params["allowreassociation"], input, "AllowReassociation", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *ec2.AssociateAddressOutput
+	output, err = d.AssociateAddress(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("attach elasticip error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ec2.AssociateAddress call took %s", time.Since(start))
+	id := aws.StringValue(output.AssociationId)
+	d.logger.Verbosef("attach elasticip '%s' done", id)
+	return aws.StringValue(output.AssociationId), nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Detach_Elasticip_DryRun(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DisassociateAddressInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["association"], input, "AssociationId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = d.DisassociateAddress(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			id := fakeDryRunId("elasticip")
+			d.logger.Verbose("full dry run: detach elasticip ok")
+			return id, nil
+		}
+	}
+
+	d.logger.Errorf("dry run: detach elasticip error: %s", err)
+	return nil, err
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Detach_Elasticip(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DisassociateAddressInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["association"], input, "AssociationId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *ec2.DisassociateAddressOutput
+	output, err = d.DisassociateAddress(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("detach elasticip error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ec2.DisassociateAddress call took %s", time.Since(start))
+	d.logger.Verbose("detach elasticip done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *Ec2Driver) Delete_Keypair_DryRun(params map[string]interface{}) (interface{}, error) {
+	input := &ec2.DeleteKeyPairInput{}
+	input.DryRun = aws.Bool(true)
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "KeyName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = d.DeleteKeyPair(input)
+	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound):
 			id := fakeDryRunId("keypair")
@@ -1656,8 +2342,9 @@ func (d *Elbv2Driver) Create_Loadbalancer(params map[string]interface{}) (interf
 		return nil, err
 	}
 	d.logger.ExtraVerbosef("elbv2.CreateLoadBalancer call took %s", time.Since(start))
-	d.logger.Verbose("create loadbalancer done")
-	return output, nil
+	id := aws.StringValue(output.LoadBalancers[0].LoadBalancerArn)
+	d.logger.Verbosef("create loadbalancer '%s' done", id)
+	return aws.StringValue(output.LoadBalancers[0].LoadBalancerArn), nil
 }
 
 // This function was auto generated
@@ -1917,22 +2604,293 @@ func (d *IamDriver) Delete_Group(params map[string]interface{}) (interface{}, er
 }
 
 // This function was auto generated
-func (d *S3Driver) Create_Bucket_DryRun(params map[string]interface{}) (interface{}, error) {
+func (d *IamDriver) Delete_Role_DryRun(params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["name"]; !ok {
-		return nil, errors.New("create bucket: missing required params 'name'")
+		return nil, errors.New("delete role: missing required params 'name'")
 	}
 
-	d.logger.Verbose("params dry run: create bucket ok")
+	d.logger.Verbose("params dry run: delete role ok")
 	return nil, nil
 }
 
 // This function was auto generated
-func (d *S3Driver) Create_Bucket(params map[string]interface{}) (interface{}, error) {
-	input := &s3.CreateBucketInput{}
+func (d *IamDriver) Delete_Role(params map[string]interface{}) (interface{}, error) {
+	input := &iam.DeleteRoleInput{}
 	var err error
 
 	// Required params
-	err = setFieldWithType(params["name"], input, "Bucket", awsstr)
+	err = setFieldWithType(params["name"], input, "RoleName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *iam.DeleteRoleOutput
+	output, err = d.DeleteRole(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete role error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("iam.DeleteRole call took %s", time.Since(start))
+	d.logger.Verbose("delete role done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Attach_Role_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("attach role: missing required params 'name'")
+	}
+
+	if _, ok := params["instanceprofile"]; !ok {
+		return nil, errors.New("attach role: missing required params 'instanceprofile'")
+	}
+
+	d.logger.Verbose("params dry run: attach role ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Attach_Role(params map[string]interface{}) (interface{}, error) {
+	input := &iam.AddRoleToInstanceProfileInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "RoleName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["instanceprofile"], input, "InstanceProfileName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *iam.AddRoleToInstanceProfileOutput
+	output, err = d.AddRoleToInstanceProfile(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("attach role error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("iam.AddRoleToInstanceProfile call took %s", time.Since(start))
+	d.logger.Verbose("attach role done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Detach_Role_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("detach role: missing required params 'name'")
+	}
+
+	if _, ok := params["instanceprofile"]; !ok {
+		return nil, errors.New("detach role: missing required params 'instanceprofile'")
+	}
+
+	d.logger.Verbose("params dry run: detach role ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Detach_Role(params map[string]interface{}) (interface{}, error) {
+	input := &iam.RemoveRoleFromInstanceProfileInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "RoleName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["instanceprofile"], input, "InstanceProfileName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *iam.RemoveRoleFromInstanceProfileOutput
+	output, err = d.RemoveRoleFromInstanceProfile(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("detach role error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("iam.RemoveRoleFromInstanceProfile call took %s", time.Since(start))
+	d.logger.Verbose("detach role done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Create_Instanceprofile_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("create instanceprofile: missing required params 'name'")
+	}
+
+	d.logger.Verbose("params dry run: create instanceprofile ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Create_Instanceprofile(params map[string]interface{}) (interface{}, error) {
+	input := &iam.CreateInstanceProfileInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "InstanceProfileName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["path"]; ok {
+		err = setFieldWithType(params["path"], input, "Path", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *iam.CreateInstanceProfileOutput
+	output, err = d.CreateInstanceProfile(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("create instanceprofile error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("iam.CreateInstanceProfile call took %s", time.Since(start))
+	id := aws.StringValue(output.InstanceProfile.InstanceProfileName)
+	d.logger.Verbosef("create instanceprofile '%s' done", id)
+	return aws.StringValue(output.InstanceProfile.InstanceProfileName), nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Delete_Instanceprofile_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("delete instanceprofile: missing required params 'name'")
+	}
+
+	d.logger.Verbose("params dry run: delete instanceprofile ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Delete_Instanceprofile(params map[string]interface{}) (interface{}, error) {
+	input := &iam.DeleteInstanceProfileInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "InstanceProfileName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *iam.DeleteInstanceProfileOutput
+	output, err = d.DeleteInstanceProfile(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete instanceprofile error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("iam.DeleteInstanceProfile call took %s", time.Since(start))
+	d.logger.Verbose("delete instanceprofile done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Delete_Accesskey_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete accesskey: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete accesskey ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Delete_Accesskey(params map[string]interface{}) (interface{}, error) {
+	input := &iam.DeleteAccessKeyInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AccessKeyId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["user"]; ok {
+		err = setFieldWithType(params["user"], input, "UserName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *iam.DeleteAccessKeyOutput
+	output, err = d.DeleteAccessKey(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete accesskey error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("iam.DeleteAccessKey call took %s", time.Since(start))
+	d.logger.Verbose("delete accesskey done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Delete_Policy_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["arn"]; !ok {
+		return nil, errors.New("delete policy: missing required params 'arn'")
+	}
+
+	d.logger.Verbose("params dry run: delete policy ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *IamDriver) Delete_Policy(params map[string]interface{}) (interface{}, error) {
+	input := &iam.DeletePolicyInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["arn"], input, "PolicyArn", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *iam.DeletePolicyOutput
+	output, err = d.DeletePolicy(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete policy error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("iam.DeletePolicy call took %s", time.Since(start))
+	d.logger.Verbose("delete policy done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *S3Driver) Create_Bucket_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("create bucket: missing required params 'name'")
+	}
+
+	d.logger.Verbose("params dry run: create bucket ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *S3Driver) Create_Bucket(params map[string]interface{}) (interface{}, error) {
+	input := &s3.CreateBucketInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "Bucket", awsstr)
 	if err != nil {
 		return nil, err
 	}
@@ -2096,6 +3054,57 @@ func (d *SnsDriver) Delete_Topic(params map[string]interface{}) (interface{}, er
 	return output, nil
 }
 
+// This function was auto generated
+func (d *SnsDriver) Publish_Topic_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["arn"]; !ok {
+		return nil, errors.New("publish topic: missing required params 'arn'")
+	}
+
+	if _, ok := params["message"]; !ok {
+		return nil, errors.New("publish topic: missing required params 'message'")
+	}
+
+	d.logger.Verbose("params dry run: publish topic ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *SnsDriver) Publish_Topic(params map[string]interface{}) (interface{}, error) {
+	input := &sns.PublishInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["arn"], input, "TopicArn", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["message"], input, "Message", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["subject"]; ok {
+		err = setFieldWithType(params["subject"], input, "Subject", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *sns.PublishOutput
+	output, err = d.Publish(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("publish topic error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("sns.Publish call took %s", time.Since(start))
+	id := aws.StringValue(output.MessageId)
+	d.logger.Verbosef("publish topic '%s' done", id)
+	return aws.StringValue(output.MessageId), nil
+}
+
 // This function was auto generated
 func (d *SnsDriver) Create_Subscription_DryRun(params map[string]interface{}) (interface{}, error) {
 	if _, ok := params["topic"]; !ok {
@@ -2293,3 +3302,1176 @@ func (d *SqsDriver) Delete_Queue(params map[string]interface{}) (interface{}, er
 	d.logger.Verbose("delete queue done")
 	return output, nil
 }
+
+// This function was auto generated
+func (d *SqsDriver) Send_Queue_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["url"]; !ok {
+		return nil, errors.New("send queue: missing required params 'url'")
+	}
+
+	if _, ok := params["message"]; !ok {
+		return nil, errors.New("send queue: missing required params 'message'")
+	}
+
+	d.logger.Verbose("params dry run: send queue ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *SqsDriver) Send_Queue(params map[string]interface{}) (interface{}, error) {
+	input := &sqs.SendMessageInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["url"], input, "QueueUrl", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["message"], input, "MessageBody", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["delay"]; ok {
+		err = setFieldWithType(params["delay"], input, "DelaySeconds", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *sqs.SendMessageOutput
+	output, err = d.SendMessage(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("send queue error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("sqs.SendMessage call took %s", time.Since(start))
+	id := aws.StringValue(output.MessageId)
+	d.logger.Verbosef("send queue '%s' done", id)
+	return aws.StringValue(output.MessageId), nil
+}
+
+// This function was auto generated
+func (d *SqsDriver) Purge_Queue_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["url"]; !ok {
+		return nil, errors.New("purge queue: missing required params 'url'")
+	}
+
+	d.logger.Verbose("params dry run: purge queue ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *SqsDriver) Purge_Queue(params map[string]interface{}) (interface{}, error) {
+	input := &sqs.PurgeQueueInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["url"], input, "QueueUrl", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *sqs.PurgeQueueOutput
+	output, err = d.PurgeQueue(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("purge queue error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("sqs.PurgeQueue call took %s", time.Since(start))
+	d.logger.Verbose("purge queue done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Create_Database_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["type"]; !ok {
+		return nil, errors.New("create database: missing required params 'type'")
+	}
+
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("create database: missing required params 'id'")
+	}
+
+	if _, ok := params["engine"]; !ok {
+		return nil, errors.New("create database: missing required params 'engine'")
+	}
+
+	if _, ok := params["password"]; !ok {
+		return nil, errors.New("create database: missing required params 'password'")
+	}
+
+	if _, ok := params["username"]; !ok {
+		return nil, errors.New("create database: missing required params 'username'")
+	}
+
+	if _, ok := params["size"]; !ok {
+		return nil, errors.New("create database: missing required params 'size'")
+	}
+
+	d.logger.Verbose("params dry run: create database ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Create_Database(params map[string]interface{}) (interface{}, error) {
+	input := &rds.CreateDBInstanceInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["type"], input, "DBInstanceClass", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["id"], input, "DBInstanceIdentifier", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["engine"], input, "Engine", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["password"], input, "MasterUserPassword", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["username"], input, "MasterUsername", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["size"], input, "AllocatedStorage", awsint64)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["autoupgrade"]; ok {
+		err = setFieldWithType(params["autoupgrade"], input, "AutoMinorVersionUpgrade", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["zone"]; ok {
+		err = setFieldWithType(params["zone"], input, "AvailabilityZone", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["backupretention"]; ok {
+		err = setFieldWithType(params["backupretention"], input, "BackupRetentionPeriod", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["backupwindow"]; ok {
+		err = setFieldWithType(params["backupwindow"], input, "PreferredBackupWindow", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["dbname"]; ok {
+		err = setFieldWithType(params["dbname"], input, "DBName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["version"]; ok {
+		err = setFieldWithType(params["version"], input, "EngineVersion", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["iops"]; ok {
+		err = setFieldWithType(params["iops"], input, "Iops", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["license"]; ok {
+		err = setFieldWithType(params["license"], input, "LicenseModel", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["maintenancewindow"]; ok {
+		err = setFieldWithType(params["maintenancewindow"], input, "PreferredMaintenanceWindow", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["multiaz"]; ok {
+		err = setFieldWithType(params["multiaz"], input, "MultiAZ", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["optiongroup"]; ok {
+		err = setFieldWithType(params["optiongroup"], input, "OptionGroupName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["parametergroup"]; ok {
+		err = setFieldWithType(params["parametergroup"], input, "DBParameterGroupName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["port"]; ok {
+		err = setFieldWithType(params["port"], input, "Port", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["public"]; ok {
+		err = setFieldWithType(params["public"], input, "PubliclyAccessible", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["encrypted"]; ok {
+		err = setFieldWithType(params["encrypted"], input, "StorageEncrypted", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["storagetype"]; ok {
+		err = setFieldWithType(params["storagetype"], input, "StorageType", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["subnetgroup"]; ok {
+		err = setFieldWithType(params["subnetgroup"], input, "DBSubnetGroupName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["groups"]; ok {
+		err = setFieldWithType(params["groups"], input, "VpcSecurityGroupIds", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *rds.CreateDBInstanceOutput
+	output, err = d.CreateDBInstance(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("create database error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("rds.CreateDBInstance call took %s", time.Since(start))
+	id := aws.StringValue(output.DBInstance.DBInstanceIdentifier)
+	d.logger.Verbosef("create database '%s' done", id)
+	return aws.StringValue(output.DBInstance.DBInstanceIdentifier), nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Delete_Database_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete database: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete database ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Delete_Database(params map[string]interface{}) (interface{}, error) {
+	input := &rds.DeleteDBInstanceInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "DBInstanceIdentifier", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["skipsnapshot"]; ok {
+		err = setFieldWithType(params["skipsnapshot"], input, "SkipFinalSnapshot", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["snapshot"]; ok {
+		err = setFieldWithType(params["snapshot"], input, "FinalDBSnapshotIdentifier", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *rds.DeleteDBInstanceOutput
+	output, err = d.DeleteDBInstance(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete database error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("rds.DeleteDBInstance call took %s", time.Since(start))
+	d.logger.Verbose("delete database done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Start_Database_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("start database: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: start database ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Start_Database(params map[string]interface{}) (interface{}, error) {
+	input := &rds.StartDBInstanceInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "DBInstanceIdentifier", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *rds.StartDBInstanceOutput
+	output, err = d.StartDBInstance(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("start database error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("rds.StartDBInstance call took %s", time.Since(start))
+	id := aws.StringValue(output.DBInstance.DBInstanceIdentifier)
+	d.logger.Verbosef("start database '%s' done", id)
+	return aws.StringValue(output.DBInstance.DBInstanceIdentifier), nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Stop_Database_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("stop database: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: stop database ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *RdsDriver) Stop_Database(params map[string]interface{}) (interface{}, error) {
+	input := &rds.StopDBInstanceInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "DBInstanceIdentifier", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["snapshot"]; ok {
+		err = setFieldWithType(params["snapshot"], input, "DBSnapshotIdentifier", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *rds.StopDBInstanceOutput
+	output, err = d.StopDBInstance(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("stop database error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("rds.StopDBInstance call took %s", time.Since(start))
+	id := aws.StringValue(output.DBInstance.DBInstanceIdentifier)
+	d.logger.Verbosef("stop database '%s' done", id)
+	return aws.StringValue(output.DBInstance.DBInstanceIdentifier), nil
+}
+
+// This function was auto generated
+func (d *LambdaDriver) Delete_Function_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete function: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete function ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *LambdaDriver) Delete_Function(params map[string]interface{}) (interface{}, error) {
+	input := &lambda.DeleteFunctionInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "FunctionName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["version"]; ok {
+		err = setFieldWithType(params["version"], input, "Qualifier", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *lambda.DeleteFunctionOutput
+	output, err = d.DeleteFunction(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete function error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("lambda.DeleteFunction call took %s", time.Since(start))
+	d.logger.Verbose("delete function done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *LambdaDriver) Delete_Eventsource_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete eventsource: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete eventsource ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *LambdaDriver) Delete_Eventsource(params map[string]interface{}) (interface{}, error) {
+	input := &lambda.DeleteEventSourceMappingInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "UUID", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *lambda.EventSourceMappingConfiguration
+	output, err = d.DeleteEventSourceMapping(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete eventsource error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("lambda.DeleteEventSourceMapping call took %s", time.Since(start))
+	d.logger.Verbose("delete eventsource done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Create_Launchconfiguration_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["image"]; !ok {
+		return nil, errors.New("create launchconfiguration: missing required params 'image'")
+	}
+
+	if _, ok := params["type"]; !ok {
+		return nil, errors.New("create launchconfiguration: missing required params 'type'")
+	}
+
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("create launchconfiguration: missing required params 'name'")
+	}
+
+	d.logger.Verbose("params dry run: create launchconfiguration ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Create_Launchconfiguration(params map[string]interface{}) (interface{}, error) {
+	input := &autoscaling.CreateLaunchConfigurationInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["image"], input, "ImageId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["type"], input, "InstanceType", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["name"], input, "LaunchConfigurationName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["public"]; ok {
+		err = setFieldWithType(params["public"], input, "AssociatePublicIpAddress", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["keypair"]; ok {
+		err = setFieldWithType(params["keypair"], input, "KeyName", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["groups"]; ok {
+		err = setFieldWithType(params["groups"], input, "SecurityGroups", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["role"]; ok {
+		err = setFieldWithType(params["role"], input, "IamInstanceProfile", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["spotprice"]; ok {
+		err = setFieldWithType(params["spotprice"], input, "SpotPrice", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["monitoring"]; ok {
+		err = setFieldWithType(params["monitoring"], input, "InstanceMonitoring.Enabled", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *autoscaling.CreateLaunchConfigurationOutput
+	output, err = d.CreateLaunchConfiguration(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("create launchconfiguration error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("autoscaling.CreateLaunchConfiguration call took %s", time.Since(start))
+	id := params["name"]
+	d.logger.Verbosef("create launchconfiguration '%s' done", id)
+	return params["name"], nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Delete_Launchconfiguration_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete launchconfiguration: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete launchconfiguration ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Delete_Launchconfiguration(params map[string]interface{}) (interface{}, error) {
+	input := &autoscaling.DeleteLaunchConfigurationInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "LaunchConfigurationName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *autoscaling.DeleteLaunchConfigurationOutput
+	output, err = d.DeleteLaunchConfiguration(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete launchconfiguration error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("autoscaling.DeleteLaunchConfiguration call took %s", time.Since(start))
+	d.logger.Verbose("delete launchconfiguration done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Create_Scalinggroup_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("create scalinggroup: missing required params 'name'")
+	}
+
+	if _, ok := params["launchconfiguration"]; !ok {
+		return nil, errors.New("create scalinggroup: missing required params 'launchconfiguration'")
+	}
+
+	if _, ok := params["maxsize"]; !ok {
+		return nil, errors.New("create scalinggroup: missing required params 'maxsize'")
+	}
+
+	if _, ok := params["minsize"]; !ok {
+		return nil, errors.New("create scalinggroup: missing required params 'minsize'")
+	}
+
+	if _, ok := params["subnets"]; !ok {
+		return nil, errors.New("create scalinggroup: missing required params 'subnets'")
+	}
+
+	d.logger.Verbose("params dry run: create scalinggroup ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Create_Scalinggroup(params map[string]interface{}) (interface{}, error) {
+	input := &autoscaling.CreateAutoScalingGroupInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "AutoScalingGroupName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["launchconfiguration"], input, "LaunchConfigurationName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["maxsize"], input, "MaxSize", awsint64)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["minsize"], input, "MinSize", awsint64)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["subnets"], input, "VPCZoneIdentifier", awscsvstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["cooldown"]; ok {
+		err = setFieldWithType(params["cooldown"], input, "DefaultCooldown", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["desiredcapacity"]; ok {
+		err = setFieldWithType(params["desiredcapacity"], input, "DesiredCapacity", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["healthcheckgraceperiod"]; ok {
+		err = setFieldWithType(params["healthcheckgraceperiod"], input, "HealthCheckGracePeriod", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["healthchecktype"]; ok {
+		err = setFieldWithType(params["healthchecktype"], input, "HealthCheckType", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["newinstancesprotected"]; ok {
+		err = setFieldWithType(params["newinstancesprotected"], input, "NewInstancesProtectedFromScaleIn", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["targetgroups"]; ok {
+		err = setFieldWithType(params["targetgroups"], input, "TargetGroupARNs", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *autoscaling.CreateAutoScalingGroupOutput
+	output, err = d.CreateAutoScalingGroup(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("create scalinggroup error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("autoscaling.CreateAutoScalingGroup call took %s", time.Since(start))
+	id := params["name"]
+	d.logger.Verbosef("create scalinggroup '%s' done", id)
+	return params["name"], nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Delete_Scalinggroup_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete scalinggroup: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete scalinggroup ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *AutoscalingDriver) Delete_Scalinggroup(params map[string]interface{}) (interface{}, error) {
+	input := &autoscaling.DeleteAutoScalingGroupInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AutoScalingGroupName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["force"]; ok {
+		err = setFieldWithType(params["force"], input, "ForceDelete", awsbool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *autoscaling.DeleteAutoScalingGroupOutput
+	output, err = d.DeleteAutoScalingGroup(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete scalinggroup error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("autoscaling.DeleteAutoScalingGroup call took %s", time.Since(start))
+	d.logger.Verbose("delete scalinggroup done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *CloudwatchDriver) Delete_Alarm_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete alarm: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete alarm ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *CloudwatchDriver) Delete_Alarm(params map[string]interface{}) (interface{}, error) {
+	input := &cloudwatch.DeleteAlarmsInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "AlarmNames", awsstringslice)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *cloudwatch.DeleteAlarmsOutput
+	output, err = d.DeleteAlarms(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete alarm error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("cloudwatch.DeleteAlarms call took %s", time.Since(start))
+	d.logger.Verbose("delete alarm done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *CloudformationDriver) Delete_Stack_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete stack: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete stack ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *CloudformationDriver) Delete_Stack(params map[string]interface{}) (interface{}, error) {
+	input := &cloudformation.DeleteStackInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "StackName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["retain"]; ok {
+		err = setFieldWithType(params["retain"], input, "RetainResources", awsstringslice)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *cloudformation.DeleteStackOutput
+	output, err = d.DeleteStack(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete stack error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("cloudformation.DeleteStack call took %s", time.Since(start))
+	d.logger.Verbose("delete stack done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *EcsDriver) Update_Service_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["cluster"]; !ok {
+		return nil, errors.New("update service: missing required params 'cluster'")
+	}
+
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("update service: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: update service ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *EcsDriver) Update_Service(params map[string]interface{}) (interface{}, error) {
+	input := &ecs.UpdateServiceInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["cluster"], input, "Cluster", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["id"], input, "Service", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["desiredcount"]; ok {
+		err = setFieldWithType(params["desiredcount"], input, "DesiredCount", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["taskdefinition"]; ok {
+		err = setFieldWithType(params["taskdefinition"], input, "TaskDefinition", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["deploymentmaxpercent"]; ok {
+		err = setFieldWithType(params["deploymentmaxpercent"], input, "DeploymentConfiguration.MaximumPercent", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := params["deploymentminpercent"]; ok {
+		err = setFieldWithType(params["deploymentminpercent"], input, "DeploymentConfiguration.MinimumHealthyPercent", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *ecs.UpdateServiceOutput
+	output, err = d.UpdateService(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("update service error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ecs.UpdateService call took %s", time.Since(start))
+	d.logger.Verbose("update service done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *EcsDriver) Stop_Task_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["cluster"]; !ok {
+		return nil, errors.New("stop task: missing required params 'cluster'")
+	}
+
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("stop task: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: stop task ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *EcsDriver) Stop_Task(params map[string]interface{}) (interface{}, error) {
+	input := &ecs.StopTaskInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["cluster"], input, "Cluster", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["id"], input, "Task", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["reason"]; ok {
+		err = setFieldWithType(params["reason"], input, "Reason", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *ecs.StopTaskOutput
+	output, err = d.StopTask(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("stop task error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("ecs.StopTask call took %s", time.Since(start))
+	id := aws.StringValue(output.Task.TaskArn)
+	d.logger.Verbosef("stop task '%s' done", id)
+	return aws.StringValue(output.Task.TaskArn), nil
+}
+
+// This function was auto generated
+func (d *DynamodbDriver) Update_Table_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("update table: missing required params 'id'")
+	}
+
+	if _, ok := params["readcapacity"]; !ok {
+		return nil, errors.New("update table: missing required params 'readcapacity'")
+	}
+
+	if _, ok := params["writecapacity"]; !ok {
+		return nil, errors.New("update table: missing required params 'writecapacity'")
+	}
+
+	d.logger.Verbose("params dry run: update table ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *DynamodbDriver) Update_Table(params map[string]interface{}) (interface{}, error) {
+	input := &dynamodb.UpdateTableInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "TableName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["readcapacity"], input, "ProvisionedThroughput.ReadCapacityUnits", awsint64)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["writecapacity"], input, "ProvisionedThroughput.WriteCapacityUnits", awsint64)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *dynamodb.UpdateTableOutput
+	output, err = d.UpdateTable(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("update table error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("dynamodb.UpdateTable call took %s", time.Since(start))
+	d.logger.Verbose("update table done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *DynamodbDriver) Delete_Table_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete table: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete table ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *DynamodbDriver) Delete_Table(params map[string]interface{}) (interface{}, error) {
+	input := &dynamodb.DeleteTableInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "TableName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *dynamodb.DeleteTableOutput
+	output, err = d.DeleteTable(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete table error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("dynamodb.DeleteTable call took %s", time.Since(start))
+	d.logger.Verbose("delete table done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *KmsDriver) Create_Key_DryRun(params map[string]interface{}) (interface{}, error) {
+	d.logger.Verbose("params dry run: create key ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *KmsDriver) Create_Key(params map[string]interface{}) (interface{}, error) {
+	input := &kms.CreateKeyInput{}
+	var err error
+
+	// Extra params
+	if _, ok := params["description"]; ok {
+		err = setFieldWithType(params["description"], input, "Description", awsstr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *kms.CreateKeyOutput
+	output, err = d.CreateKey(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("create key error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("kms.CreateKey call took %s", time.Since(start))
+	id := aws.StringValue(output.KeyMetadata.KeyId)
+	d.logger.Verbosef("create key '%s' done", id)
+	return aws.StringValue(output.KeyMetadata.KeyId), nil
+}
+
+// This function was auto generated
+func (d *KmsDriver) Delete_Key_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["id"]; !ok {
+		return nil, errors.New("delete key: missing required params 'id'")
+	}
+
+	d.logger.Verbose("params dry run: delete key ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *KmsDriver) Delete_Key(params map[string]interface{}) (interface{}, error) {
+	input := &kms.ScheduleKeyDeletionInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["id"], input, "KeyId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extra params
+	if _, ok := params["pendingdays"]; ok {
+		err = setFieldWithType(params["pendingdays"], input, "PendingWindowInDays", awsint64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	var output *kms.ScheduleKeyDeletionOutput
+	output, err = d.ScheduleKeyDeletion(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete key error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("kms.ScheduleKeyDeletion call took %s", time.Since(start))
+	d.logger.Verbose("delete key done")
+	return output, nil
+}
+
+// This function was auto generated
+func (d *KmsDriver) Create_Keyalias_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("create keyalias: missing required params 'name'")
+	}
+
+	if _, ok := params["key"]; !ok {
+		return nil, errors.New("create keyalias: missing required params 'key'")
+	}
+
+	d.logger.Verbose("params dry run: create keyalias ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *KmsDriver) Create_Keyalias(params map[string]interface{}) (interface{}, error) {
+	input := &kms.CreateAliasInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "AliasName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+	err = setFieldWithType(params["key"], input, "TargetKeyId", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *kms.CreateAliasOutput
+	output, err = d.CreateAlias(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("create keyalias error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("kms.CreateAlias call took %s", time.Since(start))
+	id := aws.StringValue(input.AliasName)
+	d.logger.Verbosef("create keyalias '%s' done", id)
+	return aws.StringValue(input.AliasName), nil
+}
+
+// This function was auto generated
+func (d *KmsDriver) Delete_Keyalias_DryRun(params map[string]interface{}) (interface{}, error) {
+	if _, ok := params["name"]; !ok {
+		return nil, errors.New("delete keyalias: missing required params 'name'")
+	}
+
+	d.logger.Verbose("params dry run: delete keyalias ok")
+	return nil, nil
+}
+
+// This function was auto generated
+func (d *KmsDriver) Delete_Keyalias(params map[string]interface{}) (interface{}, error) {
+	input := &kms.DeleteAliasInput{}
+	var err error
+
+	// Required params
+	err = setFieldWithType(params["name"], input, "AliasName", awsstr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var output *kms.DeleteAliasOutput
+	output, err = d.DeleteAlias(input)
+	output = output
+	if err != nil {
+		d.logger.Errorf("delete keyalias error: %s", err)
+		return nil, err
+	}
+	d.logger.ExtraVerbosef("kms.DeleteAlias call took %s", time.Since(start))
+	d.logger.Verbose("delete keyalias done")
+	return output, nil
+}
@@ -20,9 +20,19 @@ package aws
 import (
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
@@ -148,6 +158,36 @@ func (d *Ec2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Attach_Volume, nil
 
+	case "createsnapshot":
+		if d.dryRun {
+			return d.Create_Snapshot_DryRun, nil
+		}
+		return d.Create_Snapshot, nil
+
+	case "deletesnapshot":
+		if d.dryRun {
+			return d.Delete_Snapshot_DryRun, nil
+		}
+		return d.Delete_Snapshot, nil
+
+	case "createimage":
+		if d.dryRun {
+			return d.Create_Image_DryRun, nil
+		}
+		return d.Create_Image, nil
+
+	case "deleteimage":
+		if d.dryRun {
+			return d.Delete_Image_DryRun, nil
+		}
+		return d.Delete_Image, nil
+
+	case "copyimage":
+		if d.dryRun {
+			return d.Copy_Image_DryRun, nil
+		}
+		return d.Copy_Image, nil
+
 	case "createinternetgateway":
 		if d.dryRun {
 			return d.Create_Internetgateway_DryRun, nil
@@ -208,6 +248,48 @@ func (d *Ec2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Delete_Route, nil
 
+	case "createnatgateway":
+		if d.dryRun {
+			return d.Create_Natgateway_DryRun, nil
+		}
+		return d.Create_Natgateway, nil
+
+	case "deletenatgateway":
+		if d.dryRun {
+			return d.Delete_Natgateway_DryRun, nil
+		}
+		return d.Delete_Natgateway, nil
+
+	case "checknatgateway":
+		if d.dryRun {
+			return d.Check_Natgateway_DryRun, nil
+		}
+		return d.Check_Natgateway, nil
+
+	case "createelasticip":
+		if d.dryRun {
+			return d.Create_Elasticip_DryRun, nil
+		}
+		return d.Create_Elasticip, nil
+
+	case "deleteelasticip":
+		if d.dryRun {
+			return d.Delete_Elasticip_DryRun, nil
+		}
+		return d.Delete_Elasticip, nil
+
+	case "attachelasticip":
+		if d.dryRun {
+			return d.Attach_Elasticip_DryRun, nil
+		}
+		return d.Attach_Elasticip, nil
+
+	case "detachelasticip":
+		if d.dryRun {
+			return d.Detach_Elasticip_DryRun, nil
+		}
+		return d.Detach_Elasticip, nil
+
 	case "createtag":
 		if d.dryRun {
 			return d.Create_Tag_DryRun, nil
@@ -264,6 +346,39 @@ func (d *Elbv2Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err e
 	}
 }
 
+type ElbDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	elbiface.ELBAPI
+}
+
+func (d *ElbDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *ElbDriver) SetLogger(l *logger.Logger) { d.logger = l }
+
+func NewElbDriver(api elbiface.ELBAPI) driver.Driver {
+	return &ElbDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *ElbDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "attachclassicloadbalancer":
+		if d.dryRun {
+			return d.Attach_Classicloadbalancer_DryRun, nil
+		}
+		return d.Attach_Classicloadbalancer, nil
+
+	case "detachclassicloadbalancer":
+		if d.dryRun {
+			return d.Detach_Classicloadbalancer_DryRun, nil
+		}
+		return d.Detach_Classicloadbalancer, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
 type IamDriver struct {
 	dryRun bool
 	logger *logger.Logger
@@ -316,6 +431,66 @@ func (d *IamDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Delete_Group, nil
 
+	case "createrole":
+		if d.dryRun {
+			return d.Create_Role_DryRun, nil
+		}
+		return d.Create_Role, nil
+
+	case "deleterole":
+		if d.dryRun {
+			return d.Delete_Role_DryRun, nil
+		}
+		return d.Delete_Role, nil
+
+	case "attachrole":
+		if d.dryRun {
+			return d.Attach_Role_DryRun, nil
+		}
+		return d.Attach_Role, nil
+
+	case "detachrole":
+		if d.dryRun {
+			return d.Detach_Role_DryRun, nil
+		}
+		return d.Detach_Role, nil
+
+	case "createinstanceprofile":
+		if d.dryRun {
+			return d.Create_Instanceprofile_DryRun, nil
+		}
+		return d.Create_Instanceprofile, nil
+
+	case "deleteinstanceprofile":
+		if d.dryRun {
+			return d.Delete_Instanceprofile_DryRun, nil
+		}
+		return d.Delete_Instanceprofile, nil
+
+	case "createaccesskey":
+		if d.dryRun {
+			return d.Create_Accesskey_DryRun, nil
+		}
+		return d.Create_Accesskey, nil
+
+	case "deleteaccesskey":
+		if d.dryRun {
+			return d.Delete_Accesskey_DryRun, nil
+		}
+		return d.Delete_Accesskey, nil
+
+	case "createpolicy":
+		if d.dryRun {
+			return d.Create_Policy_DryRun, nil
+		}
+		return d.Create_Policy, nil
+
+	case "deletepolicy":
+		if d.dryRun {
+			return d.Delete_Policy_DryRun, nil
+		}
+		return d.Delete_Policy, nil
+
 	case "attachpolicy":
 		if d.dryRun {
 			return d.Attach_Policy_DryRun, nil
@@ -361,6 +536,12 @@ func (d *S3Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err erro
 		}
 		return d.Delete_Bucket, nil
 
+	case "updatebucket":
+		if d.dryRun {
+			return d.Update_Bucket_DryRun, nil
+		}
+		return d.Update_Bucket, nil
+
 	case "createstorageobject":
 		if d.dryRun {
 			return d.Create_Storageobject_DryRun, nil
@@ -406,6 +587,12 @@ func (d *SnsDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Delete_Topic, nil
 
+	case "publishtopic":
+		if d.dryRun {
+			return d.Publish_Topic_DryRun, nil
+		}
+		return d.Publish_Topic, nil
+
 	case "createsubscription":
 		if d.dryRun {
 			return d.Create_Subscription_DryRun, nil
@@ -451,6 +638,447 @@ func (d *SqsDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err err
 		}
 		return d.Delete_Queue, nil
 
+	case "updatequeue":
+		if d.dryRun {
+			return d.Update_Queue_DryRun, nil
+		}
+		return d.Update_Queue, nil
+
+	case "sendqueue":
+		if d.dryRun {
+			return d.Send_Queue_DryRun, nil
+		}
+		return d.Send_Queue, nil
+
+	case "receivequeue":
+		if d.dryRun {
+			return d.Receive_Queue_DryRun, nil
+		}
+		return d.Receive_Queue, nil
+
+	case "purgequeue":
+		if d.dryRun {
+			return d.Purge_Queue_DryRun, nil
+		}
+		return d.Purge_Queue, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type RdsDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	rdsiface.RDSAPI
+}
+
+func (d *RdsDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *RdsDriver) SetLogger(l *logger.Logger) { d.logger = l }
+
+func NewRdsDriver(api rdsiface.RDSAPI) driver.Driver {
+	return &RdsDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *RdsDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createdatabase":
+		if d.dryRun {
+			return d.Create_Database_DryRun, nil
+		}
+		return d.Create_Database, nil
+
+	case "deletedatabase":
+		if d.dryRun {
+			return d.Delete_Database_DryRun, nil
+		}
+		return d.Delete_Database, nil
+
+	case "startdatabase":
+		if d.dryRun {
+			return d.Start_Database_DryRun, nil
+		}
+		return d.Start_Database, nil
+
+	case "stopdatabase":
+		if d.dryRun {
+			return d.Stop_Database_DryRun, nil
+		}
+		return d.Stop_Database, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type LambdaDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	lambdaiface.LambdaAPI
+}
+
+func (d *LambdaDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *LambdaDriver) SetLogger(l *logger.Logger) { d.logger = l }
+
+func NewLambdaDriver(api lambdaiface.LambdaAPI) driver.Driver {
+	return &LambdaDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *LambdaDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createfunction":
+		if d.dryRun {
+			return d.Create_Function_DryRun, nil
+		}
+		return d.Create_Function, nil
+
+	case "updatefunction":
+		if d.dryRun {
+			return d.Update_Function_DryRun, nil
+		}
+		return d.Update_Function, nil
+
+	case "deletefunction":
+		if d.dryRun {
+			return d.Delete_Function_DryRun, nil
+		}
+		return d.Delete_Function, nil
+
+	case "createeventsource":
+		if d.dryRun {
+			return d.Create_Eventsource_DryRun, nil
+		}
+		return d.Create_Eventsource, nil
+
+	case "deleteeventsource":
+		if d.dryRun {
+			return d.Delete_Eventsource_DryRun, nil
+		}
+		return d.Delete_Eventsource, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type Route53Driver struct {
+	dryRun bool
+	logger *logger.Logger
+	route53iface.Route53API
+}
+
+func (d *Route53Driver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *Route53Driver) SetLogger(l *logger.Logger) { d.logger = l }
+
+func NewRoute53Driver(api route53iface.Route53API) driver.Driver {
+	return &Route53Driver{false, logger.DiscardLogger, api}
+}
+
+func (d *Route53Driver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createrecord":
+		if d.dryRun {
+			return d.Create_Record_DryRun, nil
+		}
+		return d.Create_Record, nil
+
+	case "updaterecord":
+		if d.dryRun {
+			return d.Update_Record_DryRun, nil
+		}
+		return d.Update_Record, nil
+
+	case "deleterecord":
+		if d.dryRun {
+			return d.Delete_Record_DryRun, nil
+		}
+		return d.Delete_Record, nil
+
+	case "checkrecord":
+		if d.dryRun {
+			return d.Check_Record_DryRun, nil
+		}
+		return d.Check_Record, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type AutoscalingDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	autoscalingiface.AutoScalingAPI
+}
+
+func (d *AutoscalingDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *AutoscalingDriver) SetLogger(l *logger.Logger) { d.logger = l }
+
+func NewAutoscalingDriver(api autoscalingiface.AutoScalingAPI) driver.Driver {
+	return &AutoscalingDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *AutoscalingDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createlaunchconfiguration":
+		if d.dryRun {
+			return d.Create_Launchconfiguration_DryRun, nil
+		}
+		return d.Create_Launchconfiguration, nil
+
+	case "deletelaunchconfiguration":
+		if d.dryRun {
+			return d.Delete_Launchconfiguration_DryRun, nil
+		}
+		return d.Delete_Launchconfiguration, nil
+
+	case "createscalinggroup":
+		if d.dryRun {
+			return d.Create_Scalinggroup_DryRun, nil
+		}
+		return d.Create_Scalinggroup, nil
+
+	case "updatescalinggroup":
+		if d.dryRun {
+			return d.Update_Scalinggroup_DryRun, nil
+		}
+		return d.Update_Scalinggroup, nil
+
+	case "deletescalinggroup":
+		if d.dryRun {
+			return d.Delete_Scalinggroup_DryRun, nil
+		}
+		return d.Delete_Scalinggroup, nil
+
+	case "checkscalinggroup":
+		if d.dryRun {
+			return d.Check_Scalinggroup_DryRun, nil
+		}
+		return d.Check_Scalinggroup, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type CloudwatchDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	cloudwatchiface.CloudWatchAPI
+}
+
+func (d *CloudwatchDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *CloudwatchDriver) SetLogger(l *logger.Logger) { d.logger = l }
+
+func NewCloudwatchDriver(api cloudwatchiface.CloudWatchAPI) driver.Driver {
+	return &CloudwatchDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *CloudwatchDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createalarm":
+		if d.dryRun {
+			return d.Create_Alarm_DryRun, nil
+		}
+		return d.Create_Alarm, nil
+
+	case "deletealarm":
+		if d.dryRun {
+			return d.Delete_Alarm_DryRun, nil
+		}
+		return d.Delete_Alarm, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type CloudformationDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	cloudformationiface.CloudFormationAPI
+}
+
+func (d *CloudformationDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *CloudformationDriver) SetLogger(l *logger.Logger) { d.logger = l }
+
+func NewCloudformationDriver(api cloudformationiface.CloudFormationAPI) driver.Driver {
+	return &CloudformationDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *CloudformationDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createstack":
+		if d.dryRun {
+			return d.Create_Stack_DryRun, nil
+		}
+		return d.Create_Stack, nil
+
+	case "updatestack":
+		if d.dryRun {
+			return d.Update_Stack_DryRun, nil
+		}
+		return d.Update_Stack, nil
+
+	case "deletestack":
+		if d.dryRun {
+			return d.Delete_Stack_DryRun, nil
+		}
+		return d.Delete_Stack, nil
+
+	case "checkstack":
+		if d.dryRun {
+			return d.Check_Stack_DryRun, nil
+		}
+		return d.Check_Stack, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type EcsDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	ecsiface.ECSAPI
+}
+
+func (d *EcsDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *EcsDriver) SetLogger(l *logger.Logger) { d.logger = l }
+
+func NewEcsDriver(api ecsiface.ECSAPI) driver.Driver {
+	return &EcsDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *EcsDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createservice":
+		if d.dryRun {
+			return d.Create_Service_DryRun, nil
+		}
+		return d.Create_Service, nil
+
+	case "updateservice":
+		if d.dryRun {
+			return d.Update_Service_DryRun, nil
+		}
+		return d.Update_Service, nil
+
+	case "deleteservice":
+		if d.dryRun {
+			return d.Delete_Service_DryRun, nil
+		}
+		return d.Delete_Service, nil
+
+	case "starttask":
+		if d.dryRun {
+			return d.Start_Task_DryRun, nil
+		}
+		return d.Start_Task, nil
+
+	case "stoptask":
+		if d.dryRun {
+			return d.Stop_Task_DryRun, nil
+		}
+		return d.Stop_Task, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type DynamodbDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	dynamodbiface.DynamoDBAPI
+}
+
+func (d *DynamodbDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *DynamodbDriver) SetLogger(l *logger.Logger) { d.logger = l }
+
+func NewDynamodbDriver(api dynamodbiface.DynamoDBAPI) driver.Driver {
+	return &DynamodbDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *DynamodbDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createtable":
+		if d.dryRun {
+			return d.Create_Table_DryRun, nil
+		}
+		return d.Create_Table, nil
+
+	case "updatetable":
+		if d.dryRun {
+			return d.Update_Table_DryRun, nil
+		}
+		return d.Update_Table, nil
+
+	case "deletetable":
+		if d.dryRun {
+			return d.Delete_Table_DryRun, nil
+		}
+		return d.Delete_Table, nil
+
+	case "checktable":
+		if d.dryRun {
+			return d.Check_Table_DryRun, nil
+		}
+		return d.Check_Table, nil
+
+	default:
+		return nil, driver.ErrDriverFnNotFound
+	}
+}
+
+type KmsDriver struct {
+	dryRun bool
+	logger *logger.Logger
+	kmsiface.KMSAPI
+}
+
+func (d *KmsDriver) SetDryRun(dry bool)         { d.dryRun = dry }
+func (d *KmsDriver) SetLogger(l *logger.Logger) { d.logger = l }
+
+func NewKmsDriver(api kmsiface.KMSAPI) driver.Driver {
+	return &KmsDriver{false, logger.DiscardLogger, api}
+}
+
+func (d *KmsDriver) Lookup(lookups ...string) (driverFn driver.DriverFn, err error) {
+	switch strings.Join(lookups, "") {
+
+	case "createkey":
+		if d.dryRun {
+			return d.Create_Key_DryRun, nil
+		}
+		return d.Create_Key, nil
+
+	case "deletekey":
+		if d.dryRun {
+			return d.Delete_Key_DryRun, nil
+		}
+		return d.Delete_Key, nil
+
+	case "createkeyalias":
+		if d.dryRun {
+			return d.Create_Keyalias_DryRun, nil
+		}
+		return d.Create_Keyalias, nil
+
+	case "deletekeyalias":
+		if d.dryRun {
+			return d.Delete_Keyalias_DryRun, nil
+		}
+		return d.Delete_Keyalias, nil
+
 	default:
 		return nil, driver.ErrDriverFnNotFound
 	}
@@ -29,6 +29,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"cidr"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"cidr": {Type: "cidr", Description: "IPv4 network range of the VPC (from /16 to /28)"},
+		},
 	},
 	"deletevpc": {
 		Action:         "delete",
@@ -37,6 +40,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Regex: "^vpc-", Description: "id of the VPC"},
+		},
 	},
 	"createsubnet": {
 		Action:         "create",
@@ -45,6 +51,11 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"cidr", "vpc"},
 		ExtraParams:    []string{"zone"},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"cidr": {Type: "cidr", Description: "IPv4 network range of the subnet, within the VPC range"},
+			"vpc":  {Type: "awsstr", Description: "id of the VPC"},
+			"zone": {Type: "awsstr", Regex: "^[a-z]{2}(-[a-z]+)+-\\d[a-z]$", Description: "availability zone (ex: us-west-2a)"},
+		},
 	},
 	"updatesubnet": {
 		Action:         "update",
@@ -53,6 +64,10 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{"public"},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":     {Type: "awsstr", Description: "id of the subnet"},
+			"public": {Type: "awsbool", Description: "auto-assign a public IP to instances launched in the subnet"},
+		},
 	},
 	"deletesubnet": {
 		Action:         "delete",
@@ -61,6 +76,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "id of the subnet"},
+		},
 	},
 	"createinstance": {
 		Action:         "create",
@@ -69,6 +87,17 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"image", "count", "count", "type", "subnet"},
 		ExtraParams:    []string{"key", "ip", "userdata", "group", "lock"},
 		TagsMapping:    []string{"name"},
+		Params: map[string]template.ParamDefinition{
+			"image":    {Type: "awsstr", Description: "id of the AMI"},
+			"count":    {Type: "awsint", Description: "number of instances to launch"},
+			"type":     {Type: "awsstr", Regex: "^[a-z][a-z0-9-]*\\.[a-z0-9]+$", Description: "instance type (ex: t2.micro)"},
+			"subnet":   {Type: "awsstr", Description: "id of the subnet to launch the instances in"},
+			"key":      {Type: "awsstr", Description: "name of the keypair"},
+			"ip":       {Type: "awsstr", Regex: "^(\\d{1,3}\\.){3}\\d{1,3}$", Description: "private IPv4 address within the subnet range"},
+			"userdata": {Type: "awsstr", Description: "user data file path or url"},
+			"group":    {Type: "awsstr", Description: "ids of the security groups"},
+			"lock":     {Type: "awsbool", Description: "prevent the instance termination"},
+		},
 	},
 	"updateinstance": {
 		Action:         "update",
@@ -77,6 +106,12 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{"type", "group", "lock"},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":    {Type: "awsstr", Description: "id of the instance"},
+			"type":  {Type: "awsstr", Regex: "^[a-z][a-z0-9-]*\\.[a-z0-9]+$", Description: "instance type (ex: t2.micro)"},
+			"group": {Type: "awsstr", Description: "ids of the security groups"},
+			"lock":  {Type: "awsbool", Description: "prevent the instance termination"},
+		},
 	},
 	"deleteinstance": {
 		Action:         "delete",
@@ -85,6 +120,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "ids of the instances"},
+		},
 	},
 	"startinstance": {
 		Action:         "start",
@@ -93,6 +131,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "ids of the instances"},
+		},
 	},
 	"stopinstance": {
 		Action:         "stop",
@@ -101,6 +142,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "ids of the instances"},
+		},
 	},
 	"checkinstance": {
 		Action:         "check",
@@ -109,6 +153,11 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id", "state", "timeout"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":      {Type: "awsstr", Description: "id of the instance"},
+			"state":   {Type: "enum", AllowedValues: []string{"pending", "running", "shutting-down", "terminated", "stopping", "stopped"}, Description: "expected state of the instance"},
+			"timeout": {Type: "awsint", Description: "timeout in seconds"},
+		},
 	},
 	"createsecuritygroup": {
 		Action:         "create",
@@ -117,6 +166,11 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"name", "vpc", "description"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":        {Type: "awsstr", Description: "name of the security group"},
+			"vpc":         {Type: "awsstr", Description: "id of the VPC"},
+			"description": {Type: "awsstr", Description: "description of the security group"},
+		},
 	},
 	"updatesecuritygroup": {
 		Action:         "update",
@@ -125,6 +179,14 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id", "cidr", "protocol"},
 		ExtraParams:    []string{"inbound", "outbound", "portrange"},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":        {Type: "awsstr", Description: "id of the security group"},
+			"cidr":      {Type: "cidr", Description: "IPv4 network range the rule applies to"},
+			"protocol":  {Type: "awsstr", Regex: "^(tcp|udp|icmp|any|-1|\\d{1,3})$", Description: "protocol of the rule: tcp, udp, icmp, any (or -1) or an IP protocol number"},
+			"inbound":   {Type: "enum", AllowedValues: []string{"authorize", "revoke"}, Description: "authorize or revoke an inbound rule"},
+			"outbound":  {Type: "enum", AllowedValues: []string{"authorize", "revoke"}, Description: "authorize or revoke an outbound rule"},
+			"portrange": {Type: "awsstr", Regex: "^(any|\\d+(-\\d+)?)$", Description: "port or port range (ex: 22, 8080-8090, any)"},
+		},
 	},
 	"deletesecuritygroup": {
 		Action:         "delete",
@@ -133,6 +195,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "id of the security group"},
+		},
 	},
 	"createvolume": {
 		Action:         "create",
@@ -141,6 +206,10 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"zone", "size"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"zone": {Type: "awsstr", Regex: "^[a-z]{2}(-[a-z]+)+-\\d[a-z]$", Description: "availability zone (ex: us-west-2a)"},
+			"size": {Type: "awsint", Description: "size of the volume in GiB"},
+		},
 	},
 	"deletevolume": {
 		Action:         "delete",
@@ -149,6 +218,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "id of the volume"},
+		},
 	},
 	"attachvolume": {
 		Action:         "attach",
@@ -157,6 +229,74 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"device", "id", "instance"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"device":   {Type: "awsstr", Description: "device name exposed to the instance (ex: /dev/sdh)"},
+			"id":       {Type: "awsstr", Description: "id of the volume"},
+			"instance": {Type: "awsstr", Description: "id of the instance"},
+		},
+	},
+	"createsnapshot": {
+		Action:         "create",
+		Entity:         "snapshot",
+		Api:            "ec2",
+		RequiredParams: []string{"volume"},
+		ExtraParams:    []string{"description"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"volume":      {Type: "awsstr", Description: "id of the volume to snapshot"},
+			"description": {Type: "awsstr", Description: "description of the snapshot"},
+		},
+	},
+	"deletesnapshot": {
+		Action:         "delete",
+		Entity:         "snapshot",
+		Api:            "ec2",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "id of the snapshot"},
+		},
+	},
+	"createimage": {
+		Action:         "create",
+		Entity:         "image",
+		Api:            "ec2",
+		RequiredParams: []string{"instance", "name"},
+		ExtraParams:    []string{"description", "noreboot"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"instance":    {Type: "awsstr", Description: "id of the instance to create the image from"},
+			"name":        {Type: "awsstr", Description: "name of the image"},
+			"description": {Type: "awsstr", Description: "description of the image"},
+			"noreboot":    {Type: "awsbool", Description: "do not shut down the instance before creating the image"},
+		},
+	},
+	"deleteimage": {
+		Action:         "delete",
+		Entity:         "image",
+		Api:            "ec2",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "id of the image to deregister (its EBS snapshots are deleted too)"},
+		},
+	},
+	"copyimage": {
+		Action:         "copy",
+		Entity:         "image",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "sourceregion", "name"},
+		ExtraParams:    []string{"description", "encrypted"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":           {Type: "awsstr", Description: "id of the image to copy"},
+			"sourceregion": {Type: "awsstr", Description: "region of the image to copy (the copy is created in the current region)"},
+			"name":         {Type: "awsstr", Description: "name of the new image"},
+			"description":  {Type: "awsstr", Description: "description of the new image"},
+			"encrypted":    {Type: "awsbool", Description: "encrypt the EBS snapshots of the new image"},
+		},
 	},
 	"createinternetgateway": {
 		Action:         "create",
@@ -165,6 +305,7 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params:         map[string]template.ParamDefinition{},
 	},
 	"deleteinternetgateway": {
 		Action:         "delete",
@@ -173,6 +314,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "id of the internet gateway"},
+		},
 	},
 	"attachinternetgateway": {
 		Action:         "attach",
@@ -181,6 +325,10 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id", "vpc"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":  {Type: "awsstr", Description: "id of the internet gateway"},
+			"vpc": {Type: "awsstr", Description: "id of the VPC"},
+		},
 	},
 	"detachinternetgateway": {
 		Action:         "detach",
@@ -189,6 +337,10 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id", "vpc"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":  {Type: "awsstr", Description: "id of the internet gateway"},
+			"vpc": {Type: "awsstr", Description: "id of the VPC"},
+		},
 	},
 	"createroutetable": {
 		Action:         "create",
@@ -197,6 +349,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"vpc"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"vpc": {Type: "awsstr", Description: "id of the VPC"},
+		},
 	},
 	"deleteroutetable": {
 		Action:         "delete",
@@ -205,6 +360,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "id of the route table"},
+		},
 	},
 	"attachroutetable": {
 		Action:         "attach",
@@ -213,6 +371,10 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id", "subnet"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":     {Type: "awsstr", Description: "id of the route table"},
+			"subnet": {Type: "awsstr", Description: "id of the subnet"},
+		},
 	},
 	"detachroutetable": {
 		Action:         "detach",
@@ -221,14 +383,24 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"association"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"association": {Type: "awsstr", Description: "id of the association between the route table and the subnet"},
+		},
 	},
 	"createroute": {
 		Action:         "create",
 		Entity:         "route",
 		Api:            "ec2",
-		RequiredParams: []string{"table", "cidr", "gateway"},
-		ExtraParams:    []string{},
+		RequiredParams: []string{"table", "cidr"},
+		ExtraParams:    []string{"gateway", "natgateway", "instance"},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"table":      {Type: "awsstr", Description: "id of the route table"},
+			"cidr":       {Type: "cidr", Description: "destination IPv4 network range"},
+			"gateway":    {Type: "awsstr", Description: "id of the internet gateway"},
+			"natgateway": {Type: "awsstr", Regex: "^nat-", Description: "id of the NAT gateway"},
+			"instance":   {Type: "awsstr", Regex: "^i-", Description: "id of the NAT instance"},
+		},
 	},
 	"deleteroute": {
 		Action:         "delete",
@@ -237,6 +409,95 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"table", "cidr"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"table": {Type: "awsstr", Description: "id of the route table"},
+			"cidr":  {Type: "cidr", Description: "destination IPv4 network range"},
+		},
+	},
+	"createnatgateway": {
+		Action:         "create",
+		Entity:         "natgateway",
+		Api:            "ec2",
+		RequiredParams: []string{"elasticip", "subnet"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"elasticip": {Type: "awsstr", Regex: "^eipalloc-", Description: "allocation id of the elastic IP of the NAT gateway"},
+			"subnet":    {Type: "awsstr", Description: "id of the public subnet of the NAT gateway"},
+		},
+	},
+	"deletenatgateway": {
+		Action:         "delete",
+		Entity:         "natgateway",
+		Api:            "ec2",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "id of the NAT gateway"},
+		},
+	},
+	"checknatgateway": {
+		Action:         "check",
+		Entity:         "natgateway",
+		Api:            "ec2",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":      {Type: "awsstr", Description: "id of the NAT gateway"},
+			"state":   {Type: "enum", AllowedValues: []string{"pending", "failed", "available", "deleting", "deleted"}, Description: "expected state of the NAT gateway"},
+			"timeout": {Type: "awsint", Description: "timeout in seconds"},
+		},
+	},
+	"createelasticip": {
+		Action:         "create",
+		Entity:         "elasticip",
+		Api:            "ec2",
+		RequiredParams: []string{"domain"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"domain": {Type: "enum", AllowedValues: []string{"vpc", "standard"}, Description: "use of the elastic IP: in a VPC or with EC2-Classic"},
+		},
+	},
+	"deleteelasticip": {
+		Action:         "delete",
+		Entity:         "elasticip",
+		Api:            "ec2",
+		RequiredParams: []string{},
+		ExtraParams:    []string{"id", "ip"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "allocation id of the elastic IP (VPC)"},
+			"ip": {Type: "awsstr", Description: "elastic IP (EC2-Classic)"},
+		},
+	},
+	"attachelasticip": {
+		Action:         "attach",
+		Entity:         "elasticip",
+		Api:            "ec2",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"instance", "networkinterface", "privateip", "allowreassociation"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":                 {Type: "awsstr", Description: "allocation id of the elastic IP"},
+			"instance":           {Type: "awsstr", Description: "id of the instance"},
+			"networkinterface":   {Type: "awsstr", Description: "id of the network interface"},
+			"privateip":          {Type: "awsstr", Description: "private IP of the instance or network interface the elastic IP is associated with"},
+			"allowreassociation": {Type: "awsbool", Description: "move the elastic IP when it is already associated"},
+		},
+	},
+	"detachelasticip": {
+		Action:         "detach",
+		Entity:         "elasticip",
+		Api:            "ec2",
+		RequiredParams: []string{"association"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"association": {Type: "awsstr", Description: "id of the association between the elastic IP and the instance"},
+		},
 	},
 	"createtag": {
 		Action:         "create",
@@ -245,6 +506,11 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"resource", "key", "value"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"resource": {Type: "awsstr", Description: "id of the resource to tag"},
+			"key":      {Type: "awsstr", Description: "key of the tag"},
+			"value":    {Type: "awsstr", Description: "value of the tag"},
+		},
 	},
 	"createkeypair": {
 		Action:         "create",
@@ -253,6 +519,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Description: "name of the keypair"},
+		},
 	},
 	"deletekeypair": {
 		Action:         "delete",
@@ -261,6 +530,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"id"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "name of the keypair"},
+		},
 	},
 	"createloadbalancer": {
 		Action:         "create",
@@ -269,6 +541,13 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"name", "subnets"},
 		ExtraParams:    []string{"iptype", "scheme", "groups"},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":    {Type: "awsstr", Regex: "^[a-zA-Z0-9-]{1,32}$", Description: "name of the load balancer"},
+			"subnets": {Type: "awsstr", Description: "ids of the subnets, in at least two availability zones"},
+			"iptype":  {Type: "enum", AllowedValues: []string{"ipv4", "dualstack"}, Description: "type of IP addresses used by the subnets"},
+			"scheme":  {Type: "enum", AllowedValues: []string{"internet-facing", "internal"}, Description: "scheme of the load balancer"},
+			"groups":  {Type: "awsstr", Description: "ids of the security groups"},
+		},
 	},
 	"deleteloadbalancer": {
 		Action:         "delete",
@@ -277,6 +556,33 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"arn"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"arn": {Type: "awsstr", Regex: "^arn:aws:elasticloadbalancing:", Description: "ARN of the load balancer"},
+		},
+	},
+	"attachclassicloadbalancer": {
+		Action:         "attach",
+		Entity:         "classicloadbalancer",
+		Api:            "elb",
+		RequiredParams: []string{"name", "instances"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":      {Type: "awsstr", Description: "name of the classic load balancer"},
+			"instances": {Type: "awsstr", Description: "ids of the instances to register"},
+		},
+	},
+	"detachclassicloadbalancer": {
+		Action:         "detach",
+		Entity:         "classicloadbalancer",
+		Api:            "elb",
+		RequiredParams: []string{"name", "instances"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":      {Type: "awsstr", Description: "name of the classic load balancer"},
+			"instances": {Type: "awsstr", Description: "ids of the instances to deregister"},
+		},
 	},
 	"createuser": {
 		Action:         "create",
@@ -285,6 +591,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Description: "name of the user"},
+		},
 	},
 	"deleteuser": {
 		Action:         "delete",
@@ -293,6 +602,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Description: "name of the user"},
+		},
 	},
 	"attachuser": {
 		Action:         "attach",
@@ -301,6 +613,10 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"group", "name"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"group": {Type: "awsstr", Description: "name of the group"},
+			"name":  {Type: "awsstr", Description: "name of the user"},
+		},
 	},
 	"detachuser": {
 		Action:         "detach",
@@ -309,6 +625,10 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"group", "name"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"group": {Type: "awsstr", Description: "name of the group"},
+			"name":  {Type: "awsstr", Description: "name of the user"},
+		},
 	},
 	"creategroup": {
 		Action:         "create",
@@ -317,6 +637,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Description: "name of the group"},
+		},
 	},
 	"deletegroup": {
 		Action:         "delete",
@@ -325,22 +648,157 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Description: "name of the group"},
+		},
+	},
+	"createrole": {
+		Action:         "create",
+		Entity:         "role",
+		Api:            "iam",
+		RequiredParams: []string{"name"},
+		ExtraParams:    []string{"principal", "trustpolicy", "path"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":        {Type: "awsstr", Description: "name of the role"},
+			"principal":   {Type: "awsstr", Description: "service (ex: ec2.amazonaws.com), account id or ARN allowed to assume the role"},
+			"trustpolicy": {Type: "awsstr", Description: "local path of the JSON trust policy of the role (instead of a principal)"},
+			"path":        {Type: "awsstr", Description: "path of the role (default: /)"},
+		},
+	},
+	"deleterole": {
+		Action:         "delete",
+		Entity:         "role",
+		Api:            "iam",
+		RequiredParams: []string{"name"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Description: "name of the role"},
+		},
+	},
+	"attachrole": {
+		Action:         "attach",
+		Entity:         "role",
+		Api:            "iam",
+		RequiredParams: []string{"name", "instanceprofile"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":            {Type: "awsstr", Description: "name of the role"},
+			"instanceprofile": {Type: "awsstr", Description: "name of the instance profile"},
+		},
+	},
+	"detachrole": {
+		Action:         "detach",
+		Entity:         "role",
+		Api:            "iam",
+		RequiredParams: []string{"name", "instanceprofile"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":            {Type: "awsstr", Description: "name of the role"},
+			"instanceprofile": {Type: "awsstr", Description: "name of the instance profile"},
+		},
+	},
+	"createinstanceprofile": {
+		Action:         "create",
+		Entity:         "instanceprofile",
+		Api:            "iam",
+		RequiredParams: []string{"name"},
+		ExtraParams:    []string{"path"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Description: "name of the instance profile"},
+			"path": {Type: "awsstr", Description: "path of the instance profile (default: /)"},
+		},
+	},
+	"deleteinstanceprofile": {
+		Action:         "delete",
+		Entity:         "instanceprofile",
+		Api:            "iam",
+		RequiredParams: []string{"name"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Description: "name of the instance profile"},
+		},
+	},
+	"createaccesskey": {
+		Action:         "create",
+		Entity:         "accesskey",
+		Api:            "iam",
+		RequiredParams: []string{},
+		ExtraParams:    []string{"user"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"user": {Type: "awsstr", Description: "name of the user owning the key (default: current user)"},
+		},
+	},
+	"deleteaccesskey": {
+		Action:         "delete",
+		Entity:         "accesskey",
+		Api:            "iam",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"user"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":   {Type: "awsstr", Description: "id of the access key"},
+			"user": {Type: "awsstr", Description: "name of the user owning the key (default: current user)"},
+		},
+	},
+	"createpolicy": {
+		Action:         "create",
+		Entity:         "policy",
+		Api:            "iam",
+		RequiredParams: []string{"name", "document"},
+		ExtraParams:    []string{"description", "path"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":        {Type: "awsstr", Description: "name of the policy"},
+			"document":    {Type: "awsstr", Description: "local path of the JSON document of the policy"},
+			"description": {Type: "awsstr", Description: "description of the policy"},
+			"path":        {Type: "awsstr", Description: "path of the policy (default: /)"},
+		},
+	},
+	"deletepolicy": {
+		Action:         "delete",
+		Entity:         "policy",
+		Api:            "iam",
+		RequiredParams: []string{"arn"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"arn": {Type: "awsstr", Regex: "^arn:aws:iam::", Description: "ARN of the policy"},
+		},
 	},
 	"attachpolicy": {
 		Action:         "attach",
 		Entity:         "policy",
 		Api:            "iam",
 		RequiredParams: []string{"arn"},
-		ExtraParams:    []string{"user", "group"},
+		ExtraParams:    []string{"user", "group", "role"},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"arn":   {Type: "awsstr", Regex: "^arn:aws:iam::", Description: "ARN of the policy"},
+			"user":  {Type: "awsstr", Description: "name of the user"},
+			"group": {Type: "awsstr", Description: "name of the group"},
+			"role":  {Type: "awsstr", Description: "name of the role"},
+		},
 	},
 	"detachpolicy": {
 		Action:         "detach",
 		Entity:         "policy",
 		Api:            "iam",
 		RequiredParams: []string{"arn"},
-		ExtraParams:    []string{"user", "group"},
+		ExtraParams:    []string{"user", "group", "role"},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"arn":   {Type: "awsstr", Regex: "^arn:aws:iam::", Description: "ARN of the policy"},
+			"user":  {Type: "awsstr", Description: "name of the user"},
+			"group": {Type: "awsstr", Description: "name of the group"},
+			"role":  {Type: "awsstr", Description: "name of the role"},
+		},
 	},
 	"createbucket": {
 		Action:         "create",
@@ -349,6 +807,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Regex: "^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$", Description: "name of the bucket"},
+		},
 	},
 	"deletebucket": {
 		Action:         "delete",
@@ -357,6 +818,27 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Description: "name of the bucket"},
+		},
+	},
+	"updatebucket": {
+		Action:         "update",
+		Entity:         "bucket",
+		Api:            "s3",
+		RequiredParams: []string{"name"},
+		ExtraParams:    []string{"acl", "versioning", "encryption", "encryptionkey", "publicaccessblock", "policy", "lifecycle"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":              {Type: "awsstr", Description: "name of the bucket"},
+			"acl":               {Type: "enum", AllowedValues: []string{"private", "public-read", "public-read-write", "authenticated-read"}, Description: "canned ACL of the bucket (private removes public access)"},
+			"versioning":        {Type: "enum", AllowedValues: []string{"enabled", "suspended"}, Description: "versioning of the objects of the bucket"},
+			"encryption":        {Type: "enum", AllowedValues: []string{"aes256", "kms", "none"}, Description: "default server side encryption of the objects of the bucket ('none' deletes it)"},
+			"encryptionkey":     {Type: "awsstr", Description: "KMS key (id or ARN) of the default encryption (with encryption=kms, default to the AWS managed key)"},
+			"publicaccessblock": {Type: "enum", AllowedValues: []string{"true", "false"}, Description: "block all public access to the bucket (false deletes the public access block)"},
+			"policy":            {Type: "awsstr", Description: "local path of the JSON policy of the bucket ('none' deletes the policy)"},
+			"lifecycle":         {Type: "awsstr", Description: "local path of the JSON lifecycle configuration of the bucket, holding its Rules ('none' deletes it)"},
+		},
 	},
 	"createstorageobject": {
 		Action:         "create",
@@ -365,6 +847,11 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"bucket", "file"},
 		ExtraParams:    []string{"name"},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"bucket": {Type: "awsstr", Description: "name of the bucket"},
+			"file":   {Type: "awsstr", Description: "path of the file to upload"},
+			"name":   {Type: "awsstr", Description: "key of the object (default to the file name)"},
+		},
 	},
 	"deletestorageobject": {
 		Action:         "delete",
@@ -373,6 +860,10 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"bucket", "key"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"bucket": {Type: "awsstr", Description: "name of the bucket"},
+			"key":    {Type: "awsstr", Description: "key of the object"},
+		},
 	},
 	"createtopic": {
 		Action:         "create",
@@ -381,6 +872,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Description: "name of the topic"},
+		},
 	},
 	"deletetopic": {
 		Action:         "delete",
@@ -389,6 +883,22 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"arn"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"arn": {Type: "awsstr", Regex: "^arn:aws:sns:", Description: "ARN of the topic"},
+		},
+	},
+	"publishtopic": {
+		Action:         "publish",
+		Entity:         "topic",
+		Api:            "sns",
+		RequiredParams: []string{"arn", "message"},
+		ExtraParams:    []string{"subject"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"arn":     {Type: "awsstr", Regex: "^arn:aws:sns:", Description: "ARN of the topic"},
+			"message": {Type: "awsstr", Description: "message to publish (between double quotes when it contains spaces)"},
+			"subject": {Type: "awsstr", Description: "subject of the message (used by email endpoints)"},
+		},
 	},
 	"createsubscription": {
 		Action:         "create",
@@ -397,6 +907,11 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"topic", "endpoint", "protocol"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"topic":    {Type: "awsstr", Regex: "^arn:aws:sns:", Description: "ARN of the topic"},
+			"endpoint": {Type: "awsstr", Description: "endpoint receiving the notifications (url, email, ARN, ...)"},
+			"protocol": {Type: "enum", AllowedValues: []string{"http", "https", "email", "email-json", "sms", "sqs", "application", "lambda"}, Description: "protocol of the endpoint"},
+		},
 	},
 	"deletesubscription": {
 		Action:         "delete",
@@ -405,6 +920,9 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"arn"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"arn": {Type: "awsstr", Regex: "^arn:aws:sns:", Description: "ARN of the subscription"},
+		},
 	},
 	"createqueue": {
 		Action:         "create",
@@ -413,6 +931,16 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"name"},
 		ExtraParams:    []string{"delay", "maxMsgSize", "retentionPeriod", "policy", "msgWait", "redrivePolicy", "visibilityTimeout"},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":              {Type: "awsstr", Description: "name of the queue"},
+			"delay":             {Type: "awsint", Description: "delivery delay of the messages in seconds"},
+			"maxMsgSize":        {Type: "awsint", Description: "maximum message size in bytes"},
+			"retentionPeriod":   {Type: "awsint", Description: "retention period of the messages in seconds"},
+			"policy":            {Type: "awsstr", Description: "access policy of the queue (JSON)"},
+			"msgWait":           {Type: "awsint", Description: "long polling wait time of receive calls in seconds"},
+			"redrivePolicy":     {Type: "awsstr", Description: "dead letter queue policy (JSON)"},
+			"visibilityTimeout": {Type: "awsint", Description: "visibility timeout of the messages in seconds"},
+		},
 	},
 	"deletequeue": {
 		Action:         "delete",
@@ -421,6 +949,638 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 		RequiredParams: []string{"url"},
 		ExtraParams:    []string{},
 		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"url": {Type: "awsstr", Description: "url of the queue"},
+		},
+	},
+	"updatequeue": {
+		Action:         "update",
+		Entity:         "queue",
+		Api:            "sqs",
+		RequiredParams: []string{"url"},
+		ExtraParams:    []string{"delay", "maxMsgSize", "retentionPeriod", "msgWait", "visibilityTimeout", "deadletterqueue", "maxreceive"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"url":               {Type: "awsstr", Description: "url of the queue"},
+			"delay":             {Type: "awsint", Description: "delivery delay of the messages in seconds"},
+			"maxMsgSize":        {Type: "awsint", Description: "maximum message size in bytes"},
+			"retentionPeriod":   {Type: "awsint", Description: "retention period of the messages in seconds"},
+			"msgWait":           {Type: "awsint", Description: "long polling wait time of receive calls in seconds"},
+			"visibilityTimeout": {Type: "awsint", Description: "visibility timeout of the messages in seconds"},
+			"deadletterqueue":   {Type: "awsstr", Description: "ARN of the dead letter queue receiving the messages received too many times ('none' to remove it)"},
+			"maxreceive":        {Type: "awsint", Description: "number of receives before a message goes to the dead letter queue (default: 5)"},
+		},
+	},
+	"sendqueue": {
+		Action:         "send",
+		Entity:         "queue",
+		Api:            "sqs",
+		RequiredParams: []string{"url", "message"},
+		ExtraParams:    []string{"delay"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"url":     {Type: "awsstr", Description: "url of the queue"},
+			"message": {Type: "awsstr", Description: "body of the message (between double quotes when it contains spaces)"},
+			"delay":   {Type: "awsint", Description: "delivery delay of the message in seconds"},
+		},
+	},
+	"receivequeue": {
+		Action:         "receive",
+		Entity:         "queue",
+		Api:            "sqs",
+		RequiredParams: []string{"url"},
+		ExtraParams:    []string{"max", "wait", "visibility", "delete"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"url":        {Type: "awsstr", Description: "url of the queue"},
+			"max":        {Type: "awsint", Description: "maximum number of messages to receive, from 1 to 10 (default: 1)"},
+			"wait":       {Type: "awsint", Description: "seconds to wait for messages to arrive (long polling)"},
+			"visibility": {Type: "awsint", Description: "seconds the received messages are hidden from other receivers"},
+			"delete":     {Type: "awsbool", Description: "delete the messages once received"},
+		},
+	},
+	"purgequeue": {
+		Action:         "purge",
+		Entity:         "queue",
+		Api:            "sqs",
+		RequiredParams: []string{"url"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"url": {Type: "awsstr", Description: "url of the queue"},
+		},
+	},
+	"createdatabase": {
+		Action:         "create",
+		Entity:         "database",
+		Api:            "rds",
+		RequiredParams: []string{"type", "id", "engine", "password", "username", "size"},
+		ExtraParams:    []string{"autoupgrade", "zone", "backupretention", "backupwindow", "dbname", "version", "iops", "license", "maintenancewindow", "multiaz", "optiongroup", "parametergroup", "port", "public", "encrypted", "storagetype", "subnetgroup", "groups"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"type":              {Type: "awsstr", Regex: "^db\\.", Description: "instance class of the database (e.g. db.t2.micro)"},
+			"id":                {Type: "awsstr", Regex: "^[a-zA-Z][a-zA-Z0-9-]{0,62}$", Description: "identifier of the database"},
+			"engine":            {Type: "enum", AllowedValues: []string{"mysql", "mariadb", "postgres", "aurora", "oracle-se1", "oracle-se2", "oracle-se", "oracle-ee", "sqlserver-ee", "sqlserver-se", "sqlserver-ex", "sqlserver-web"}, Description: "database engine"},
+			"password":          {Type: "awsstr", Description: "password of the master user"},
+			"username":          {Type: "awsstr", Description: "name of the master user"},
+			"size":              {Type: "awsint", Description: "storage to allocate in GiB"},
+			"autoupgrade":       {Type: "awsbool", Description: "apply minor engine upgrades automatically"},
+			"zone":              {Type: "awsstr", Description: "availability zone of the database"},
+			"backupretention":   {Type: "awsint", Description: "number of days automated backups are kept"},
+			"backupwindow":      {Type: "awsstr", Description: "daily time range of automated backups (hh24:mi-hh24:mi)"},
+			"dbname":            {Type: "awsstr", Description: "name of the database created with the instance"},
+			"version":           {Type: "awsstr", Description: "version of the database engine"},
+			"iops":              {Type: "awsint", Description: "provisioned IOPS of the storage"},
+			"license":           {Type: "enum", AllowedValues: []string{"license-included", "bring-your-own-license", "general-public-license"}, Description: "license model of the database"},
+			"maintenancewindow": {Type: "awsstr", Description: "weekly time range of maintenance (ddd:hh24:mi-ddd:hh24:mi)"},
+			"multiaz":           {Type: "awsbool", Description: "deploy a standby replica in another availability zone"},
+			"optiongroup":       {Type: "awsstr", Description: "name of the option group"},
+			"parametergroup":    {Type: "awsstr", Description: "name of the parameter group"},
+			"port":              {Type: "awsint", Description: "port the database listens on"},
+			"public":            {Type: "awsbool", Description: "give the database a public address"},
+			"encrypted":         {Type: "awsbool", Description: "encrypt the storage"},
+			"storagetype":       {Type: "enum", AllowedValues: []string{"standard", "gp2", "io1"}, Description: "type of the storage"},
+			"subnetgroup":       {Type: "awsstr", Description: "name of the subnet group"},
+			"groups":            {Type: "awsstr", Regex: "^sg-", Description: "ids of the security groups"},
+		},
+	},
+	"deletedatabase": {
+		Action:         "delete",
+		Entity:         "database",
+		Api:            "rds",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"skipsnapshot", "snapshot"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":           {Type: "awsstr", Description: "identifier of the database"},
+			"skipsnapshot": {Type: "awsbool", Description: "delete without taking a final snapshot"},
+			"snapshot":     {Type: "awsstr", Description: "identifier of the final snapshot"},
+		},
+	},
+	"startdatabase": {
+		Action:         "start",
+		Entity:         "database",
+		Api:            "rds",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "identifier of the database"},
+		},
+	},
+	"stopdatabase": {
+		Action:         "stop",
+		Entity:         "database",
+		Api:            "rds",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"snapshot"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":       {Type: "awsstr", Description: "identifier of the database"},
+			"snapshot": {Type: "awsstr", Description: "identifier of the snapshot taken before stopping the database"},
+		},
+	},
+	"createfunction": {
+		Action:         "create",
+		Entity:         "function",
+		Api:            "lambda",
+		RequiredParams: []string{"name", "handler", "role", "runtime", "zipfile"},
+		ExtraParams:    []string{"description", "memory", "timeout", "publish", "subnets", "groups"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":        {Type: "awsstr", Description: "name of the function"},
+			"handler":     {Type: "awsstr", Description: "entrypoint of the function in its code (e.g. index.handler)"},
+			"role":        {Type: "awsstr", Regex: "^arn:aws:iam::", Description: "ARN of the execution role of the function"},
+			"runtime":     {Type: "awsstr", Description: "runtime of the function (e.g. nodejs4.3, python2.7, java8)"},
+			"zipfile":     {Type: "awsstr", Description: "local path of the zip file or directory of the function code"},
+			"description": {Type: "awsstr", Description: "description of the function"},
+			"memory":      {Type: "awsint", Description: "memory of the function in MB"},
+			"timeout":     {Type: "awsint", Description: "execution timeout of the function in seconds"},
+			"publish":     {Type: "awsbool", Description: "publish a version of the function"},
+			"subnets":     {Type: "awsstr", Regex: "^subnet-", Description: "ids of the subnets the function runs in"},
+			"groups":      {Type: "awsstr", Regex: "^sg-", Description: "ids of the security groups of the function"},
+		},
+	},
+	"updatefunction": {
+		Action:         "update",
+		Entity:         "function",
+		Api:            "lambda",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"zipfile", "publish", "handler", "role", "runtime", "description", "memory", "timeout"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":          {Type: "awsstr", Description: "name or ARN of the function"},
+			"zipfile":     {Type: "awsstr", Description: "local path of the zip file or directory of the new function code"},
+			"publish":     {Type: "awsbool", Description: "publish a version of the function"},
+			"handler":     {Type: "awsstr", Description: "entrypoint of the function in its code (e.g. index.handler)"},
+			"role":        {Type: "awsstr", Regex: "^arn:aws:iam::", Description: "ARN of the execution role of the function"},
+			"runtime":     {Type: "awsstr", Description: "runtime of the function (e.g. nodejs4.3, python2.7, java8)"},
+			"description": {Type: "awsstr", Description: "description of the function"},
+			"memory":      {Type: "awsint", Description: "memory of the function in MB"},
+			"timeout":     {Type: "awsint", Description: "execution timeout of the function in seconds"},
+		},
+	},
+	"deletefunction": {
+		Action:         "delete",
+		Entity:         "function",
+		Api:            "lambda",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"version"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":      {Type: "awsstr", Description: "name or ARN of the function"},
+			"version": {Type: "awsstr", Description: "version of the function to delete (all versions when empty)"},
+		},
+	},
+	"createeventsource": {
+		Action:         "create",
+		Entity:         "eventsource",
+		Api:            "lambda",
+		RequiredParams: []string{"function", "queue"},
+		ExtraParams:    []string{"batchsize", "enabled"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"function":  {Type: "awsstr", Description: "name or ARN of the function"},
+			"queue":     {Type: "awsstr", Description: "url or ARN of the SQS queue triggering the function"},
+			"batchsize": {Type: "awsint", Description: "maximum number of messages sent to the function at once"},
+			"enabled":   {Type: "awsbool", Description: "enable the mapping (default true)"},
+		},
+	},
+	"deleteeventsource": {
+		Action:         "delete",
+		Entity:         "eventsource",
+		Api:            "lambda",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "UUID of the event source mapping"},
+		},
+	},
+	"createrecord": {
+		Action:         "create",
+		Entity:         "record",
+		Api:            "route53",
+		RequiredParams: []string{"zone", "name", "type", "value", "ttl"},
+		ExtraParams:    []string{"comment"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"zone":    {Type: "awsstr", Description: "id of the hosted zone"},
+			"name":    {Type: "awsstr", Description: "fully qualified domain name of the record"},
+			"type":    {Type: "enum", AllowedValues: []string{"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"}, Description: "type of the record"},
+			"value":   {Type: "awsstr", Description: "values of the record"},
+			"ttl":     {Type: "awsint", Description: "time to live of the record in seconds"},
+			"comment": {Type: "awsstr", Description: "comment of the change"},
+		},
+	},
+	"updaterecord": {
+		Action:         "update",
+		Entity:         "record",
+		Api:            "route53",
+		RequiredParams: []string{"zone", "name", "type", "value", "ttl"},
+		ExtraParams:    []string{"comment"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"zone":    {Type: "awsstr", Description: "id of the hosted zone"},
+			"name":    {Type: "awsstr", Description: "fully qualified domain name of the record"},
+			"type":    {Type: "enum", AllowedValues: []string{"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"}, Description: "type of the record"},
+			"value":   {Type: "awsstr", Description: "values of the record"},
+			"ttl":     {Type: "awsint", Description: "time to live of the record in seconds"},
+			"comment": {Type: "awsstr", Description: "comment of the change"},
+		},
+	},
+	"deleterecord": {
+		Action:         "delete",
+		Entity:         "record",
+		Api:            "route53",
+		RequiredParams: []string{"zone", "name", "type", "value", "ttl"},
+		ExtraParams:    []string{"comment"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"zone":    {Type: "awsstr", Description: "id of the hosted zone"},
+			"name":    {Type: "awsstr", Description: "fully qualified domain name of the record"},
+			"type":    {Type: "enum", AllowedValues: []string{"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"}, Description: "type of the record"},
+			"value":   {Type: "awsstr", Description: "values of the record"},
+			"ttl":     {Type: "awsint", Description: "time to live of the record in seconds"},
+			"comment": {Type: "awsstr", Description: "comment of the change"},
+		},
+	},
+	"checkrecord": {
+		Action:         "check",
+		Entity:         "record",
+		Api:            "route53",
+		RequiredParams: []string{"id", "timeout"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":      {Type: "awsstr", Description: "id of the change returned when creating, updating or deleting a record"},
+			"timeout": {Type: "awsint", Description: "timeout in seconds"},
+		},
+	},
+	"createlaunchconfiguration": {
+		Action:         "create",
+		Entity:         "launchconfiguration",
+		Api:            "autoscaling",
+		RequiredParams: []string{"image", "type", "name"},
+		ExtraParams:    []string{"public", "keypair", "groups", "role", "spotprice", "monitoring"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"image":      {Type: "awsstr", Description: "id of the AMI of the instances"},
+			"type":       {Type: "awsstr", Description: "type of the instances (e.g. t2.micro)"},
+			"name":       {Type: "awsstr", Description: "name of the launch configuration"},
+			"public":     {Type: "awsbool", Description: "assign a public IP to the instances"},
+			"keypair":    {Type: "awsstr", Description: "name of the keypair of the instances"},
+			"groups":     {Type: "awsstr", Regex: "^sg-", Description: "ids of the security groups of the instances"},
+			"role":       {Type: "awsstr", Description: "name or ARN of the instance profile of the instances"},
+			"spotprice":  {Type: "awsstr", Description: "maximum hourly price for spot instances"},
+			"monitoring": {Type: "awsbool", Description: "enable detailed monitoring of the instances"},
+		},
+	},
+	"deletelaunchconfiguration": {
+		Action:         "delete",
+		Entity:         "launchconfiguration",
+		Api:            "autoscaling",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "name of the launch configuration"},
+		},
+	},
+	"createscalinggroup": {
+		Action:         "create",
+		Entity:         "scalinggroup",
+		Api:            "autoscaling",
+		RequiredParams: []string{"name", "launchconfiguration", "maxsize", "minsize", "subnets"},
+		ExtraParams:    []string{"cooldown", "desiredcapacity", "healthcheckgraceperiod", "healthchecktype", "newinstancesprotected", "targetgroups"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":                   {Type: "awsstr", Description: "name of the scaling group"},
+			"launchconfiguration":    {Type: "awsstr", Description: "name of the launch configuration of the instances"},
+			"maxsize":                {Type: "awsint", Description: "maximum number of instances"},
+			"minsize":                {Type: "awsint", Description: "minimum number of instances"},
+			"subnets":                {Type: "awsstr", Regex: "^subnet-", Description: "ids of the subnets the instances are launched in"},
+			"cooldown":               {Type: "awsint", Description: "seconds between two scaling activities"},
+			"desiredcapacity":        {Type: "awsint", Description: "number of instances to run (between minsize and maxsize)"},
+			"healthcheckgraceperiod": {Type: "awsint", Description: "seconds before checking the health of a new instance"},
+			"healthchecktype":        {Type: "enum", AllowedValues: []string{"EC2", "ELB"}, Description: "type of health check of the instances"},
+			"newinstancesprotected":  {Type: "awsbool", Description: "protect new instances from scale in"},
+			"targetgroups":           {Type: "awsstr", Description: "ARNs of the target groups the instances are registered in"},
+		},
+	},
+	"updatescalinggroup": {
+		Action:         "update",
+		Entity:         "scalinggroup",
+		Api:            "autoscaling",
+		RequiredParams: []string{"name"},
+		ExtraParams:    []string{"launchconfiguration", "maxsize", "minsize", "desiredcapacity", "cooldown", "healthcheckgraceperiod", "healthchecktype", "newinstancesprotected", "subnets"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":                   {Type: "awsstr", Description: "name of the scaling group"},
+			"launchconfiguration":    {Type: "awsstr", Description: "name of the launch configuration of the instances"},
+			"maxsize":                {Type: "awsint", Description: "maximum number of instances"},
+			"minsize":                {Type: "awsint", Description: "minimum number of instances"},
+			"desiredcapacity":        {Type: "awsint", Description: "number of instances to run (between minsize and maxsize)"},
+			"cooldown":               {Type: "awsint", Description: "seconds between two scaling activities"},
+			"healthcheckgraceperiod": {Type: "awsint", Description: "seconds before checking the health of a new instance"},
+			"healthchecktype":        {Type: "enum", AllowedValues: []string{"EC2", "ELB"}, Description: "type of health check of the instances"},
+			"newinstancesprotected":  {Type: "awsbool", Description: "protect new instances from scale in"},
+			"subnets":                {Type: "awsstr", Regex: "^subnet-", Description: "ids of the subnets the instances are launched in"},
+		},
+	},
+	"deletescalinggroup": {
+		Action:         "delete",
+		Entity:         "scalinggroup",
+		Api:            "autoscaling",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"force"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":    {Type: "awsstr", Description: "name of the scaling group"},
+			"force": {Type: "awsbool", Description: "delete the group and terminate its instances without waiting for them"},
+		},
+	},
+	"checkscalinggroup": {
+		Action:         "check",
+		Entity:         "scalinggroup",
+		Api:            "autoscaling",
+		RequiredParams: []string{"id", "count", "timeout"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":      {Type: "awsstr", Description: "name of the scaling group"},
+			"count":   {Type: "awsint", Description: "expected number of instances in service (0 also waits for a deleted group to be gone)"},
+			"timeout": {Type: "awsint", Description: "timeout in seconds"},
+		},
+	},
+	"createalarm": {
+		Action:         "create",
+		Entity:         "alarm",
+		Api:            "cloudwatch",
+		RequiredParams: []string{"name", "operator", "metric", "namespace", "evaluationperiods", "period", "statistic", "threshold"},
+		ExtraParams:    []string{"enabled", "alarmactions", "insufficientdataactions", "okactions", "description", "dimensions", "unit"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":                    {Type: "awsstr", Description: "name of the alarm"},
+			"operator":                {Type: "enum", AllowedValues: []string{"GreaterThanOrEqualToThreshold", "GreaterThanThreshold", "LessThanThreshold", "LessThanOrEqualToThreshold"}, Description: "comparison of the statistic with the threshold"},
+			"metric":                  {Type: "awsstr", Description: "name of the metric (e.g. CPUUtilization)"},
+			"namespace":               {Type: "awsstr", Description: "namespace of the metric (e.g. AWS/EC2)"},
+			"evaluationperiods":       {Type: "awsint", Description: "number of periods over which the statistic is compared to the threshold"},
+			"period":                  {Type: "awsint", Description: "length in seconds of a period (multiple of 60)"},
+			"statistic":               {Type: "enum", AllowedValues: []string{"SampleCount", "Average", "Sum", "Minimum", "Maximum"}, Description: "statistic applied to the metric"},
+			"threshold":               {Type: "awsstr", Regex: "^-?[0-9]+(\\.[0-9]+)?$", Description: "value compared to the statistic"},
+			"enabled":                 {Type: "awsbool", Description: "execute the actions of the alarm when its state changes"},
+			"alarmactions":            {Type: "awsstr", Description: "ARNs of the actions (e.g. SNS topics) executed when entering the ALARM state"},
+			"insufficientdataactions": {Type: "awsstr", Description: "ARNs of the actions executed when entering the INSUFFICIENT_DATA state"},
+			"okactions":               {Type: "awsstr", Description: "ARNs of the actions executed when entering the OK state"},
+			"description":             {Type: "awsstr", Description: "description of the alarm"},
+			"dimensions":              {Type: "awsstr", Description: "dimensions of the metric as Name:Value (e.g. InstanceId:i-12345678)"},
+			"unit":                    {Type: "awsstr", Description: "unit of the metric (e.g. Percent)"},
+		},
+	},
+	"deletealarm": {
+		Action:         "delete",
+		Entity:         "alarm",
+		Api:            "cloudwatch",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "name of the alarm"},
+		},
+	},
+	"createstack": {
+		Action:         "create",
+		Entity:         "stack",
+		Api:            "cloudformation",
+		RequiredParams: []string{"name", "templatefile"},
+		ExtraParams:    []string{"parameters", "capabilities", "notifications", "role", "disablerollback", "timeout"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":            {Type: "awsstr", Description: "name of the stack"},
+			"templatefile":    {Type: "awsstr", Description: "path to the local JSON or YAML CloudFormation template"},
+			"parameters":      {Type: "awsstr", Description: "parameters of the template as Key:Value"},
+			"capabilities":    {Type: "enum", AllowedValues: []string{"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"}, Description: "acknowledge the IAM resources created by the template"},
+			"notifications":   {Type: "awsstr", Description: "ARNs of the SNS topics notified of the stack events"},
+			"role":            {Type: "awsstr", Description: "ARN of the IAM role CloudFormation assumes to manage the stack"},
+			"disablerollback": {Type: "awsbool", Description: "keep the resources of a stack whose creation failed"},
+			"timeout":         {Type: "awsint", Description: "minutes before a stack not yet created fails"},
+		},
+	},
+	"updatestack": {
+		Action:         "update",
+		Entity:         "stack",
+		Api:            "cloudformation",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"templatefile", "parameters", "capabilities", "notifications", "role"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":            {Type: "awsstr", Description: "name or id of the stack"},
+			"templatefile":  {Type: "awsstr", Description: "path to the local JSON or YAML CloudFormation template (default to the current template)"},
+			"parameters":    {Type: "awsstr", Description: "parameters of the template as Key:Value"},
+			"capabilities":  {Type: "enum", AllowedValues: []string{"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"}, Description: "acknowledge the IAM resources created by the template"},
+			"notifications": {Type: "awsstr", Description: "ARNs of the SNS topics notified of the stack events"},
+			"role":          {Type: "awsstr", Description: "ARN of the IAM role CloudFormation assumes to manage the stack"},
+		},
+	},
+	"deletestack": {
+		Action:         "delete",
+		Entity:         "stack",
+		Api:            "cloudformation",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"retain"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":     {Type: "awsstr", Description: "name or id of the stack"},
+			"retain": {Type: "awsstr", Description: "logical ids of the resources to keep (stacks in DELETE_FAILED state only)"},
+		},
+	},
+	"checkstack": {
+		Action:         "check",
+		Entity:         "stack",
+		Api:            "cloudformation",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":      {Type: "awsstr", Description: "name or id of the stack"},
+			"state":   {Type: "enum", AllowedValues: []string{"CREATE_COMPLETE", "CREATE_FAILED", "UPDATE_COMPLETE", "UPDATE_ROLLBACK_COMPLETE", "UPDATE_ROLLBACK_FAILED", "ROLLBACK_COMPLETE", "ROLLBACK_FAILED", "DELETE_COMPLETE", "DELETE_FAILED"}, Description: "expected state, failing when the stack reaches another terminal state"},
+			"timeout": {Type: "awsint", Description: "timeout in seconds"},
+		},
+	},
+	"createservice": {
+		Action:         "create",
+		Entity:         "service",
+		Api:            "ecs",
+		RequiredParams: []string{"cluster", "name", "taskdefinition", "desiredcount"},
+		ExtraParams:    []string{"role", "targetgroup", "containername", "containerport", "deploymentmaxpercent", "deploymentminpercent"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"cluster":              {Type: "awsstr", Description: "name or ARN of the cluster running the service"},
+			"name":                 {Type: "awsstr", Description: "name of the service"},
+			"taskdefinition":       {Type: "awsstr", Description: "family:revision or ARN of the task definition to run"},
+			"desiredcount":         {Type: "awsint", Description: "number of tasks to keep running"},
+			"role":                 {Type: "awsstr", Description: "name or ARN of the IAM role allowing ECS to register the tasks in the target group"},
+			"targetgroup":          {Type: "awsstr", Regex: "^arn:", Description: "ARN of the target group the tasks are registered in"},
+			"containername":        {Type: "awsstr", Description: "name of the container registered in the target group"},
+			"containerport":        {Type: "awsint", Description: "port of the container registered in the target group"},
+			"deploymentmaxpercent": {Type: "awsint", Description: "upper limit of running tasks during a deployment, in percent of the desired count"},
+			"deploymentminpercent": {Type: "awsint", Description: "lower limit of healthy tasks during a deployment, in percent of the desired count"},
+		},
+	},
+	"updateservice": {
+		Action:         "update",
+		Entity:         "service",
+		Api:            "ecs",
+		RequiredParams: []string{"cluster", "id"},
+		ExtraParams:    []string{"desiredcount", "taskdefinition", "deploymentmaxpercent", "deploymentminpercent"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"cluster":              {Type: "awsstr", Description: "name or ARN of the cluster running the service"},
+			"id":                   {Type: "awsstr", Description: "name or ARN of the service"},
+			"desiredcount":         {Type: "awsint", Description: "number of tasks to keep running"},
+			"taskdefinition":       {Type: "awsstr", Description: "family:revision or ARN of the task definition to deploy"},
+			"deploymentmaxpercent": {Type: "awsint", Description: "upper limit of running tasks during a deployment, in percent of the desired count"},
+			"deploymentminpercent": {Type: "awsint", Description: "lower limit of healthy tasks during a deployment, in percent of the desired count"},
+		},
+	},
+	"deleteservice": {
+		Action:         "delete",
+		Entity:         "service",
+		Api:            "ecs",
+		RequiredParams: []string{"cluster", "id"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"cluster": {Type: "awsstr", Description: "name or ARN of the cluster running the service"},
+			"id":      {Type: "awsstr", Description: "name or ARN of the service (scaled down to 0 tasks before deletion)"},
+		},
+	},
+	"starttask": {
+		Action:         "start",
+		Entity:         "task",
+		Api:            "ecs",
+		RequiredParams: []string{"cluster", "taskdefinition"},
+		ExtraParams:    []string{"group", "startedby"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"cluster":        {Type: "awsstr", Description: "name or ARN of the cluster to run the task on"},
+			"taskdefinition": {Type: "awsstr", Description: "family:revision or ARN of the task definition to run"},
+			"group":          {Type: "awsstr", Description: "name of the task group of the task"},
+			"startedby":      {Type: "awsstr", Description: "tag identifying who started the task"},
+		},
+	},
+	"stoptask": {
+		Action:         "stop",
+		Entity:         "task",
+		Api:            "ecs",
+		RequiredParams: []string{"cluster", "id"},
+		ExtraParams:    []string{"reason"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"cluster": {Type: "awsstr", Description: "name or ARN of the cluster running the task"},
+			"id":      {Type: "awsstr", Description: "id or ARN of the task"},
+			"reason":  {Type: "awsstr", Description: "reason shown in the stopped reason of the task"},
+		},
+	},
+	"createtable": {
+		Action:         "create",
+		Entity:         "table",
+		Api:            "dynamodb",
+		RequiredParams: []string{"name", "hashkey", "readcapacity", "writecapacity"},
+		ExtraParams:    []string{"rangekey", "streamview"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name":          {Type: "awsstr", Description: "name of the table"},
+			"hashkey":       {Type: "awsstr", Regex: "^[^:]+:[SNB]$", Description: "partition key of the table, as name:type (type S, N or B; ex: id:S)"},
+			"readcapacity":  {Type: "awsint", Description: "provisioned reads per second"},
+			"writecapacity": {Type: "awsint", Description: "provisioned writes per second"},
+			"rangekey":      {Type: "awsstr", Regex: "^[^:]+:[SNB]$", Description: "sort key of the table, as name:type (type S, N or B; ex: timestamp:N)"},
+			"streamview":    {Type: "enum", AllowedValues: []string{"KEYS_ONLY", "NEW_IMAGE", "OLD_IMAGE", "NEW_AND_OLD_IMAGES"}, Description: "enable the stream of the table, with the given item information"},
+		},
+	},
+	"updatetable": {
+		Action:         "update",
+		Entity:         "table",
+		Api:            "dynamodb",
+		RequiredParams: []string{"id", "readcapacity", "writecapacity"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":            {Type: "awsstr", Description: "name of the table"},
+			"readcapacity":  {Type: "awsint", Description: "provisioned reads per second"},
+			"writecapacity": {Type: "awsint", Description: "provisioned writes per second"},
+		},
+	},
+	"deletetable": {
+		Action:         "delete",
+		Entity:         "table",
+		Api:            "dynamodb",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id": {Type: "awsstr", Description: "name of the table"},
+		},
+	},
+	"checktable": {
+		Action:         "check",
+		Entity:         "table",
+		Api:            "dynamodb",
+		RequiredParams: []string{"id", "state", "timeout"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":      {Type: "awsstr", Description: "name of the table"},
+			"state":   {Type: "enum", AllowedValues: []string{"CREATING", "UPDATING", "DELETING", "ACTIVE", "not-found"}, Description: "expected state of the table (not-found once deleted)"},
+			"timeout": {Type: "awsint", Description: "timeout in seconds"},
+		},
+	},
+	"createkey": {
+		Action:         "create",
+		Entity:         "key",
+		Api:            "kms",
+		RequiredParams: []string{},
+		ExtraParams:    []string{"description"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"description": {Type: "awsstr", Description: "description of the key"},
+		},
+	},
+	"deletekey": {
+		Action:         "delete",
+		Entity:         "key",
+		Api:            "kms",
+		RequiredParams: []string{"id"},
+		ExtraParams:    []string{"pendingdays"},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"id":          {Type: "awsstr", Description: "id or ARN of the key"},
+			"pendingdays": {Type: "awsint", Description: "days before the key is deleted, between 7 and 30 (default: 30)"},
+		},
+	},
+	"createkeyalias": {
+		Action:         "create",
+		Entity:         "keyalias",
+		Api:            "kms",
+		RequiredParams: []string{"name", "key"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Regex: "^alias/[a-zA-Z0-9:/_-]+$", Description: "name of the alias, prefixed with alias/ (ex: alias/my-key)"},
+			"key":  {Type: "awsstr", Description: "id or ARN of the key"},
+		},
+	},
+	"deletekeyalias": {
+		Action:         "delete",
+		Entity:         "keyalias",
+		Api:            "kms",
+		RequiredParams: []string{"name"},
+		ExtraParams:    []string{},
+		TagsMapping:    []string{},
+		Params: map[string]template.ParamDefinition{
+			"name": {Type: "awsstr", Regex: "^alias/", Description: "name of the alias, prefixed with alias/"},
+		},
 	},
 }
 
@@ -443,6 +1603,11 @@ func DriverSupportedActions() map[string][]string {
 	supported["create"] = append(supported["create"], "volume")
 	supported["delete"] = append(supported["delete"], "volume")
 	supported["attach"] = append(supported["attach"], "volume")
+	supported["create"] = append(supported["create"], "snapshot")
+	supported["delete"] = append(supported["delete"], "snapshot")
+	supported["create"] = append(supported["create"], "image")
+	supported["delete"] = append(supported["delete"], "image")
+	supported["copy"] = append(supported["copy"], "image")
 	supported["create"] = append(supported["create"], "internetgateway")
 	supported["delete"] = append(supported["delete"], "internetgateway")
 	supported["attach"] = append(supported["attach"], "internetgateway")
@@ -453,28 +1618,91 @@ func DriverSupportedActions() map[string][]string {
 	supported["detach"] = append(supported["detach"], "routetable")
 	supported["create"] = append(supported["create"], "route")
 	supported["delete"] = append(supported["delete"], "route")
+	supported["create"] = append(supported["create"], "natgateway")
+	supported["delete"] = append(supported["delete"], "natgateway")
+	supported["check"] = append(supported["check"], "natgateway")
+	supported["create"] = append(supported["create"], "elasticip")
+	supported["delete"] = append(supported["delete"], "elasticip")
+	supported["attach"] = append(supported["attach"], "elasticip")
+	supported["detach"] = append(supported["detach"], "elasticip")
 	supported["create"] = append(supported["create"], "tag")
 	supported["create"] = append(supported["create"], "keypair")
 	supported["delete"] = append(supported["delete"], "keypair")
 	supported["create"] = append(supported["create"], "loadbalancer")
 	supported["delete"] = append(supported["delete"], "loadbalancer")
+	supported["attach"] = append(supported["attach"], "classicloadbalancer")
+	supported["detach"] = append(supported["detach"], "classicloadbalancer")
 	supported["create"] = append(supported["create"], "user")
 	supported["delete"] = append(supported["delete"], "user")
 	supported["attach"] = append(supported["attach"], "user")
 	supported["detach"] = append(supported["detach"], "user")
 	supported["create"] = append(supported["create"], "group")
 	supported["delete"] = append(supported["delete"], "group")
+	supported["create"] = append(supported["create"], "role")
+	supported["delete"] = append(supported["delete"], "role")
+	supported["attach"] = append(supported["attach"], "role")
+	supported["detach"] = append(supported["detach"], "role")
+	supported["create"] = append(supported["create"], "instanceprofile")
+	supported["delete"] = append(supported["delete"], "instanceprofile")
+	supported["create"] = append(supported["create"], "accesskey")
+	supported["delete"] = append(supported["delete"], "accesskey")
+	supported["create"] = append(supported["create"], "policy")
+	supported["delete"] = append(supported["delete"], "policy")
 	supported["attach"] = append(supported["attach"], "policy")
 	supported["detach"] = append(supported["detach"], "policy")
 	supported["create"] = append(supported["create"], "bucket")
 	supported["delete"] = append(supported["delete"], "bucket")
+	supported["update"] = append(supported["update"], "bucket")
 	supported["create"] = append(supported["create"], "storageobject")
 	supported["delete"] = append(supported["delete"], "storageobject")
 	supported["create"] = append(supported["create"], "topic")
 	supported["delete"] = append(supported["delete"], "topic")
+	supported["publish"] = append(supported["publish"], "topic")
 	supported["create"] = append(supported["create"], "subscription")
 	supported["delete"] = append(supported["delete"], "subscription")
 	supported["create"] = append(supported["create"], "queue")
 	supported["delete"] = append(supported["delete"], "queue")
+	supported["update"] = append(supported["update"], "queue")
+	supported["send"] = append(supported["send"], "queue")
+	supported["receive"] = append(supported["receive"], "queue")
+	supported["purge"] = append(supported["purge"], "queue")
+	supported["create"] = append(supported["create"], "database")
+	supported["delete"] = append(supported["delete"], "database")
+	supported["start"] = append(supported["start"], "database")
+	supported["stop"] = append(supported["stop"], "database")
+	supported["create"] = append(supported["create"], "function")
+	supported["update"] = append(supported["update"], "function")
+	supported["delete"] = append(supported["delete"], "function")
+	supported["create"] = append(supported["create"], "eventsource")
+	supported["delete"] = append(supported["delete"], "eventsource")
+	supported["create"] = append(supported["create"], "record")
+	supported["update"] = append(supported["update"], "record")
+	supported["delete"] = append(supported["delete"], "record")
+	supported["check"] = append(supported["check"], "record")
+	supported["create"] = append(supported["create"], "launchconfiguration")
+	supported["delete"] = append(supported["delete"], "launchconfiguration")
+	supported["create"] = append(supported["create"], "scalinggroup")
+	supported["update"] = append(supported["update"], "scalinggroup")
+	supported["delete"] = append(supported["delete"], "scalinggroup")
+	supported["check"] = append(supported["check"], "scalinggroup")
+	supported["create"] = append(supported["create"], "alarm")
+	supported["delete"] = append(supported["delete"], "alarm")
+	supported["create"] = append(supported["create"], "stack")
+	supported["update"] = append(supported["update"], "stack")
+	supported["delete"] = append(supported["delete"], "stack")
+	supported["check"] = append(supported["check"], "stack")
+	supported["create"] = append(supported["create"], "service")
+	supported["update"] = append(supported["update"], "service")
+	supported["delete"] = append(supported["delete"], "service")
+	supported["start"] = append(supported["start"], "task")
+	supported["stop"] = append(supported["stop"], "task")
+	supported["create"] = append(supported["create"], "table")
+	supported["update"] = append(supported["update"], "table")
+	supported["delete"] = append(supported["delete"], "table")
+	supported["check"] = append(supported["check"], "table")
+	supported["create"] = append(supported["create"], "key")
+	supported["delete"] = append(supported["delete"], "key")
+	supported["create"] = append(supported["create"], "keyalias")
+	supported["delete"] = append(supported["delete"], "keyalias")
 	return supported
 }
@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
@@ -37,6 +38,7 @@ const (
 	awsint64slice
 	awsstringslice
 	awsstringpointermap
+	awscsvstr
 )
 
 var (
@@ -80,6 +82,13 @@ func setFieldWithType(v, i interface{}, fieldPath string, destType int) error {
 			str := fmt.Sprint(v)
 			v = []*string{&str}
 		}
+	case awscsvstr:
+		switch vv := v.(type) {
+		case []string:
+			v = strings.Join(vv, ",")
+		default:
+			v = fmt.Sprint(v)
+		}
 	case awsint64slice:
 		awsint, err := castInt64(v)
 		if err != nil {
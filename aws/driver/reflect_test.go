@@ -132,6 +132,14 @@ func TestSetFieldWithMultiType(t *testing.T) {
 		t.Fatalf("got %v, want %v", got, want)
 	}
 
+	err = setFieldWithType([]string{"one", "two"}, &any, "Field", awscsvstr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := any.Field, "one,two"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
 	err = setFieldWithType(int64(321), &any, "Int64ArrayField", awsint64slice)
 	if err != nil {
 		t.Fatal(err)
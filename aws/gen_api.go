@@ -27,12 +27,32 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/sns"
@@ -51,9 +71,21 @@ const accessDenied = "Access Denied"
 func init() {
 	ServiceNames = append(ServiceNames, "infra")
 	ServiceNames = append(ServiceNames, "access")
+	cloud.GlobalServices["access"] = true
 	ServiceNames = append(ServiceNames, "storage")
+	cloud.GlobalServices["storage"] = true
 	ServiceNames = append(ServiceNames, "notification")
 	ServiceNames = append(ServiceNames, "queue")
+	ServiceNames = append(ServiceNames, "database")
+	ServiceNames = append(ServiceNames, "lambda")
+	ServiceNames = append(ServiceNames, "dns")
+	cloud.GlobalServices["dns"] = true
+	ServiceNames = append(ServiceNames, "autoscaling")
+	ServiceNames = append(ServiceNames, "monitoring")
+	ServiceNames = append(ServiceNames, "stack")
+	ServiceNames = append(ServiceNames, "container")
+	ServiceNames = append(ServiceNames, "nosql")
+	ServiceNames = append(ServiceNames, "encryption")
 }
 
 var ServiceNames = []string{}
@@ -67,10 +99,15 @@ var ResourceTypes = []string{
 	"volume",
 	"internetgateway",
 	"routetable",
+	"natgateway",
+	"elasticip",
+	"image",
+	"snapshot",
 	"availabilityzone",
 	"loadbalancer",
 	"targetgroup",
 	"listener",
+	"classicloadbalancer",
 	"user",
 	"group",
 	"role",
@@ -80,48 +117,101 @@ var ResourceTypes = []string{
 	"subscription",
 	"topic",
 	"queue",
+	"database",
+	"dbsubnetgroup",
+	"dbparametergroup",
+	"function",
+	"zone",
+	"record",
+	"launchconfiguration",
+	"scalinggroup",
+	"alarm",
+	"stack",
+	"cluster",
+	"service",
+	"taskdefinition",
+	"task",
+	"containerinstance",
+	"table",
+	"key",
+	"keyalias",
 }
 
 var ServicePerAPI = map[string]string{
-	"ec2":   "infra",
-	"elbv2": "infra",
-	"iam":   "access",
-	"s3":    "storage",
-	"sns":   "notification",
-	"sqs":   "queue",
+	"ec2":            "infra",
+	"elbv2":          "infra",
+	"elb":            "infra",
+	"iam":            "access",
+	"s3":             "storage",
+	"sns":            "notification",
+	"sqs":            "queue",
+	"rds":            "database",
+	"lambda":         "lambda",
+	"route53":        "dns",
+	"autoscaling":    "autoscaling",
+	"cloudwatch":     "monitoring",
+	"cloudformation": "stack",
+	"ecs":            "container",
+	"dynamodb":       "nosql",
+	"kms":            "encryption",
 }
 
 var ServicePerResourceType = map[string]string{
-	"instance":         "infra",
-	"subnet":           "infra",
-	"vpc":              "infra",
-	"keypair":          "infra",
-	"securitygroup":    "infra",
-	"volume":           "infra",
-	"internetgateway":  "infra",
-	"routetable":       "infra",
-	"availabilityzone": "infra",
-	"loadbalancer":     "infra",
-	"targetgroup":      "infra",
-	"listener":         "infra",
-	"user":             "access",
-	"group":            "access",
-	"role":             "access",
-	"policy":           "access",
-	"bucket":           "storage",
-	"storageobject":    "storage",
-	"subscription":     "notification",
-	"topic":            "notification",
-	"queue":            "queue",
+	"instance":            "infra",
+	"subnet":              "infra",
+	"vpc":                 "infra",
+	"keypair":             "infra",
+	"securitygroup":       "infra",
+	"volume":              "infra",
+	"internetgateway":     "infra",
+	"routetable":          "infra",
+	"natgateway":          "infra",
+	"elasticip":           "infra",
+	"image":               "infra",
+	"snapshot":            "infra",
+	"availabilityzone":    "infra",
+	"loadbalancer":        "infra",
+	"targetgroup":         "infra",
+	"listener":            "infra",
+	"classicloadbalancer": "infra",
+	"user":                "access",
+	"group":               "access",
+	"role":                "access",
+	"policy":              "access",
+	"bucket":              "storage",
+	"storageobject":       "storage",
+	"subscription":        "notification",
+	"topic":               "notification",
+	"queue":               "queue",
+	"database":            "database",
+	"dbsubnetgroup":       "database",
+	"dbparametergroup":    "database",
+	"function":            "lambda",
+	"zone":                "dns",
+	"record":              "dns",
+	"launchconfiguration": "autoscaling",
+	"scalinggroup":        "autoscaling",
+	"alarm":               "monitoring",
+	"stack":               "stack",
+	"cluster":             "container",
+	"service":             "container",
+	"taskdefinition":      "container",
+	"task":                "container",
+	"containerinstance":   "container",
+	"table":               "nosql",
+	"key":                 "encryption",
+	"keyalias":            "encryption",
 }
 
 type Infra struct {
 	once   oncer
 	region string
+	sess   *session.Session
 	config config
 	log    *logger.Logger
 	ec2iface.EC2API
 	elbv2iface.ELBV2API
+	elbiface.ELBAPI
 }
 
 func NewInfra(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
@@ -129,8 +219,10 @@ func NewInfra(sess *session.Session, awsconf config, log *logger.Logger) cloud.S
 	return &Infra{
 		EC2API:   ec2.New(sess),
 		ELBV2API: elbv2.New(sess),
+		ELBAPI:   elb.New(sess),
 		config:   awsconf,
 		region:   region,
+		sess:     sess,
 		log:      log,
 	}
 }
@@ -139,10 +231,15 @@ func (s *Infra) Name() string {
 	return "infra"
 }
 
+func (s *Infra) Region() string {
+	return s.region
+}
+
 func (s *Infra) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewEc2Driver(s.EC2API),
 		awsdriver.NewElbv2Driver(s.ELBV2API),
+		awsdriver.NewElbDriver(s.ELBAPI),
 	}
 }
 
@@ -155,10 +252,15 @@ func (s *Infra) ResourceTypes() (all []string) {
 	all = append(all, "volume")
 	all = append(all, "internetgateway")
 	all = append(all, "routetable")
+	all = append(all, "natgateway")
+	all = append(all, "elasticip")
+	all = append(all, "image")
+	all = append(all, "snapshot")
 	all = append(all, "availabilityzone")
 	all = append(all, "loadbalancer")
 	all = append(all, "targetgroup")
 	all = append(all, "listener")
+	all = append(all, "classicloadbalancer")
 	return
 }
 
@@ -178,10 +280,15 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 	var volumeList []*ec2.Volume
 	var internetgatewayList []*ec2.InternetGateway
 	var routetableList []*ec2.RouteTable
+	var natgatewayList []*ec2.NatGateway
+	var elasticipList []*ec2.Address
+	var imageList []*ec2.Image
+	var snapshotList []*ec2.Snapshot
 	var availabilityzoneList []*ec2.AvailabilityZone
 	var loadbalancerList []*elbv2.LoadBalancer
 	var targetgroupList []*elbv2.TargetGroup
 	var listenerList []*elbv2.Listener
+	var classicloadbalancerList []*elb.LoadBalancerDescription
 
 	errc := make(chan error)
 	var wg sync.WaitGroup
@@ -314,6 +421,70 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 	} else {
 		s.log.Verbose("sync: *disabled* for resource infra[routetable]")
 	}
+	if s.config.getBool("aws.infra.natgateway.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, natgatewayList, err = s.fetch_all_natgateway_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[natgateway]")
+	}
+	if s.config.getBool("aws.infra.elasticip.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, elasticipList, err = s.fetch_all_elasticip_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[elasticip]")
+	}
+	if s.config.getBool("aws.infra.image.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, imageList, err = s.fetch_all_image_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[image]")
+	}
+	if s.config.getBool("aws.infra.snapshot.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, snapshotList, err = s.fetch_all_snapshot_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[snapshot]")
+	}
 	if s.config.getBool("aws.infra.availabilityzone.sync", true) {
 		wg.Add(1)
 		go func() {
@@ -378,6 +549,22 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 	} else {
 		s.log.Verbose("sync: *disabled* for resource infra[listener]")
 	}
+	if s.config.getBool("aws.infra.classicloadbalancer.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, classicloadbalancerList, err = s.fetch_all_classicloadbalancer_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource infra[classicloadbalancer]")
+	}
 
 	go func() {
 		wg.Wait()
@@ -407,7 +594,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range instanceList {
 				for _, fn := range addParentsFns["instance"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -422,7 +609,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range subnetList {
 				for _, fn := range addParentsFns["subnet"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -437,7 +624,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range vpcList {
 				for _, fn := range addParentsFns["vpc"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -452,7 +639,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range keypairList {
 				for _, fn := range addParentsFns["keypair"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -467,7 +654,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range securitygroupList {
 				for _, fn := range addParentsFns["securitygroup"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -482,7 +669,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range volumeList {
 				for _, fn := range addParentsFns["volume"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -497,7 +684,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range internetgatewayList {
 				for _, fn := range addParentsFns["internetgateway"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -512,7 +699,67 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range routetableList {
 				for _, fn := range addParentsFns["routetable"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.infra.natgateway.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range natgatewayList {
+				for _, fn := range addParentsFns["natgateway"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.infra.elasticip.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range elasticipList {
+				for _, fn := range addParentsFns["elasticip"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.infra.image.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range imageList {
+				for _, fn := range addParentsFns["image"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.infra.snapshot.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range snapshotList {
+				for _, fn := range addParentsFns["snapshot"] {
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -527,7 +774,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range availabilityzoneList {
 				for _, fn := range addParentsFns["availabilityzone"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -542,7 +789,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range loadbalancerList {
 				for _, fn := range addParentsFns["loadbalancer"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -557,7 +804,7 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range targetgroupList {
 				for _, fn := range addParentsFns["targetgroup"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -572,7 +819,22 @@ func (s *Infra) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range listenerList {
 				for _, fn := range addParentsFns["listener"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.infra.classicloadbalancer.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range classicloadbalancerList {
+				for _, fn := range addParentsFns["classicloadbalancer"] {
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -622,6 +884,18 @@ func (s *Infra) FetchByType(t string) (*graph.Graph, error) {
 	case "routetable":
 		graph, _, err := s.fetch_all_routetable_graph()
 		return graph, err
+	case "natgateway":
+		graph, _, err := s.fetch_all_natgateway_graph()
+		return graph, err
+	case "elasticip":
+		graph, _, err := s.fetch_all_elasticip_graph()
+		return graph, err
+	case "image":
+		graph, _, err := s.fetch_all_image_graph()
+		return graph, err
+	case "snapshot":
+		graph, _, err := s.fetch_all_snapshot_graph()
+		return graph, err
 	case "availabilityzone":
 		graph, _, err := s.fetch_all_availabilityzone_graph()
 		return graph, err
@@ -634,6 +908,9 @@ func (s *Infra) FetchByType(t string) (*graph.Graph, error) {
 	case "listener":
 		graph, _, err := s.fetch_all_listener_graph()
 		return graph, err
+	case "classicloadbalancer":
+		graph, _, err := s.fetch_all_classicloadbalancer_graph()
+		return graph, err
 	default:
 		return nil, fmt.Errorf("aws infra: unsupported fetch for type %s", t)
 	}
@@ -643,13 +920,13 @@ func (s *Infra) fetch_all_instance_graph() (*graph.Graph, []*ec2.Instance, error
 	g := graph.NewGraph()
 	var cloudResources []*ec2.Instance
 	var badResErr error
-	err := s.DescribeInstancesPages(&ec2.DescribeInstancesInput{},
+	err := s.EC2API.DescribeInstancesPages(&ec2.DescribeInstancesInput{},
 		func(out *ec2.DescribeInstancesOutput, lastPage bool) (shouldContinue bool) {
 			for _, all := range out.Reservations {
 				for _, output := range all.Instances {
 					cloudResources = append(cloudResources, output)
 					var res *graph.Resource
-					res, badResErr = newResource(output)
+					res, badResErr = newResource(s, output)
 					if badResErr != nil {
 						return false
 					}
@@ -668,14 +945,14 @@ func (s *Infra) fetch_all_instance_graph() (*graph.Graph, []*ec2.Instance, error
 func (s *Infra) fetch_all_subnet_graph() (*graph.Graph, []*ec2.Subnet, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.Subnet
-	out, err := s.DescribeSubnets(&ec2.DescribeSubnetsInput{})
+	out, err := s.EC2API.DescribeSubnets(&ec2.DescribeSubnetsInput{})
 	if err != nil {
 		return nil, cloudResources, err
 	}
 
 	for _, output := range out.Subnets {
 		cloudResources = append(cloudResources, output)
-		res, err := newResource(output)
+		res, err := newResource(s, output)
 		if err != nil {
 			return g, cloudResources, err
 		}
@@ -689,14 +966,14 @@ func (s *Infra) fetch_all_subnet_graph() (*graph.Graph, []*ec2.Subnet, error) {
 func (s *Infra) fetch_all_vpc_graph() (*graph.Graph, []*ec2.Vpc, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.Vpc
-	out, err := s.DescribeVpcs(&ec2.DescribeVpcsInput{})
+	out, err := s.EC2API.DescribeVpcs(&ec2.DescribeVpcsInput{})
 	if err != nil {
 		return nil, cloudResources, err
 	}
 
 	for _, output := range out.Vpcs {
 		cloudResources = append(cloudResources, output)
-		res, err := newResource(output)
+		res, err := newResource(s, output)
 		if err != nil {
 			return g, cloudResources, err
 		}
@@ -710,14 +987,14 @@ func (s *Infra) fetch_all_vpc_graph() (*graph.Graph, []*ec2.Vpc, error) {
 func (s *Infra) fetch_all_keypair_graph() (*graph.Graph, []*ec2.KeyPairInfo, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.KeyPairInfo
-	out, err := s.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
+	out, err := s.EC2API.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
 	if err != nil {
 		return nil, cloudResources, err
 	}
 
 	for _, output := range out.KeyPairs {
 		cloudResources = append(cloudResources, output)
-		res, err := newResource(output)
+		res, err := newResource(s, output)
 		if err != nil {
 			return g, cloudResources, err
 		}
@@ -731,14 +1008,14 @@ func (s *Infra) fetch_all_keypair_graph() (*graph.Graph, []*ec2.KeyPairInfo, err
 func (s *Infra) fetch_all_securitygroup_graph() (*graph.Graph, []*ec2.SecurityGroup, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.SecurityGroup
-	out, err := s.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{})
+	out, err := s.EC2API.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{})
 	if err != nil {
 		return nil, cloudResources, err
 	}
 
 	for _, output := range out.SecurityGroups {
 		cloudResources = append(cloudResources, output)
-		res, err := newResource(output)
+		res, err := newResource(s, output)
 		if err != nil {
 			return g, cloudResources, err
 		}
@@ -753,12 +1030,12 @@ func (s *Infra) fetch_all_volume_graph() (*graph.Graph, []*ec2.Volume, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.Volume
 	var badResErr error
-	err := s.DescribeVolumesPages(&ec2.DescribeVolumesInput{},
+	err := s.EC2API.DescribeVolumesPages(&ec2.DescribeVolumesInput{},
 		func(out *ec2.DescribeVolumesOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.Volumes {
 				cloudResources = append(cloudResources, output)
 				var res *graph.Resource
-				res, badResErr = newResource(output)
+				res, badResErr = newResource(s, output)
 				if badResErr != nil {
 					return false
 				}
@@ -776,14 +1053,14 @@ func (s *Infra) fetch_all_volume_graph() (*graph.Graph, []*ec2.Volume, error) {
 func (s *Infra) fetch_all_internetgateway_graph() (*graph.Graph, []*ec2.InternetGateway, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.InternetGateway
-	out, err := s.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{})
+	out, err := s.EC2API.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{})
 	if err != nil {
 		return nil, cloudResources, err
 	}
 
 	for _, output := range out.InternetGateways {
 		cloudResources = append(cloudResources, output)
-		res, err := newResource(output)
+		res, err := newResource(s, output)
 		if err != nil {
 			return g, cloudResources, err
 		}
@@ -797,14 +1074,77 @@ func (s *Infra) fetch_all_internetgateway_graph() (*graph.Graph, []*ec2.Internet
 func (s *Infra) fetch_all_routetable_graph() (*graph.Graph, []*ec2.RouteTable, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.RouteTable
-	out, err := s.DescribeRouteTables(&ec2.DescribeRouteTablesInput{})
+	out, err := s.EC2API.DescribeRouteTables(&ec2.DescribeRouteTablesInput{})
 	if err != nil {
 		return nil, cloudResources, err
 	}
 
 	for _, output := range out.RouteTables {
 		cloudResources = append(cloudResources, output)
-		res, err := newResource(output)
+		res, err := newResource(s, output)
+		if err != nil {
+			return g, cloudResources, err
+		}
+		g.AddResource(res)
+	}
+
+	return g, cloudResources, nil
+
+}
+
+func (s *Infra) fetch_all_natgateway_graph() (*graph.Graph, []*ec2.NatGateway, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ec2.NatGateway
+	out, err := s.EC2API.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{})
+	if err != nil {
+		return nil, cloudResources, err
+	}
+
+	for _, output := range out.NatGateways {
+		cloudResources = append(cloudResources, output)
+		res, err := newResource(s, output)
+		if err != nil {
+			return g, cloudResources, err
+		}
+		g.AddResource(res)
+	}
+
+	return g, cloudResources, nil
+
+}
+
+func (s *Infra) fetch_all_elasticip_graph() (*graph.Graph, []*ec2.Address, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ec2.Address
+	out, err := s.EC2API.DescribeAddresses(&ec2.DescribeAddressesInput{})
+	if err != nil {
+		return nil, cloudResources, err
+	}
+
+	for _, output := range out.Addresses {
+		cloudResources = append(cloudResources, output)
+		res, err := newResource(s, output)
+		if err != nil {
+			return g, cloudResources, err
+		}
+		g.AddResource(res)
+	}
+
+	return g, cloudResources, nil
+
+}
+
+func (s *Infra) fetch_all_image_graph() (*graph.Graph, []*ec2.Image, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ec2.Image
+	out, err := s.EC2API.DescribeImages(&ec2.DescribeImagesInput{Owners: []*string{awssdk.String("self")}})
+	if err != nil {
+		return nil, cloudResources, err
+	}
+
+	for _, output := range out.Images {
+		cloudResources = append(cloudResources, output)
+		res, err := newResource(s, output)
 		if err != nil {
 			return g, cloudResources, err
 		}
@@ -815,17 +1155,41 @@ func (s *Infra) fetch_all_routetable_graph() (*graph.Graph, []*ec2.RouteTable, e
 
 }
 
+func (s *Infra) fetch_all_snapshot_graph() (*graph.Graph, []*ec2.Snapshot, error) {
+	g := graph.NewGraph()
+	var cloudResources []*ec2.Snapshot
+	var badResErr error
+	err := s.EC2API.DescribeSnapshotsPages(&ec2.DescribeSnapshotsInput{OwnerIds: []*string{awssdk.String("self")}},
+		func(out *ec2.DescribeSnapshotsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.Snapshots {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
 func (s *Infra) fetch_all_availabilityzone_graph() (*graph.Graph, []*ec2.AvailabilityZone, error) {
 	g := graph.NewGraph()
 	var cloudResources []*ec2.AvailabilityZone
-	out, err := s.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{})
+	out, err := s.EC2API.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{})
 	if err != nil {
 		return nil, cloudResources, err
 	}
 
 	for _, output := range out.AvailabilityZones {
 		cloudResources = append(cloudResources, output)
-		res, err := newResource(output)
+		res, err := newResource(s, output)
 		if err != nil {
 			return g, cloudResources, err
 		}
@@ -840,12 +1204,12 @@ func (s *Infra) fetch_all_loadbalancer_graph() (*graph.Graph, []*elbv2.LoadBalan
 	g := graph.NewGraph()
 	var cloudResources []*elbv2.LoadBalancer
 	var badResErr error
-	err := s.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{},
+	err := s.ELBV2API.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{},
 		func(out *elbv2.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.LoadBalancers {
 				cloudResources = append(cloudResources, output)
 				var res *graph.Resource
-				res, badResErr = newResource(output)
+				res, badResErr = newResource(s, output)
 				if badResErr != nil {
 					return false
 				}
@@ -863,14 +1227,14 @@ func (s *Infra) fetch_all_loadbalancer_graph() (*graph.Graph, []*elbv2.LoadBalan
 func (s *Infra) fetch_all_targetgroup_graph() (*graph.Graph, []*elbv2.TargetGroup, error) {
 	g := graph.NewGraph()
 	var cloudResources []*elbv2.TargetGroup
-	out, err := s.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{})
+	out, err := s.ELBV2API.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{})
 	if err != nil {
 		return nil, cloudResources, err
 	}
 
 	for _, output := range out.TargetGroups {
 		cloudResources = append(cloudResources, output)
-		res, err := newResource(output)
+		res, err := newResource(s, output)
 		if err != nil {
 			return g, cloudResources, err
 		}
@@ -881,6 +1245,30 @@ func (s *Infra) fetch_all_targetgroup_graph() (*graph.Graph, []*elbv2.TargetGrou
 
 }
 
+func (s *Infra) fetch_all_classicloadbalancer_graph() (*graph.Graph, []*elb.LoadBalancerDescription, error) {
+	g := graph.NewGraph()
+	var cloudResources []*elb.LoadBalancerDescription
+	var badResErr error
+	err := s.ELBAPI.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{},
+		func(out *elb.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.LoadBalancerDescriptions {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.NextMarker != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
 func (s *Infra) IsSyncDisabled() bool {
 	return !s.config.getBool("aws.infra.sync", true)
 }
@@ -888,6 +1276,7 @@ func (s *Infra) IsSyncDisabled() bool {
 type Access struct {
 	once   oncer
 	region string
+	sess   *session.Session
 	config config
 	log    *logger.Logger
 	iamiface.IAMAPI
@@ -899,6 +1288,7 @@ func NewAccess(sess *session.Session, awsconf config, log *logger.Logger) cloud.
 		IAMAPI: iam.New(sess),
 		config: awsconf,
 		region: region,
+		sess:   sess,
 		log:    log,
 	}
 }
@@ -907,6 +1297,10 @@ func (s *Access) Name() string {
 	return "access"
 }
 
+func (s *Access) Region() string {
+	return s.region
+}
+
 func (s *Access) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewIamDriver(s.IAMAPI),
@@ -1030,7 +1424,7 @@ func (s *Access) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range userList {
 				for _, fn := range addParentsFns["user"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -1045,7 +1439,7 @@ func (s *Access) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range groupList {
 				for _, fn := range addParentsFns["group"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -1060,7 +1454,7 @@ func (s *Access) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range roleList {
 				for _, fn := range addParentsFns["role"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -1075,7 +1469,7 @@ func (s *Access) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range policyList {
 				for _, fn := range addParentsFns["policy"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -1122,12 +1516,12 @@ func (s *Access) fetch_all_group_graph() (*graph.Graph, []*iam.GroupDetail, erro
 	g := graph.NewGraph()
 	var cloudResources []*iam.GroupDetail
 	var badResErr error
-	err := s.GetAccountAuthorizationDetailsPages(&iam.GetAccountAuthorizationDetailsInput{Filter: []*string{awssdk.String(iam.EntityTypeGroup)}},
+	err := s.IAMAPI.GetAccountAuthorizationDetailsPages(&iam.GetAccountAuthorizationDetailsInput{Filter: []*string{awssdk.String(iam.EntityTypeGroup)}},
 		func(out *iam.GetAccountAuthorizationDetailsOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.GroupDetailList {
 				cloudResources = append(cloudResources, output)
 				var res *graph.Resource
-				res, badResErr = newResource(output)
+				res, badResErr = newResource(s, output)
 				if badResErr != nil {
 					return false
 				}
@@ -1146,12 +1540,12 @@ func (s *Access) fetch_all_role_graph() (*graph.Graph, []*iam.RoleDetail, error)
 	g := graph.NewGraph()
 	var cloudResources []*iam.RoleDetail
 	var badResErr error
-	err := s.GetAccountAuthorizationDetailsPages(&iam.GetAccountAuthorizationDetailsInput{Filter: []*string{awssdk.String(iam.EntityTypeRole)}},
+	err := s.IAMAPI.GetAccountAuthorizationDetailsPages(&iam.GetAccountAuthorizationDetailsInput{Filter: []*string{awssdk.String(iam.EntityTypeRole)}},
 		func(out *iam.GetAccountAuthorizationDetailsOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.RoleDetailList {
 				cloudResources = append(cloudResources, output)
 				var res *graph.Resource
-				res, badResErr = newResource(output)
+				res, badResErr = newResource(s, output)
 				if badResErr != nil {
 					return false
 				}
@@ -1170,12 +1564,12 @@ func (s *Access) fetch_all_policy_graph() (*graph.Graph, []*iam.Policy, error) {
 	g := graph.NewGraph()
 	var cloudResources []*iam.Policy
 	var badResErr error
-	err := s.ListPoliciesPages(&iam.ListPoliciesInput{OnlyAttached: awssdk.Bool(true)},
+	err := s.IAMAPI.ListPoliciesPages(&iam.ListPoliciesInput{OnlyAttached: awssdk.Bool(true)},
 		func(out *iam.ListPoliciesOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.Policies {
 				cloudResources = append(cloudResources, output)
 				var res *graph.Resource
-				res, badResErr = newResource(output)
+				res, badResErr = newResource(s, output)
 				if badResErr != nil {
 					return false
 				}
@@ -1197,6 +1591,7 @@ func (s *Access) IsSyncDisabled() bool {
 type Storage struct {
 	once   oncer
 	region string
+	sess   *session.Session
 	config config
 	log    *logger.Logger
 	s3iface.S3API
@@ -1208,6 +1603,7 @@ func NewStorage(sess *session.Session, awsconf config, log *logger.Logger) cloud
 		S3API:  s3.New(sess),
 		config: awsconf,
 		region: region,
+		sess:   sess,
 		log:    log,
 	}
 }
@@ -1216,6 +1612,10 @@ func (s *Storage) Name() string {
 	return "storage"
 }
 
+func (s *Storage) Region() string {
+	return s.region
+}
+
 func (s *Storage) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewS3Driver(s.S3API),
@@ -1303,7 +1703,7 @@ func (s *Storage) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range bucketList {
 				for _, fn := range addParentsFns["bucket"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -1318,7 +1718,7 @@ func (s *Storage) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range storageobjectList {
 				for _, fn := range addParentsFns["storageobject"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -1362,6 +1762,7 @@ func (s *Storage) IsSyncDisabled() bool {
 type Notification struct {
 	once   oncer
 	region string
+	sess   *session.Session
 	config config
 	log    *logger.Logger
 	snsiface.SNSAPI
@@ -1373,6 +1774,7 @@ func NewNotification(sess *session.Session, awsconf config, log *logger.Logger)
 		SNSAPI: sns.New(sess),
 		config: awsconf,
 		region: region,
+		sess:   sess,
 		log:    log,
 	}
 }
@@ -1381,6 +1783,10 @@ func (s *Notification) Name() string {
 	return "notification"
 }
 
+func (s *Notification) Region() string {
+	return s.region
+}
+
 func (s *Notification) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewSnsDriver(s.SNSAPI),
@@ -1468,7 +1874,7 @@ func (s *Notification) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range subscriptionList {
 				for _, fn := range addParentsFns["subscription"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -1483,7 +1889,7 @@ func (s *Notification) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range topicList {
 				for _, fn := range addParentsFns["topic"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -1524,12 +1930,12 @@ func (s *Notification) fetch_all_subscription_graph() (*graph.Graph, []*sns.Subs
 	g := graph.NewGraph()
 	var cloudResources []*sns.Subscription
 	var badResErr error
-	err := s.ListSubscriptionsPages(&sns.ListSubscriptionsInput{},
+	err := s.SNSAPI.ListSubscriptionsPages(&sns.ListSubscriptionsInput{},
 		func(out *sns.ListSubscriptionsOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.Subscriptions {
 				cloudResources = append(cloudResources, output)
 				var res *graph.Resource
-				res, badResErr = newResource(output)
+				res, badResErr = newResource(s, output)
 				if badResErr != nil {
 					return false
 				}
@@ -1548,12 +1954,12 @@ func (s *Notification) fetch_all_topic_graph() (*graph.Graph, []*sns.Topic, erro
 	g := graph.NewGraph()
 	var cloudResources []*sns.Topic
 	var badResErr error
-	err := s.ListTopicsPages(&sns.ListTopicsInput{},
+	err := s.SNSAPI.ListTopicsPages(&sns.ListTopicsInput{},
 		func(out *sns.ListTopicsOutput, lastPage bool) (shouldContinue bool) {
 			for _, output := range out.Topics {
 				cloudResources = append(cloudResources, output)
 				var res *graph.Resource
-				res, badResErr = newResource(output)
+				res, badResErr = newResource(s, output)
 				if badResErr != nil {
 					return false
 				}
@@ -1575,6 +1981,7 @@ func (s *Notification) IsSyncDisabled() bool {
 type Queue struct {
 	once   oncer
 	region string
+	sess   *session.Session
 	config config
 	log    *logger.Logger
 	sqsiface.SQSAPI
@@ -1586,6 +1993,7 @@ func NewQueue(sess *session.Session, awsconf config, log *logger.Logger) cloud.S
 		SQSAPI: sqs.New(sess),
 		config: awsconf,
 		region: region,
+		sess:   sess,
 		log:    log,
 	}
 }
@@ -1594,6 +2002,10 @@ func (s *Queue) Name() string {
 	return "queue"
 }
 
+func (s *Queue) Region() string {
+	return s.region
+}
+
 func (s *Queue) Drivers() []driver.Driver {
 	return []driver.Driver{
 		awsdriver.NewSqsDriver(s.SQSAPI),
@@ -1663,7 +2075,7 @@ func (s *Queue) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range queueList {
 				for _, fn := range addParentsFns["queue"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -1700,3 +2112,1782 @@ func (s *Queue) FetchByType(t string) (*graph.Graph, error) {
 func (s *Queue) IsSyncDisabled() bool {
 	return !s.config.getBool("aws.queue.sync", true)
 }
+
+type Database struct {
+	once   oncer
+	region string
+	sess   *session.Session
+	config config
+	log    *logger.Logger
+	rdsiface.RDSAPI
+}
+
+func NewDatabase(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	return &Database{
+		RDSAPI: rds.New(sess),
+		config: awsconf,
+		region: region,
+		sess:   sess,
+		log:    log,
+	}
+}
+
+func (s *Database) Name() string {
+	return "database"
+}
+
+func (s *Database) Region() string {
+	return s.region
+}
+
+func (s *Database) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewRdsDriver(s.RDSAPI),
+	}
+}
+
+func (s *Database) ResourceTypes() (all []string) {
+	all = append(all, "database")
+	all = append(all, "dbsubnetgroup")
+	all = append(all, "dbparametergroup")
+	return
+}
+
+func (s *Database) FetchResources() (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if s.IsSyncDisabled() {
+		return g, nil
+	}
+
+	regionN := graph.InitResource(s.region, graph.Region)
+	g.AddResource(regionN)
+	var databaseList []*rds.DBInstance
+	var dbsubnetgroupList []*rds.DBSubnetGroup
+	var dbparametergroupList []*rds.DBParameterGroup
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+
+	if s.config.getBool("aws.database.database.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, databaseList, err = s.fetch_all_database_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource database[database]")
+	}
+	if s.config.getBool("aws.database.dbsubnetgroup.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, dbsubnetgroupList, err = s.fetch_all_dbsubnetgroup_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource database[dbsubnetgroup]")
+	}
+	if s.config.getBool("aws.database.dbparametergroup.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, dbparametergroupList, err = s.fetch_all_dbparametergroup_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource database[dbparametergroup]")
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		switch ee := err.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				return g, cloud.ErrFetchAccessDenied
+			default:
+				return g, ee
+			}
+		case nil:
+			continue
+		default:
+			return g, ee
+		}
+	}
+
+	errc = make(chan error)
+	if s.config.getBool("aws.database.database.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range databaseList {
+				for _, fn := range addParentsFns["database"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.database.dbsubnetgroup.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range dbsubnetgroupList {
+				for _, fn := range addParentsFns["dbsubnetgroup"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.database.dbparametergroup.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range dbparametergroupList {
+				for _, fn := range addParentsFns["dbparametergroup"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func (s *Database) FetchByType(t string) (*graph.Graph, error) {
+	switch t {
+	case "database":
+		graph, _, err := s.fetch_all_database_graph()
+		return graph, err
+	case "dbsubnetgroup":
+		graph, _, err := s.fetch_all_dbsubnetgroup_graph()
+		return graph, err
+	case "dbparametergroup":
+		graph, _, err := s.fetch_all_dbparametergroup_graph()
+		return graph, err
+	default:
+		return nil, fmt.Errorf("aws database: unsupported fetch for type %s", t)
+	}
+}
+
+func (s *Database) fetch_all_database_graph() (*graph.Graph, []*rds.DBInstance, error) {
+	g := graph.NewGraph()
+	var cloudResources []*rds.DBInstance
+	var badResErr error
+	err := s.RDSAPI.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{},
+		func(out *rds.DescribeDBInstancesOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.DBInstances {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.Marker != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Database) fetch_all_dbsubnetgroup_graph() (*graph.Graph, []*rds.DBSubnetGroup, error) {
+	g := graph.NewGraph()
+	var cloudResources []*rds.DBSubnetGroup
+	var badResErr error
+	err := s.RDSAPI.DescribeDBSubnetGroupsPages(&rds.DescribeDBSubnetGroupsInput{},
+		func(out *rds.DescribeDBSubnetGroupsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.DBSubnetGroups {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.Marker != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Database) fetch_all_dbparametergroup_graph() (*graph.Graph, []*rds.DBParameterGroup, error) {
+	g := graph.NewGraph()
+	var cloudResources []*rds.DBParameterGroup
+	var badResErr error
+	err := s.RDSAPI.DescribeDBParameterGroupsPages(&rds.DescribeDBParameterGroupsInput{},
+		func(out *rds.DescribeDBParameterGroupsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.DBParameterGroups {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.Marker != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Database) IsSyncDisabled() bool {
+	return !s.config.getBool("aws.database.sync", true)
+}
+
+type Lambda struct {
+	once   oncer
+	region string
+	sess   *session.Session
+	config config
+	log    *logger.Logger
+	lambdaiface.LambdaAPI
+}
+
+func NewLambda(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	return &Lambda{
+		LambdaAPI: lambda.New(sess),
+		config:    awsconf,
+		region:    region,
+		sess:      sess,
+		log:       log,
+	}
+}
+
+func (s *Lambda) Name() string {
+	return "lambda"
+}
+
+func (s *Lambda) Region() string {
+	return s.region
+}
+
+func (s *Lambda) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewLambdaDriver(s.LambdaAPI),
+	}
+}
+
+func (s *Lambda) ResourceTypes() (all []string) {
+	all = append(all, "function")
+	return
+}
+
+func (s *Lambda) FetchResources() (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if s.IsSyncDisabled() {
+		return g, nil
+	}
+
+	regionN := graph.InitResource(s.region, graph.Region)
+	g.AddResource(regionN)
+	var functionList []*lambda.FunctionConfiguration
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+
+	if s.config.getBool("aws.lambda.function.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, functionList, err = s.fetch_all_function_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource lambda[function]")
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		switch ee := err.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				return g, cloud.ErrFetchAccessDenied
+			default:
+				return g, ee
+			}
+		case nil:
+			continue
+		default:
+			return g, ee
+		}
+	}
+
+	errc = make(chan error)
+	if s.config.getBool("aws.lambda.function.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range functionList {
+				for _, fn := range addParentsFns["function"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func (s *Lambda) FetchByType(t string) (*graph.Graph, error) {
+	switch t {
+	case "function":
+		graph, _, err := s.fetch_all_function_graph()
+		return graph, err
+	default:
+		return nil, fmt.Errorf("aws lambda: unsupported fetch for type %s", t)
+	}
+}
+
+func (s *Lambda) fetch_all_function_graph() (*graph.Graph, []*lambda.FunctionConfiguration, error) {
+	g := graph.NewGraph()
+	var cloudResources []*lambda.FunctionConfiguration
+	var badResErr error
+	err := s.LambdaAPI.ListFunctionsPages(&lambda.ListFunctionsInput{},
+		func(out *lambda.ListFunctionsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.Functions {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.NextMarker != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Lambda) IsSyncDisabled() bool {
+	return !s.config.getBool("aws.lambda.sync", true)
+}
+
+type Dns struct {
+	once   oncer
+	region string
+	sess   *session.Session
+	config config
+	log    *logger.Logger
+	route53iface.Route53API
+}
+
+func NewDns(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	return &Dns{
+		Route53API: route53.New(sess),
+		config:     awsconf,
+		region:     region,
+		sess:       sess,
+		log:        log,
+	}
+}
+
+func (s *Dns) Name() string {
+	return "dns"
+}
+
+func (s *Dns) Region() string {
+	return s.region
+}
+
+func (s *Dns) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewRoute53Driver(s.Route53API),
+	}
+}
+
+func (s *Dns) ResourceTypes() (all []string) {
+	all = append(all, "zone")
+	all = append(all, "record")
+	return
+}
+
+func (s *Dns) FetchResources() (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if s.IsSyncDisabled() {
+		return g, nil
+	}
+
+	regionN := graph.InitResource(s.region, graph.Region)
+	g.AddResource(regionN)
+	var zoneList []*route53.HostedZone
+	var recordList []*route53.ResourceRecordSet
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+
+	if s.config.getBool("aws.dns.zone.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, zoneList, err = s.fetch_all_zone_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource dns[zone]")
+	}
+	if s.config.getBool("aws.dns.record.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, recordList, err = s.fetch_all_record_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource dns[record]")
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		switch ee := err.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				return g, cloud.ErrFetchAccessDenied
+			default:
+				return g, ee
+			}
+		case nil:
+			continue
+		default:
+			return g, ee
+		}
+	}
+
+	errc = make(chan error)
+	if s.config.getBool("aws.dns.zone.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range zoneList {
+				for _, fn := range addParentsFns["zone"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.dns.record.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range recordList {
+				for _, fn := range addParentsFns["record"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func (s *Dns) FetchByType(t string) (*graph.Graph, error) {
+	switch t {
+	case "zone":
+		graph, _, err := s.fetch_all_zone_graph()
+		return graph, err
+	case "record":
+		graph, _, err := s.fetch_all_record_graph()
+		return graph, err
+	default:
+		return nil, fmt.Errorf("aws dns: unsupported fetch for type %s", t)
+	}
+}
+
+func (s *Dns) fetch_all_zone_graph() (*graph.Graph, []*route53.HostedZone, error) {
+	g := graph.NewGraph()
+	var cloudResources []*route53.HostedZone
+	var badResErr error
+	err := s.Route53API.ListHostedZonesPages(&route53.ListHostedZonesInput{},
+		func(out *route53.ListHostedZonesOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.HostedZones {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.NextMarker != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Dns) IsSyncDisabled() bool {
+	return !s.config.getBool("aws.dns.sync", true)
+}
+
+type Autoscaling struct {
+	once   oncer
+	region string
+	sess   *session.Session
+	config config
+	log    *logger.Logger
+	autoscalingiface.AutoScalingAPI
+}
+
+func NewAutoscaling(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	return &Autoscaling{
+		AutoScalingAPI: autoscaling.New(sess),
+		config:         awsconf,
+		region:         region,
+		sess:           sess,
+		log:            log,
+	}
+}
+
+func (s *Autoscaling) Name() string {
+	return "autoscaling"
+}
+
+func (s *Autoscaling) Region() string {
+	return s.region
+}
+
+func (s *Autoscaling) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewAutoscalingDriver(s.AutoScalingAPI),
+	}
+}
+
+func (s *Autoscaling) ResourceTypes() (all []string) {
+	all = append(all, "launchconfiguration")
+	all = append(all, "scalinggroup")
+	return
+}
+
+func (s *Autoscaling) FetchResources() (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if s.IsSyncDisabled() {
+		return g, nil
+	}
+
+	regionN := graph.InitResource(s.region, graph.Region)
+	g.AddResource(regionN)
+	var launchconfigurationList []*autoscaling.LaunchConfiguration
+	var scalinggroupList []*autoscaling.Group
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+
+	if s.config.getBool("aws.autoscaling.launchconfiguration.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, launchconfigurationList, err = s.fetch_all_launchconfiguration_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource autoscaling[launchconfiguration]")
+	}
+	if s.config.getBool("aws.autoscaling.scalinggroup.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, scalinggroupList, err = s.fetch_all_scalinggroup_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource autoscaling[scalinggroup]")
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		switch ee := err.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				return g, cloud.ErrFetchAccessDenied
+			default:
+				return g, ee
+			}
+		case nil:
+			continue
+		default:
+			return g, ee
+		}
+	}
+
+	errc = make(chan error)
+	if s.config.getBool("aws.autoscaling.launchconfiguration.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range launchconfigurationList {
+				for _, fn := range addParentsFns["launchconfiguration"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.autoscaling.scalinggroup.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range scalinggroupList {
+				for _, fn := range addParentsFns["scalinggroup"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func (s *Autoscaling) FetchByType(t string) (*graph.Graph, error) {
+	switch t {
+	case "launchconfiguration":
+		graph, _, err := s.fetch_all_launchconfiguration_graph()
+		return graph, err
+	case "scalinggroup":
+		graph, _, err := s.fetch_all_scalinggroup_graph()
+		return graph, err
+	default:
+		return nil, fmt.Errorf("aws autoscaling: unsupported fetch for type %s", t)
+	}
+}
+
+func (s *Autoscaling) fetch_all_launchconfiguration_graph() (*graph.Graph, []*autoscaling.LaunchConfiguration, error) {
+	g := graph.NewGraph()
+	var cloudResources []*autoscaling.LaunchConfiguration
+	var badResErr error
+	err := s.AutoScalingAPI.DescribeLaunchConfigurationsPages(&autoscaling.DescribeLaunchConfigurationsInput{},
+		func(out *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.LaunchConfigurations {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Autoscaling) fetch_all_scalinggroup_graph() (*graph.Graph, []*autoscaling.Group, error) {
+	g := graph.NewGraph()
+	var cloudResources []*autoscaling.Group
+	var badResErr error
+	err := s.AutoScalingAPI.DescribeAutoScalingGroupsPages(&autoscaling.DescribeAutoScalingGroupsInput{},
+		func(out *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.AutoScalingGroups {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Autoscaling) IsSyncDisabled() bool {
+	return !s.config.getBool("aws.autoscaling.sync", true)
+}
+
+type Monitoring struct {
+	once   oncer
+	region string
+	sess   *session.Session
+	config config
+	log    *logger.Logger
+	cloudwatchiface.CloudWatchAPI
+}
+
+func NewMonitoring(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	return &Monitoring{
+		CloudWatchAPI: cloudwatch.New(sess),
+		config:        awsconf,
+		region:        region,
+		sess:          sess,
+		log:           log,
+	}
+}
+
+func (s *Monitoring) Name() string {
+	return "monitoring"
+}
+
+func (s *Monitoring) Region() string {
+	return s.region
+}
+
+func (s *Monitoring) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewCloudwatchDriver(s.CloudWatchAPI),
+	}
+}
+
+func (s *Monitoring) ResourceTypes() (all []string) {
+	all = append(all, "alarm")
+	return
+}
+
+func (s *Monitoring) FetchResources() (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if s.IsSyncDisabled() {
+		return g, nil
+	}
+
+	regionN := graph.InitResource(s.region, graph.Region)
+	g.AddResource(regionN)
+	var alarmList []*cloudwatch.MetricAlarm
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+
+	if s.config.getBool("aws.monitoring.alarm.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, alarmList, err = s.fetch_all_alarm_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource monitoring[alarm]")
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		switch ee := err.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				return g, cloud.ErrFetchAccessDenied
+			default:
+				return g, ee
+			}
+		case nil:
+			continue
+		default:
+			return g, ee
+		}
+	}
+
+	errc = make(chan error)
+	if s.config.getBool("aws.monitoring.alarm.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range alarmList {
+				for _, fn := range addParentsFns["alarm"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func (s *Monitoring) FetchByType(t string) (*graph.Graph, error) {
+	switch t {
+	case "alarm":
+		graph, _, err := s.fetch_all_alarm_graph()
+		return graph, err
+	default:
+		return nil, fmt.Errorf("aws monitoring: unsupported fetch for type %s", t)
+	}
+}
+
+func (s *Monitoring) fetch_all_alarm_graph() (*graph.Graph, []*cloudwatch.MetricAlarm, error) {
+	g := graph.NewGraph()
+	var cloudResources []*cloudwatch.MetricAlarm
+	var badResErr error
+	err := s.CloudWatchAPI.DescribeAlarmsPages(&cloudwatch.DescribeAlarmsInput{},
+		func(out *cloudwatch.DescribeAlarmsOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.MetricAlarms {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Monitoring) IsSyncDisabled() bool {
+	return !s.config.getBool("aws.monitoring.sync", true)
+}
+
+type Stack struct {
+	once   oncer
+	region string
+	sess   *session.Session
+	config config
+	log    *logger.Logger
+	cloudformationiface.CloudFormationAPI
+}
+
+func NewStack(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	return &Stack{
+		CloudFormationAPI: cloudformation.New(sess),
+		config:            awsconf,
+		region:            region,
+		sess:              sess,
+		log:               log,
+	}
+}
+
+func (s *Stack) Name() string {
+	return "stack"
+}
+
+func (s *Stack) Region() string {
+	return s.region
+}
+
+func (s *Stack) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewCloudformationDriver(s.CloudFormationAPI),
+	}
+}
+
+func (s *Stack) ResourceTypes() (all []string) {
+	all = append(all, "stack")
+	return
+}
+
+func (s *Stack) FetchResources() (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if s.IsSyncDisabled() {
+		return g, nil
+	}
+
+	regionN := graph.InitResource(s.region, graph.Region)
+	g.AddResource(regionN)
+	var stackList []*cloudformation.Stack
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+
+	if s.config.getBool("aws.stack.stack.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, stackList, err = s.fetch_all_stack_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource stack[stack]")
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		switch ee := err.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				return g, cloud.ErrFetchAccessDenied
+			default:
+				return g, ee
+			}
+		case nil:
+			continue
+		default:
+			return g, ee
+		}
+	}
+
+	errc = make(chan error)
+	if s.config.getBool("aws.stack.stack.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range stackList {
+				for _, fn := range addParentsFns["stack"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func (s *Stack) FetchByType(t string) (*graph.Graph, error) {
+	switch t {
+	case "stack":
+		graph, _, err := s.fetch_all_stack_graph()
+		return graph, err
+	default:
+		return nil, fmt.Errorf("aws stack: unsupported fetch for type %s", t)
+	}
+}
+
+func (s *Stack) fetch_all_stack_graph() (*graph.Graph, []*cloudformation.Stack, error) {
+	g := graph.NewGraph()
+	var cloudResources []*cloudformation.Stack
+	var badResErr error
+	err := s.CloudFormationAPI.DescribeStacksPages(&cloudformation.DescribeStacksInput{},
+		func(out *cloudformation.DescribeStacksOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.Stacks {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.NextToken != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Stack) IsSyncDisabled() bool {
+	return !s.config.getBool("aws.stack.sync", true)
+}
+
+type Container struct {
+	once   oncer
+	region string
+	sess   *session.Session
+	config config
+	log    *logger.Logger
+	ecsiface.ECSAPI
+}
+
+func NewContainer(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	return &Container{
+		ECSAPI: ecs.New(sess),
+		config: awsconf,
+		region: region,
+		sess:   sess,
+		log:    log,
+	}
+}
+
+func (s *Container) Name() string {
+	return "container"
+}
+
+func (s *Container) Region() string {
+	return s.region
+}
+
+func (s *Container) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewEcsDriver(s.ECSAPI),
+	}
+}
+
+func (s *Container) ResourceTypes() (all []string) {
+	all = append(all, "cluster")
+	all = append(all, "service")
+	all = append(all, "taskdefinition")
+	all = append(all, "task")
+	all = append(all, "containerinstance")
+	return
+}
+
+func (s *Container) FetchResources() (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if s.IsSyncDisabled() {
+		return g, nil
+	}
+
+	regionN := graph.InitResource(s.region, graph.Region)
+	g.AddResource(regionN)
+	var clusterList []*ecs.Cluster
+	var serviceList []*ecs.Service
+	var taskdefinitionList []*ecs.TaskDefinition
+	var taskList []*ecs.Task
+	var containerinstanceList []*ecs.ContainerInstance
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+
+	if s.config.getBool("aws.container.cluster.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, clusterList, err = s.fetch_all_cluster_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource container[cluster]")
+	}
+	if s.config.getBool("aws.container.service.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, serviceList, err = s.fetch_all_service_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource container[service]")
+	}
+	if s.config.getBool("aws.container.taskdefinition.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, taskdefinitionList, err = s.fetch_all_taskdefinition_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource container[taskdefinition]")
+	}
+	if s.config.getBool("aws.container.task.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, taskList, err = s.fetch_all_task_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource container[task]")
+	}
+	if s.config.getBool("aws.container.containerinstance.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, containerinstanceList, err = s.fetch_all_containerinstance_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource container[containerinstance]")
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		switch ee := err.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				return g, cloud.ErrFetchAccessDenied
+			default:
+				return g, ee
+			}
+		case nil:
+			continue
+		default:
+			return g, ee
+		}
+	}
+
+	errc = make(chan error)
+	if s.config.getBool("aws.container.cluster.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range clusterList {
+				for _, fn := range addParentsFns["cluster"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.container.service.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range serviceList {
+				for _, fn := range addParentsFns["service"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.container.taskdefinition.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range taskdefinitionList {
+				for _, fn := range addParentsFns["taskdefinition"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.container.task.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range taskList {
+				for _, fn := range addParentsFns["task"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.container.containerinstance.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range containerinstanceList {
+				for _, fn := range addParentsFns["containerinstance"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func (s *Container) FetchByType(t string) (*graph.Graph, error) {
+	switch t {
+	case "cluster":
+		graph, _, err := s.fetch_all_cluster_graph()
+		return graph, err
+	case "service":
+		graph, _, err := s.fetch_all_service_graph()
+		return graph, err
+	case "taskdefinition":
+		graph, _, err := s.fetch_all_taskdefinition_graph()
+		return graph, err
+	case "task":
+		graph, _, err := s.fetch_all_task_graph()
+		return graph, err
+	case "containerinstance":
+		graph, _, err := s.fetch_all_containerinstance_graph()
+		return graph, err
+	default:
+		return nil, fmt.Errorf("aws container: unsupported fetch for type %s", t)
+	}
+}
+
+func (s *Container) IsSyncDisabled() bool {
+	return !s.config.getBool("aws.container.sync", true)
+}
+
+type Nosql struct {
+	once   oncer
+	region string
+	sess   *session.Session
+	config config
+	log    *logger.Logger
+	dynamodbiface.DynamoDBAPI
+}
+
+func NewNosql(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	return &Nosql{
+		DynamoDBAPI: dynamodb.New(sess),
+		config:      awsconf,
+		region:      region,
+		sess:        sess,
+		log:         log,
+	}
+}
+
+func (s *Nosql) Name() string {
+	return "nosql"
+}
+
+func (s *Nosql) Region() string {
+	return s.region
+}
+
+func (s *Nosql) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewDynamodbDriver(s.DynamoDBAPI),
+	}
+}
+
+func (s *Nosql) ResourceTypes() (all []string) {
+	all = append(all, "table")
+	return
+}
+
+func (s *Nosql) FetchResources() (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if s.IsSyncDisabled() {
+		return g, nil
+	}
+
+	regionN := graph.InitResource(s.region, graph.Region)
+	g.AddResource(regionN)
+	var tableList []*dynamodb.TableDescription
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+
+	if s.config.getBool("aws.nosql.table.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, tableList, err = s.fetch_all_table_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource nosql[table]")
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		switch ee := err.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				return g, cloud.ErrFetchAccessDenied
+			default:
+				return g, ee
+			}
+		case nil:
+			continue
+		default:
+			return g, ee
+		}
+	}
+
+	errc = make(chan error)
+	if s.config.getBool("aws.nosql.table.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range tableList {
+				for _, fn := range addParentsFns["table"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func (s *Nosql) FetchByType(t string) (*graph.Graph, error) {
+	switch t {
+	case "table":
+		graph, _, err := s.fetch_all_table_graph()
+		return graph, err
+	default:
+		return nil, fmt.Errorf("aws nosql: unsupported fetch for type %s", t)
+	}
+}
+
+func (s *Nosql) IsSyncDisabled() bool {
+	return !s.config.getBool("aws.nosql.sync", true)
+}
+
+type Encryption struct {
+	once   oncer
+	region string
+	sess   *session.Session
+	config config
+	log    *logger.Logger
+	kmsiface.KMSAPI
+}
+
+func NewEncryption(sess *session.Session, awsconf config, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	return &Encryption{
+		KMSAPI: kms.New(sess),
+		config: awsconf,
+		region: region,
+		sess:   sess,
+		log:    log,
+	}
+}
+
+func (s *Encryption) Name() string {
+	return "encryption"
+}
+
+func (s *Encryption) Region() string {
+	return s.region
+}
+
+func (s *Encryption) Drivers() []driver.Driver {
+	return []driver.Driver{
+		awsdriver.NewKmsDriver(s.KMSAPI),
+	}
+}
+
+func (s *Encryption) ResourceTypes() (all []string) {
+	all = append(all, "key")
+	all = append(all, "keyalias")
+	return
+}
+
+func (s *Encryption) FetchResources() (*graph.Graph, error) {
+	g := graph.NewGraph()
+	if s.IsSyncDisabled() {
+		return g, nil
+	}
+
+	regionN := graph.InitResource(s.region, graph.Region)
+	g.AddResource(regionN)
+	var keyList []*kms.KeyMetadata
+	var keyaliasList []*kms.AliasListEntry
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+
+	if s.config.getBool("aws.encryption.key.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, keyList, err = s.fetch_all_key_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource encryption[key]")
+	}
+	if s.config.getBool("aws.encryption.keyalias.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resGraph *graph.Graph
+			var err error
+			resGraph, keyaliasList, err = s.fetch_all_keyalias_graph()
+			if err != nil {
+				errc <- err
+				return
+			}
+			g.AddGraph(resGraph)
+		}()
+	} else {
+		s.log.Verbose("sync: *disabled* for resource encryption[keyalias]")
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		switch ee := err.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				return g, cloud.ErrFetchAccessDenied
+			default:
+				return g, ee
+			}
+		case nil:
+			continue
+		default:
+			return g, ee
+		}
+	}
+
+	errc = make(chan error)
+	if s.config.getBool("aws.encryption.key.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range keyList {
+				for _, fn := range addParentsFns["key"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	if s.config.getBool("aws.encryption.keyalias.sync", true) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, r := range keyaliasList {
+				for _, fn := range addParentsFns["keyalias"] {
+					err := fn(g, s, r)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+func (s *Encryption) FetchByType(t string) (*graph.Graph, error) {
+	switch t {
+	case "key":
+		graph, _, err := s.fetch_all_key_graph()
+		return graph, err
+	case "keyalias":
+		graph, _, err := s.fetch_all_keyalias_graph()
+		return graph, err
+	default:
+		return nil, fmt.Errorf("aws encryption: unsupported fetch for type %s", t)
+	}
+}
+
+func (s *Encryption) fetch_all_keyalias_graph() (*graph.Graph, []*kms.AliasListEntry, error) {
+	g := graph.NewGraph()
+	var cloudResources []*kms.AliasListEntry
+	var badResErr error
+	err := s.KMSAPI.ListAliasesPages(&kms.ListAliasesInput{},
+		func(out *kms.ListAliasesOutput, lastPage bool) (shouldContinue bool) {
+			for _, output := range out.Aliases {
+				cloudResources = append(cloudResources, output)
+				var res *graph.Resource
+				res, badResErr = newResource(s, output)
+				if badResErr != nil {
+					return false
+				}
+				g.AddResource(res)
+			}
+			return out.NextMarker != nil
+		})
+	if err != nil {
+		return g, cloudResources, err
+	}
+
+	return g, cloudResources, badResErr
+}
+
+func (s *Encryption) IsSyncDisabled() bool {
+	return !s.config.getBool("aws.encryption.sync", true)
+}
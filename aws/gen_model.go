@@ -0,0 +1,526 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// DO NOT EDIT
+// This file was automatically generated with go generate
+package aws
+
+import "github.com/wallix/awless/graph"
+
+var awsResourcesDef = map[graph.ResourceType]map[string]*propertyTransform{
+	// infra
+	"instance": {
+		"Id":             {name: "InstanceId", transform: extractValueFn},
+		"SubnetId":       {name: "SubnetId", transform: extractValueFn},
+		"Name":           {name: "Tags", transform: extractTagFn("Name")},
+		"State":          {name: "State", transform: extractFieldFn("Name")},
+		"Type":           {name: "InstanceType", transform: extractValueFn},
+		"KeyName":        {name: "KeyName", transform: extractValueFn},
+		"PublicIp":       {name: "PublicIpAddress", transform: extractValueFn},
+		"LaunchTime":     {name: "LaunchTime", transform: extractValueFn},
+		"VpcId":          {name: "VpcId", transform: extractValueFn},
+		"PrivateIp":      {name: "PrivateIpAddress", transform: extractValueFn},
+		"ImageId":        {name: "ImageId", transform: extractValueFn},
+		"SecurityGroups": {name: "SecurityGroups", transform: extractSliceValues("GroupId")},
+	},
+	"subnet": {
+		"Id":                  {name: "SubnetId", transform: extractValueFn},
+		"Name":                {name: "Tags", transform: extractTagFn("Name")},
+		"CidrBlock":           {name: "CidrBlock", transform: extractValueFn},
+		"AvailabilityZone":    {name: "AvailabilityZone", transform: extractValueFn},
+		"VpcId":               {name: "VpcId", transform: extractValueFn},
+		"MapPublicIpOnLaunch": {name: "MapPublicIpOnLaunch", transform: extractValueFn},
+		"State":               {name: "State", transform: extractValueFn},
+		"DefaultForAz":        {name: "DefaultForAz", transform: extractValueFn},
+	},
+	"vpc": {
+		"Id":        {name: "VpcId", transform: extractValueFn},
+		"Name":      {name: "Tags", transform: extractTagFn("Name")},
+		"IsDefault": {name: "IsDefault", transform: extractValueFn},
+		"State":     {name: "State", transform: extractValueFn},
+		"CidrBlock": {name: "CidrBlock", transform: extractValueFn},
+	},
+	"keypair": {
+		"Id":             {name: "KeyName", transform: extractValueFn},
+		"KeyFingerprint": {name: "KeyFingerprint", transform: extractValueFn},
+		"Name":           {name: "KeyName", transform: extractValueFn},
+	},
+	"securitygroup": {
+		"Id":            {name: "GroupId", transform: extractValueFn},
+		"VpcId":         {name: "VpcId", transform: extractValueFn},
+		"InboundRules":  {name: "IpPermissions", transform: extractIpPermissionSliceFn},
+		"OutboundRules": {name: "IpPermissionsEgress", transform: extractIpPermissionSliceFn},
+		"Name":          {name: "GroupName", transform: extractValueFn},
+		"Description":   {name: "Description", transform: extractValueFn},
+		"OwnerId":       {name: "OwnerId", transform: extractValueFn},
+	},
+	"volume": {
+		"Id":               {name: "VolumeId", transform: extractValueFn},
+		"Name":             {name: "Tags", transform: extractTagFn("Name")},
+		"VolumeType":       {name: "VolumeType", transform: extractValueFn},
+		"State":            {name: "State", transform: extractValueFn},
+		"Size":             {name: "Size", transform: extractValueFn},
+		"Encrypted":        {name: "Encrypted", transform: extractValueFn},
+		"CreateTime":       {name: "CreateTime", transform: extractTimeFn},
+		"AvailabilityZone": {name: "AvailabilityZone", transform: extractValueFn},
+		"Key":              {name: "KmsKeyId", transform: extractValueFn},
+	},
+	"internetgateway": {
+		"Id":   {name: "InternetGatewayId", transform: extractValueFn},
+		"Name": {name: "Tags", transform: extractTagFn("Name")},
+		"Vpcs": {name: "Attachments", transform: extractSliceValues("VpcId")},
+	},
+	"routetable": {
+		"Id":     {name: "RouteTableId", transform: extractValueFn},
+		"Name":   {name: "Tags", transform: extractTagFn("Name")},
+		"VpcId":  {name: "VpcId", transform: extractValueFn},
+		"Main":   {name: "Associations", transform: extractHasATrueBoolInStructSliceFn("Main")},
+		"Routes": {name: "Routes", transform: extractRoutesSliceFn},
+	},
+	"natgateway": {
+		"Id":          {name: "NatGatewayId", transform: extractValueFn},
+		"State":       {name: "State", transform: extractValueFn},
+		"VpcId":       {name: "VpcId", transform: extractValueFn},
+		"SubnetId":    {name: "SubnetId", transform: extractValueFn},
+		"PublicIPs":   {name: "NatGatewayAddresses", transform: extractSliceValues("PublicIp")},
+		"PrivateIPs":  {name: "NatGatewayAddresses", transform: extractSliceValues("PrivateIp")},
+		"CreateTime":  {name: "CreateTime", transform: extractTimeFn},
+		"ElasticIPs":  {name: "NatGatewayAddresses", transform: extractSliceValues("AllocationId")},
+		"FailureCode": {name: "FailureCode", transform: extractValueFn},
+	},
+	"elasticip": {
+		"Id":                 {name: "AllocationId", transform: extractValueFn},
+		"PublicIp":           {name: "PublicIp", transform: extractValueFn},
+		"PrivateIp":          {name: "PrivateIpAddress", transform: extractValueFn},
+		"Domain":             {name: "Domain", transform: extractValueFn},
+		"InstanceId":         {name: "InstanceId", transform: extractValueFn},
+		"AssociationId":      {name: "AssociationId", transform: extractValueFn},
+		"NetworkInterfaceId": {name: "NetworkInterfaceId", transform: extractValueFn},
+	},
+	"image": {
+		"Id":             {name: "ImageId", transform: extractValueFn},
+		"Name":           {name: "Name", transform: extractValueFn},
+		"State":          {name: "State", transform: extractValueFn},
+		"Architecture":   {name: "Architecture", transform: extractValueFn},
+		"Type":           {name: "VirtualizationType", transform: extractValueFn},
+		"RootDeviceType": {name: "RootDeviceType", transform: extractValueFn},
+		"Public":         {name: "Public", transform: extractValueFn},
+		"CreateTime":     {name: "CreationDate", transform: extractStringTimeFn(imageTimeLayout)},
+		"Description":    {name: "Description", transform: extractValueFn},
+		"Hypervisor":     {name: "Hypervisor", transform: extractValueFn},
+		"Location":       {name: "ImageLocation", transform: extractValueFn},
+		"RootDevice":     {name: "RootDeviceName", transform: extractValueFn},
+		"Snapshots":      {name: "BlockDeviceMappings", transform: extractImageSnapshotsFn},
+	},
+	"snapshot": {
+		"Id":          {name: "SnapshotId", transform: extractValueFn},
+		"Name":        {name: "Tags", transform: extractTagFn("Name")},
+		"VolumeId":    {name: "VolumeId", transform: extractValueFn},
+		"State":       {name: "State", transform: extractValueFn},
+		"Progress":    {name: "Progress", transform: extractValueFn},
+		"Size":        {name: "VolumeSize", transform: extractValueFn},
+		"Encrypted":   {name: "Encrypted", transform: extractValueFn},
+		"CreateTime":  {name: "StartTime", transform: extractTimeFn},
+		"Description": {name: "Description", transform: extractValueFn},
+		"Key":         {name: "KmsKeyId", transform: extractValueFn},
+	},
+	"availabilityzone": {
+		"Name":     {name: "ZoneName", transform: extractValueFn},
+		"State":    {name: "State", transform: extractValueFn},
+		"Region":   {name: "RegionName", transform: extractValueFn},
+		"Messages": {name: "Messages", transform: extractSliceValues("Message")},
+		"Id":       {name: "ZoneName", transform: extractValueFn},
+	},
+	"loadbalancer": {
+		"Name":                  {name: "LoadBalancerName", transform: extractValueFn},
+		"VpcId":                 {name: "VpcId", transform: extractValueFn},
+		"State":                 {name: "State", transform: extractFieldFn("Code")},
+		"DNSName":               {name: "DNSName", transform: extractValueFn},
+		"CreateTime":            {name: "CreatedTime", transform: extractTimeFn},
+		"Scheme":                {name: "Scheme", transform: extractValueFn},
+		"Id":                    {name: "LoadBalancerArn", transform: extractValueFn},
+		"AvailabilityZones":     {name: "AvailabilityZones", transform: extractSliceValues("ZoneName")},
+		"Subnets":               {name: "AvailabilityZones", transform: extractSliceValues("SubnetId")},
+		"CanonicalHostedZoneId": {name: "CanonicalHostedZoneId", transform: extractValueFn},
+		"IpAddressType":         {name: "IpAddressType", transform: extractValueFn},
+		"Type":                  {name: "Type", transform: extractValueFn},
+	},
+	"targetgroup": {
+		"Name":                       {name: "TargetGroupName", transform: extractValueFn},
+		"VpcId":                      {name: "VpcId", transform: extractValueFn},
+		"Matcher":                    {name: "Matcher", transform: extractFieldFn("HttpCode")},
+		"Port":                       {name: "Port", transform: extractValueFn},
+		"Protocol":                   {name: "Protocol", transform: extractValueFn},
+		"HealthCheckIntervalSeconds": {name: "HealthCheckIntervalSeconds", transform: extractValueFn},
+		"HealthCheckPath":            {name: "HealthCheckPath", transform: extractValueFn},
+		"HealthCheckPort":            {name: "HealthCheckPort", transform: extractValueFn},
+		"HealthCheckProtocol":        {name: "HealthCheckProtocol", transform: extractValueFn},
+		"Id":                         {name: "TargetGroupArn", transform: extractValueFn},
+		"HealthCheckTimeoutSeconds":  {name: "HealthCheckTimeoutSeconds", transform: extractValueFn},
+		"HealthyThresholdCount":      {name: "HealthyThresholdCount", transform: extractValueFn},
+		"UnhealthyThresholdCount":    {name: "UnhealthyThresholdCount", transform: extractValueFn},
+	},
+	"listener": {
+		"Id":           {name: "ListenerArn", transform: extractValueFn},
+		"Actions":      {name: "DefaultActions", transform: extractSliceValues("Type")},
+		"LoadBalancer": {name: "LoadBalancerArn", transform: extractValueFn},
+		"Port":         {name: "Port", transform: extractValueFn},
+		"Protocol":     {name: "Protocol", transform: extractValueFn},
+		"SslPolicy":    {name: "SslPolicy", transform: extractValueFn},
+		"Certificates": {name: "Certificates", transform: extractSliceValues("CertificateArn")},
+	},
+	"classicloadbalancer": {
+		"Name":                  {name: "LoadBalancerName", transform: extractValueFn},
+		"VpcId":                 {name: "VPCId", transform: extractValueFn},
+		"DNSName":               {name: "DNSName", transform: extractValueFn},
+		"Listeners":             {name: "ListenerDescriptions", transform: extractClassicListenersFn},
+		"Instances":             {name: "Instances", transform: extractSliceValues("InstanceId")},
+		"CreateTime":            {name: "CreatedTime", transform: extractTimeFn},
+		"Scheme":                {name: "Scheme", transform: extractValueFn},
+		"Id":                    {name: "LoadBalancerName", transform: extractValueFn},
+		"AvailabilityZones":     {name: "AvailabilityZones", transform: extractStringSliceFn},
+		"Subnets":               {name: "Subnets", transform: extractStringSliceFn},
+		"SecurityGroups":        {name: "SecurityGroups", transform: extractStringSliceFn},
+		"HealthCheck":           {name: "HealthCheck", transform: extractFieldFn("Target")},
+		"CanonicalHostedZoneId": {name: "CanonicalHostedZoneNameID", transform: extractValueFn},
+	},
+	// access
+	"user": {
+		"Id":                   {name: "UserId", transform: extractValueFn},
+		"Name":                 {name: "UserName", transform: extractValueFn},
+		"PasswordLastUsedDate": {name: "PasswordLastUsed", transform: extractTimeFn},
+		"CreateDate":           {name: "CreateDate", transform: extractTimeFn},
+		"Arn":                  {name: "Arn", transform: extractValueFn},
+		"Path":                 {name: "Path", transform: extractValueFn},
+		"InlinePolicies":       {name: "UserPolicyList", transform: extractSliceValues("PolicyName")},
+	},
+	"group": {
+		"Id":             {name: "GroupId", transform: extractValueFn},
+		"Name":           {name: "GroupName", transform: extractValueFn},
+		"CreateDate":     {name: "CreateDate", transform: extractTimeFn},
+		"Arn":            {name: "Arn", transform: extractValueFn},
+		"Path":           {name: "Path", transform: extractValueFn},
+		"InlinePolicies": {name: "GroupPolicyList", transform: extractSliceValues("PolicyName")},
+	},
+	"role": {
+		"Id":             {name: "RoleId", transform: extractValueFn},
+		"Name":           {name: "RoleName", transform: extractValueFn},
+		"CreateDate":     {name: "CreateDate", transform: extractTimeFn},
+		"Arn":            {name: "Arn", transform: extractValueFn},
+		"Path":           {name: "Path", transform: extractValueFn},
+		"InlinePolicies": {name: "RolePolicyList", transform: extractSliceValues("PolicyName")},
+	},
+	"policy": {
+		"Id":           {name: "PolicyId", transform: extractValueFn},
+		"Name":         {name: "PolicyName", transform: extractValueFn},
+		"CreateDate":   {name: "CreateDate", transform: extractTimeFn},
+		"UpdateDate":   {name: "UpdateDate", transform: extractTimeFn},
+		"Arn":          {name: "Arn", transform: extractValueFn},
+		"Description":  {name: "Description", transform: extractValueFn},
+		"IsAttachable": {name: "IsAttachable", transform: extractValueFn},
+		"Path":         {name: "Path", transform: extractValueFn},
+	},
+	// storage
+	"bucket": {
+		"Name":              {name: "Name", transform: extractValueFn},
+		"Grants":            {fetch: fetchAndExtractGrantsFn},
+		"Public":            {fetch: fetchBucketPublicFn},
+		"Versioning":        {fetch: fetchBucketVersioningFn},
+		"Encryption":        {fetch: fetchBucketEncryptionFn},
+		"CreateDate":        {name: "CreationDate", transform: extractTimeFn},
+		"Id":                {name: "Name", transform: extractValueFn},
+		"Policy":            {fetch: fetchBucketPolicyFn},
+		"Lifecycle":         {fetch: fetchBucketLifecycleFn},
+		"PublicAccessBlock": {fetch: fetchBucketPublicAccessBlockFn},
+	},
+	"storageobject": {
+		"Key":          {name: "Key", transform: extractValueFn},
+		"ModifiedDate": {name: "LastModified", transform: extractTimeFn},
+		"OwnerId":      {name: "Owner", transform: extractFieldFn("ID")},
+		"Size":         {name: "Size", transform: extractValueFn},
+		"Class":        {name: "StorageClass", transform: extractValueFn},
+		"Id":           {name: "Key", transform: extractValueFn},
+		"ETag":         {name: "ETag", transform: extractETagFn},
+	},
+	// notification
+	"subscription": {
+		"SubscriptionArn": {name: "SubscriptionArn", transform: extractValueFn},
+		"TopicArn":        {name: "TopicArn", transform: extractValueFn},
+		"Endpoint":        {name: "Endpoint", transform: extractValueFn},
+		"Protocol":        {name: "Protocol", transform: extractValueFn},
+		"Owner":           {name: "Owner", transform: extractValueFn},
+		"Id":              {name: "Endpoint", transform: extractValueFn},
+	},
+	"topic": {
+		"TopicArn": {name: "TopicArn", transform: extractValueFn},
+		"Id":       {name: "TopicArn", transform: extractValueFn},
+	},
+	// queue
+	"queue": {},
+	// database
+	"database": {
+		"Id":                {name: "DBInstanceIdentifier", transform: extractValueFn},
+		"State":             {name: "DBInstanceStatus", transform: extractValueFn},
+		"Class":             {name: "DBInstanceClass", transform: extractValueFn},
+		"Engine":            {name: "Engine", transform: extractValueFn},
+		"EngineVersion":     {name: "EngineVersion", transform: extractValueFn},
+		"Storage":           {name: "AllocatedStorage", transform: extractValueFn},
+		"Address":           {name: "Endpoint", transform: extractFieldFn("Address")},
+		"Port":              {name: "Endpoint", transform: extractFieldFn("Port")},
+		"VpcId":             {name: "DBSubnetGroup", transform: extractFieldFn("VpcId")},
+		"CreateTime":        {name: "InstanceCreateTime", transform: extractTimeFn},
+		"Name":              {name: "DBInstanceIdentifier", transform: extractValueFn},
+		"Arn":               {name: "DBInstanceArn", transform: extractValueFn},
+		"DBName":            {name: "DBName", transform: extractValueFn},
+		"Username":          {name: "MasterUsername", transform: extractValueFn},
+		"StorageType":       {name: "StorageType", transform: extractValueFn},
+		"Encrypted":         {name: "StorageEncrypted", transform: extractValueFn},
+		"Key":               {name: "KmsKeyId", transform: extractValueFn},
+		"AvailabilityZone":  {name: "AvailabilityZone", transform: extractValueFn},
+		"MultiAZ":           {name: "MultiAZ", transform: extractValueFn},
+		"Public":            {name: "PubliclyAccessible", transform: extractValueFn},
+		"BackupRetention":   {name: "BackupRetentionPeriod", transform: extractValueFn},
+		"SubnetGroup":       {name: "DBSubnetGroup", transform: extractFieldFn("DBSubnetGroupName")},
+		"SecurityGroups":    {name: "VpcSecurityGroups", transform: extractSliceValues("VpcSecurityGroupId")},
+		"ParameterGroups":   {name: "DBParameterGroups", transform: extractSliceValues("DBParameterGroupName")},
+		"MaintenanceWindow": {name: "PreferredMaintenanceWindow", transform: extractValueFn},
+	},
+	"dbsubnetgroup": {
+		"Name":        {name: "DBSubnetGroupName", transform: extractValueFn},
+		"VpcId":       {name: "VpcId", transform: extractValueFn},
+		"State":       {name: "SubnetGroupStatus", transform: extractValueFn},
+		"Subnets":     {name: "Subnets", transform: extractSliceValues("SubnetIdentifier")},
+		"Description": {name: "DBSubnetGroupDescription", transform: extractValueFn},
+		"Id":          {name: "DBSubnetGroupName", transform: extractValueFn},
+		"Arn":         {name: "DBSubnetGroupArn", transform: extractValueFn},
+	},
+	"dbparametergroup": {
+		"Name":        {name: "DBParameterGroupName", transform: extractValueFn},
+		"Family":      {name: "DBParameterGroupFamily", transform: extractValueFn},
+		"Description": {name: "Description", transform: extractValueFn},
+		"Id":          {name: "DBParameterGroupName", transform: extractValueFn},
+		"Arn":         {name: "DBParameterGroupArn", transform: extractValueFn},
+	},
+	// lambda
+	"function": {
+		"Name":           {name: "FunctionName", transform: extractValueFn},
+		"Runtime":        {name: "Runtime", transform: extractValueFn},
+		"Handler":        {name: "Handler", transform: extractValueFn},
+		"Memory":         {name: "MemorySize", transform: extractValueFn},
+		"Timeout":        {name: "Timeout", transform: extractValueFn},
+		"Size":           {name: "CodeSize", transform: extractValueFn},
+		"VpcId":          {name: "VpcConfig", transform: extractFieldFn("VpcId")},
+		"Modified":       {name: "LastModified", transform: extractStringTimeFn(lambdaTimeLayout)},
+		"Id":             {name: "FunctionArn", transform: extractValueFn},
+		"Arn":            {name: "FunctionArn", transform: extractValueFn},
+		"Role":           {name: "Role", transform: extractValueFn},
+		"Description":    {name: "Description", transform: extractValueFn},
+		"Hash":           {name: "CodeSha256", transform: extractValueFn},
+		"Version":        {name: "Version", transform: extractValueFn},
+		"Subnets":        {name: "VpcConfig", transform: extractStringSliceFieldFn("SubnetIds")},
+		"SecurityGroups": {name: "VpcConfig", transform: extractStringSliceFieldFn("SecurityGroupIds")},
+	},
+	// dns
+	"zone": {
+		"Id":              {name: "Id", transform: extractValueFn},
+		"Name":            {name: "Name", transform: extractValueFn},
+		"Comment":         {name: "Config", transform: extractFieldFn("Comment")},
+		"Private":         {name: "Config", transform: extractFieldFn("PrivateZone")},
+		"RecordCount":     {name: "ResourceRecordSetCount", transform: extractValueFn},
+		"CallerReference": {name: "CallerReference", transform: extractValueFn},
+	},
+	"record": {
+		"Name":          {name: "Name", transform: extractValueFn},
+		"Type":          {name: "Type", transform: extractValueFn},
+		"TTL":           {name: "TTL", transform: extractValueFn},
+		"Records":       {name: "ResourceRecords", transform: extractSliceValues("Value")},
+		"Alias":         {name: "AliasTarget", transform: extractFieldFn("DNSName")},
+		"SetIdentifier": {name: "SetIdentifier", transform: extractValueFn},
+		"Weight":        {name: "Weight", transform: extractValueFn},
+		"Region":        {name: "Region", transform: extractValueFn},
+		"Failover":      {name: "Failover", transform: extractValueFn},
+		"HealthCheckId": {name: "HealthCheckId", transform: extractValueFn},
+	},
+	// autoscaling
+	"launchconfiguration": {
+		"Name":           {name: "LaunchConfigurationName", transform: extractValueFn},
+		"Type":           {name: "InstanceType", transform: extractValueFn},
+		"ImageId":        {name: "ImageId", transform: extractValueFn},
+		"KeyName":        {name: "KeyName", transform: extractValueFn},
+		"SecurityGroups": {name: "SecurityGroups", transform: extractStringSliceFn},
+		"CreateTime":     {name: "CreatedTime", transform: extractTimeFn},
+		"Id":             {name: "LaunchConfigurationName", transform: extractValueFn},
+		"Arn":            {name: "LaunchConfigurationARN", transform: extractValueFn},
+		"Profile":        {name: "IamInstanceProfile", transform: extractValueFn},
+		"SpotPrice":      {name: "SpotPrice", transform: extractValueFn},
+		"PublicIp":       {name: "AssociatePublicIpAddress", transform: extractValueFn},
+	},
+	"scalinggroup": {
+		"Name":                    {name: "AutoScalingGroupName", transform: extractValueFn},
+		"LaunchConfigurationName": {name: "LaunchConfigurationName", transform: extractValueFn},
+		"MinSize":                 {name: "MinSize", transform: extractValueFn},
+		"DesiredCapacity":         {name: "DesiredCapacity", transform: extractValueFn},
+		"MaxSize":                 {name: "MaxSize", transform: extractValueFn},
+		"Instances":               {name: "Instances", transform: extractSliceValues("InstanceId")},
+		"Subnets":                 {name: "VPCZoneIdentifier", transform: extractCommaSeparatedFn},
+		"CreateTime":              {name: "CreatedTime", transform: extractTimeFn},
+		"Id":                      {name: "AutoScalingGroupName", transform: extractValueFn},
+		"Arn":                     {name: "AutoScalingGroupARN", transform: extractValueFn},
+		"DefaultCooldown":         {name: "DefaultCooldown", transform: extractValueFn},
+		"HealthCheckType":         {name: "HealthCheckType", transform: extractValueFn},
+		"HealthCheckGracePeriod":  {name: "HealthCheckGracePeriod", transform: extractValueFn},
+		"State":                   {name: "Status", transform: extractValueFn},
+		"AvailabilityZones":       {name: "AvailabilityZones", transform: extractStringSliceFn},
+		"TargetGroups":            {name: "TargetGroupARNs", transform: extractStringSliceFn},
+	},
+	// monitoring
+	"alarm": {
+		"Name":                    {name: "AlarmName", transform: extractValueFn},
+		"State":                   {name: "StateValue", transform: extractValueFn},
+		"Namespace":               {name: "Namespace", transform: extractValueFn},
+		"MetricName":              {name: "MetricName", transform: extractValueFn},
+		"Operator":                {name: "ComparisonOperator", transform: extractValueFn},
+		"Threshold":               {name: "Threshold", transform: extractValueFn},
+		"Dimensions":              {name: "Dimensions", transform: extractAlarmDimensionsFn},
+		"StateUpdated":            {name: "StateUpdatedTimestamp", transform: extractTimeFn},
+		"Id":                      {name: "AlarmName", transform: extractValueFn},
+		"Arn":                     {name: "AlarmArn", transform: extractValueFn},
+		"Description":             {name: "AlarmDescription", transform: extractValueFn},
+		"StateReason":             {name: "StateReason", transform: extractValueFn},
+		"Statistic":               {name: "Statistic", transform: extractValueFn},
+		"Period":                  {name: "Period", transform: extractValueFn},
+		"EvaluationPeriods":       {name: "EvaluationPeriods", transform: extractValueFn},
+		"Unit":                    {name: "Unit", transform: extractValueFn},
+		"ActionsEnabled":          {name: "ActionsEnabled", transform: extractValueFn},
+		"AlarmActions":            {name: "AlarmActions", transform: extractStringSliceFn},
+		"OKActions":               {name: "OKActions", transform: extractStringSliceFn},
+		"InsufficientDataActions": {name: "InsufficientDataActions", transform: extractStringSliceFn},
+		"UpdateTime":              {name: "AlarmConfigurationUpdatedTimestamp", transform: extractTimeFn},
+	},
+	// stack
+	"stack": {
+		"Name":            {name: "StackName", transform: extractValueFn},
+		"State":           {name: "StackStatus", transform: extractValueFn},
+		"Parameters":      {name: "Parameters", transform: extractStackParametersFn},
+		"Outputs":         {name: "Outputs", transform: extractStackOutputsFn},
+		"CreateTime":      {name: "CreationTime", transform: extractTimeFn},
+		"UpdateTime":      {name: "LastUpdatedTime", transform: extractTimeFn},
+		"Id":              {name: "StackId", transform: extractValueFn},
+		"Description":     {name: "Description", transform: extractValueFn},
+		"StateReason":     {name: "StackStatusReason", transform: extractValueFn},
+		"Capabilities":    {name: "Capabilities", transform: extractStringSliceFn},
+		"Notifications":   {name: "NotificationARNs", transform: extractStringSliceFn},
+		"Role":            {name: "RoleARN", transform: extractValueFn},
+		"DisableRollback": {name: "DisableRollback", transform: extractValueFn},
+		"Timeout":         {name: "TimeoutInMinutes", transform: extractValueFn},
+		"Resources":       {fetch: fetchStackResourcesFn},
+	},
+	// container
+	"cluster": {
+		"Name":                    {name: "ClusterName", transform: extractValueFn},
+		"State":                   {name: "Status", transform: extractValueFn},
+		"ActiveServicesCount":     {name: "ActiveServicesCount", transform: extractValueFn},
+		"RunningTasksCount":       {name: "RunningTasksCount", transform: extractValueFn},
+		"PendingTasksCount":       {name: "PendingTasksCount", transform: extractValueFn},
+		"ContainerInstancesCount": {name: "RegisteredContainerInstancesCount", transform: extractValueFn},
+		"Id":                      {name: "ClusterArn", transform: extractValueFn},
+		"Arn":                     {name: "ClusterArn", transform: extractValueFn},
+	},
+	"service": {
+		"Name":                 {name: "ServiceName", transform: extractValueFn},
+		"Cluster":              {name: "ClusterArn", transform: extractValueFn},
+		"State":                {name: "Status", transform: extractValueFn},
+		"TaskDefinition":       {name: "TaskDefinition", transform: extractValueFn},
+		"DesiredCount":         {name: "DesiredCount", transform: extractValueFn},
+		"RunningCount":         {name: "RunningCount", transform: extractValueFn},
+		"PendingCount":         {name: "PendingCount", transform: extractValueFn},
+		"CreateTime":           {name: "CreatedAt", transform: extractTimeFn},
+		"Id":                   {name: "ServiceArn", transform: extractValueFn},
+		"Arn":                  {name: "ServiceArn", transform: extractValueFn},
+		"Role":                 {name: "RoleArn", transform: extractValueFn},
+		"TargetGroups":         {name: "LoadBalancers", transform: extractSliceValues("TargetGroupArn")},
+		"DeploymentMaxPercent": {name: "DeploymentConfiguration", transform: extractFieldFn("MaximumPercent")},
+		"DeploymentMinPercent": {name: "DeploymentConfiguration", transform: extractFieldFn("MinimumHealthyPercent")},
+	},
+	"taskdefinition": {
+		"Name":        {name: "Family", transform: extractValueFn},
+		"Revision":    {name: "Revision", transform: extractValueFn},
+		"State":       {name: "Status", transform: extractValueFn},
+		"Containers":  {name: "ContainerDefinitions", transform: extractSliceValues("Name")},
+		"Images":      {name: "ContainerDefinitions", transform: extractSliceValues("Image")},
+		"Role":        {name: "TaskRoleArn", transform: extractValueFn},
+		"Id":          {name: "TaskDefinitionArn", transform: extractValueFn},
+		"Arn":         {name: "TaskDefinitionArn", transform: extractValueFn},
+		"NetworkMode": {name: "NetworkMode", transform: extractValueFn},
+	},
+	"task": {
+		"Id":                {name: "TaskArn", transform: extractValueFn},
+		"Cluster":           {name: "ClusterArn", transform: extractValueFn},
+		"TaskDefinition":    {name: "TaskDefinitionArn", transform: extractValueFn},
+		"State":             {name: "LastStatus", transform: extractValueFn},
+		"Group":             {name: "Group", transform: extractValueFn},
+		"ContainerInstance": {name: "ContainerInstanceArn", transform: extractValueFn},
+		"LaunchTime":        {name: "StartedAt", transform: extractTimeFn},
+		"Arn":               {name: "TaskArn", transform: extractValueFn},
+		"DesiredState":      {name: "DesiredStatus", transform: extractValueFn},
+		"StateReason":       {name: "StoppedReason", transform: extractValueFn},
+		"StartedBy":         {name: "StartedBy", transform: extractValueFn},
+		"CreateTime":        {name: "CreatedAt", transform: extractTimeFn},
+		"StopTime":          {name: "StoppedAt", transform: extractTimeFn},
+	},
+	"containerinstance": {
+		"Id":                {name: "ContainerInstanceArn", transform: extractValueFn},
+		"InstanceId":        {name: "Ec2InstanceId", transform: extractValueFn},
+		"State":             {name: "Status", transform: extractValueFn},
+		"AgentConnected":    {name: "AgentConnected", transform: extractValueFn},
+		"RunningTasksCount": {name: "RunningTasksCount", transform: extractValueFn},
+		"PendingTasksCount": {name: "PendingTasksCount", transform: extractValueFn},
+		"Arn":               {name: "ContainerInstanceArn", transform: extractValueFn},
+		"AgentVersion":      {name: "VersionInfo", transform: extractFieldFn("AgentVersion")},
+	},
+	// nosql
+	"table": {
+		"Name":          {name: "TableName", transform: extractValueFn},
+		"State":         {name: "TableStatus", transform: extractValueFn},
+		"KeySchema":     {name: "KeySchema", transform: extractTableKeySchemaFn},
+		"ReadCapacity":  {name: "ProvisionedThroughput", transform: extractFieldFn("ReadCapacityUnits")},
+		"WriteCapacity": {name: "ProvisionedThroughput", transform: extractFieldFn("WriteCapacityUnits")},
+		"ItemCount":     {name: "ItemCount", transform: extractValueFn},
+		"Size":          {name: "TableSizeBytes", transform: extractValueFn},
+		"CreateTime":    {name: "CreationDateTime", transform: extractTimeFn},
+		"Id":            {name: "TableName", transform: extractValueFn},
+		"Arn":           {name: "TableArn", transform: extractValueFn},
+		"StreamArn":     {name: "LatestStreamArn", transform: extractValueFn},
+		"StreamView":    {name: "StreamSpecification", transform: extractFieldFn("StreamViewType")},
+	},
+	// encryption
+	"key": {
+		"Id":           {name: "KeyId", transform: extractValueFn},
+		"Description":  {name: "Description", transform: extractValueFn},
+		"State":        {name: "KeyState", transform: extractValueFn},
+		"Usage":        {name: "KeyUsage", transform: extractValueFn},
+		"Origin":       {name: "Origin", transform: extractValueFn},
+		"CreateTime":   {name: "CreationDate", transform: extractTimeFn},
+		"DeletionTime": {name: "DeletionDate", transform: extractTimeFn},
+		"Arn":          {name: "Arn", transform: extractValueFn},
+		"Enabled":      {name: "Enabled", transform: extractValueFn},
+	},
+	"keyalias": {
+		"Name": {name: "AliasName", transform: extractValueFn},
+		"Key":  {name: "TargetKeyId", transform: extractValueFn},
+		"Id":   {name: "AliasName", transform: extractValueFn},
+		"Arn":  {name: "AliasArn", transform: extractValueFn},
+	},
+}
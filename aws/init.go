@@ -18,6 +18,7 @@ package aws
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -29,9 +30,15 @@ import (
 )
 
 var (
-	AccessService, InfraService, StorageService, NotificationService, QueueService cloud.Service
+	AccessService, InfraService, StorageService, NotificationService, QueueService, DatabaseService, LambdaService, DnsService, AutoscalingService, MonitoringService, StackService, ContainerService, NosqlService, EncryptionService cloud.Service
 
 	SecuAPI Security
+
+	currentSession  *session.Session
+	currentAccount  string
+	currentConf     config
+	currentLogger   *logger.Logger
+	accountSessions = make(map[string]*session.Session)
 )
 
 func InitSession(region, profile string) (*session.Session, error) {
@@ -53,6 +60,31 @@ func InitSession(region, profile string) (*session.Session, error) {
 	return session, nil
 }
 
+// InitAccountSession returns a session with the credentials of an account context: the ones of its profile,
+// or the temporary ones of its role, assumed with the credentials of its profile. With an MFA device,
+// the token code is prompted on stdin
+func InitAccountSession(region string, acc *Account) (*session.Session, error) {
+	sess, err := InitSession(region, acc.Profile)
+	if err != nil || acc.Role == "" {
+		return sess, err
+	}
+
+	creds := stscreds.NewCredentials(sess, acc.Role, func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = fmt.Sprintf("awless-%s-%d", acc.Name, time.Now().Unix())
+		if acc.ExternalID != "" {
+			p.ExternalID = awssdk.String(acc.ExternalID)
+		}
+		if acc.MFASerial != "" {
+			p.SerialNumber = awssdk.String(acc.MFASerial)
+			p.TokenProvider = stscreds.StdinTokenProvider
+		}
+	})
+	if _, err = creds.Get(); err != nil {
+		return nil, fmt.Errorf("account %s: cannot assume role %s: %s", acc.Name, acc.Role, err)
+	}
+	return sess.Copy(&awssdk.Config{Credentials: creds}), nil
+}
+
 func InitServices(conf map[string]interface{}, log *logger.Logger) error {
 	awsconf := config(conf)
 	region := awsconf.region()
@@ -60,22 +92,122 @@ func InitServices(conf map[string]interface{}, log *logger.Logger) error {
 		return errors.New("empty AWS region. Set it with `awless config set aws.region`")
 	}
 
-	sess, err := InitSession(region, awsconf.profile())
+	sess, err := newAccountSession(awsconf, awsconf.accountName(), region)
 	if err != nil {
 		return err
 	}
+	currentSession, currentAccount, currentConf, currentLogger = sess, awsconf.accountName(), awsconf, log
+	accountSessions[currentAccount] = sess
+
 	AccessService = NewAccess(sess, awsconf, log)
 	InfraService = NewInfra(sess, awsconf, log)
 	StorageService = NewStorage(sess, awsconf, log)
 	SecuAPI = NewSecu(sess)
 	NotificationService = NewNotification(sess, awsconf, log)
 	QueueService = NewQueue(sess, awsconf, log)
+	DatabaseService = NewDatabase(sess, awsconf, log)
+	LambdaService = NewLambda(sess, awsconf, log)
+	DnsService = NewDns(sess, awsconf, log)
+	AutoscalingService = NewAutoscaling(sess, awsconf, log)
+	MonitoringService = NewMonitoring(sess, awsconf, log)
+	StackService = NewStack(sess, awsconf, log)
+	ContainerService = NewContainer(sess, awsconf, log)
+	NosqlService = NewNosql(sess, awsconf, log)
+	EncryptionService = NewEncryption(sess, awsconf, log)
 
 	cloud.ServiceRegistry[InfraService.Name()] = InfraService
 	cloud.ServiceRegistry[AccessService.Name()] = AccessService
 	cloud.ServiceRegistry[StorageService.Name()] = StorageService
 	cloud.ServiceRegistry[NotificationService.Name()] = NotificationService
 	cloud.ServiceRegistry[QueueService.Name()] = QueueService
+	cloud.ServiceRegistry[DatabaseService.Name()] = DatabaseService
+	cloud.ServiceRegistry[LambdaService.Name()] = LambdaService
+	cloud.ServiceRegistry[DnsService.Name()] = DnsService
+	cloud.ServiceRegistry[AutoscalingService.Name()] = AutoscalingService
+	cloud.ServiceRegistry[MonitoringService.Name()] = MonitoringService
+	cloud.ServiceRegistry[StackService.Name()] = StackService
+	cloud.ServiceRegistry[ContainerService.Name()] = ContainerService
+	cloud.ServiceRegistry[NosqlService.Name()] = NosqlService
+	cloud.ServiceRegistry[EncryptionService.Name()] = EncryptionService
 
 	return nil
 }
+
+// RegionServices returns the services of a region, sharing the credentials of the session of InitServices.
+// Global services are only returned for the session region, so that their resources are fetched once
+func RegionServices(region string) ([]cloud.Service, error) {
+	var services []cloud.Service
+	for _, name := range ServiceNames {
+		if srv, ok := cloud.ServiceRegistry[name]; ok && srv.Region() == region {
+			services = append(services, srv)
+		}
+	}
+	if len(services) > 0 {
+		return services, nil
+	}
+	if currentSession == nil {
+		return nil, fmt.Errorf("cannot load services in region %s: no AWS session", region)
+	}
+	return newRegionServices(currentSession, region), nil
+}
+
+// AccountServices returns the services of an account context in a region. As for RegionServices,
+// global services are only returned for the session region. The session of each account is created once
+// (prompting for its MFA token code if needed): it is not safe for concurrent use
+func AccountServices(account, region string) ([]cloud.Service, error) {
+	if account == currentAccount {
+		return RegionServices(region)
+	}
+	if currentSession == nil {
+		return nil, fmt.Errorf("cannot load services of account %s: no AWS session", account)
+	}
+	sess, ok := accountSessions[account]
+	if !ok {
+		var err error
+		if sess, err = newAccountSession(currentConf, account, awssdk.StringValue(currentSession.Config.Region)); err != nil {
+			return nil, err
+		}
+		accountSessions[account] = sess
+	}
+	return newRegionServices(sess, region), nil
+}
+
+func newAccountSession(awsconf config, account, region string) (*session.Session, error) {
+	if account == "" {
+		return InitSession(region, awsconf.profile())
+	}
+	acc, err := awsconf.account(account)
+	if err != nil {
+		return nil, err
+	}
+	return InitAccountSession(region, acc)
+}
+
+func newRegionServices(sess *session.Session, region string) []cloud.Service {
+	isSessionRegion := region == awssdk.StringValue(currentSession.Config.Region)
+	sess = sess.Copy(&awssdk.Config{Region: awssdk.String(region)})
+
+	var services []cloud.Service
+	for _, srv := range []cloud.Service{
+		NewInfra(sess, currentConf, currentLogger),
+		NewAccess(sess, currentConf, currentLogger),
+		NewStorage(sess, currentConf, currentLogger),
+		NewNotification(sess, currentConf, currentLogger),
+		NewQueue(sess, currentConf, currentLogger),
+		NewDatabase(sess, currentConf, currentLogger),
+		NewLambda(sess, currentConf, currentLogger),
+		NewDns(sess, currentConf, currentLogger),
+		NewAutoscaling(sess, currentConf, currentLogger),
+		NewMonitoring(sess, currentConf, currentLogger),
+		NewStack(sess, currentConf, currentLogger),
+		NewContainer(sess, currentConf, currentLogger),
+		NewNosql(sess, currentConf, currentLogger),
+		NewEncryption(sess, currentConf, currentLogger),
+	} {
+		if cloud.GlobalServices[srv.Name()] && !isSessionRegion {
+			continue
+		}
+		services = append(services, srv)
+	}
+	return services
+}
@@ -5,12 +5,34 @@ import (
 	"strconv"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	awsdriver "github.com/wallix/awless/aws/driver"
@@ -27,6 +49,11 @@ type mockEc2 struct {
 	keyPairs         []*ec2.KeyPairInfo
 	internetGateways []*ec2.InternetGateway
 	routeTables      []*ec2.RouteTable
+	natGateways      []*ec2.NatGateway
+	addresses        []*ec2.Address
+	volumes          []*ec2.Volume
+	images           []*ec2.Image
+	snapshots        []*ec2.Snapshot
 }
 
 func (m *mockEc2) DescribeVpcs(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
@@ -62,14 +89,33 @@ func (m *mockEc2) DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2
 	return &ec2.DescribeRouteTablesOutput{RouteTables: m.routeTables}, nil
 }
 
-// Not tested
+func (m *mockEc2) DescribeNatGateways(input *ec2.DescribeNatGatewaysInput) (*ec2.DescribeNatGatewaysOutput, error) {
+	return &ec2.DescribeNatGatewaysOutput{NatGateways: m.natGateways}, nil
+}
+
+func (m *mockEc2) DescribeAddresses(input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error) {
+	return &ec2.DescribeAddressesOutput{Addresses: m.addresses}, nil
+}
+
 func (m *mockEc2) DescribeVolumes(input *ec2.DescribeVolumesInput) (*ec2.DescribeVolumesOutput, error) {
-	return &ec2.DescribeVolumesOutput{}, nil
+	return &ec2.DescribeVolumesOutput{Volumes: m.volumes}, nil
 }
+
 func (m *mockEc2) DescribeVolumesPages(input *ec2.DescribeVolumesInput, fn func(p *ec2.DescribeVolumesOutput, lastPage bool) (shouldContinue bool)) error {
-	fn(&ec2.DescribeVolumesOutput{}, true)
+	fn(&ec2.DescribeVolumesOutput{Volumes: m.volumes}, true)
+	return nil
+}
+
+func (m *mockEc2) DescribeImages(input *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+	return &ec2.DescribeImagesOutput{Images: m.images}, nil
+}
+
+func (m *mockEc2) DescribeSnapshotsPages(input *ec2.DescribeSnapshotsInput, fn func(p *ec2.DescribeSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&ec2.DescribeSnapshotsOutput{Snapshots: m.snapshots}, true)
 	return nil
 }
+
+// Not tested
 func (m *mockEc2) DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
 	return &ec2.DescribeAvailabilityZonesOutput{}, nil
 }
@@ -101,6 +147,16 @@ func (m *mockELB) DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (
 	return &elbv2.DescribeTargetHealthOutput{TargetHealthDescriptions: m.targetHealths[awssdk.StringValue(input.TargetGroupArn)]}, nil
 }
 
+type mockClassicELB struct {
+	elbiface.ELBAPI
+	loadBalancers []*elb.LoadBalancerDescription
+}
+
+func (m *mockClassicELB) DescribeLoadBalancersPages(input *elb.DescribeLoadBalancersInput, fn func(p *elb.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&elb.DescribeLoadBalancersOutput{LoadBalancerDescriptions: m.loadBalancers}, true)
+	return nil
+}
+
 type mockIam struct {
 	iamiface.IAMAPI
 	groups          []*iam.GroupDetail
@@ -147,11 +203,98 @@ func (m *mockIam) GetAccountAuthorizationDetailsPages(input *iam.GetAccountAutho
 	return nil
 }
 
+func mockELBV2API(perRegion map[string]elbv2iface.ELBV2API) (restore func()) {
+	previous := newELBV2API
+	newELBV2API = func(sess *session.Session, region string) elbv2iface.ELBV2API {
+		if mock, ok := perRegion[region]; ok {
+			return mock
+		}
+		return &wrongRegionELB{region: region}
+	}
+	return func() { newELBV2API = previous }
+}
+
+type wrongRegionELB struct {
+	elbv2iface.ELBV2API
+	region string
+}
+
+func (m *wrongRegionELB) DescribeLoadBalancersPages(input *elbv2.DescribeLoadBalancersInput, fn func(p *elbv2.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool)) error {
+	return fmt.Errorf("unexpected call to load balancers of region %s", m.region)
+}
+
+func (m *wrongRegionELB) DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error) {
+	return nil, fmt.Errorf("unexpected call to target healths of region %s", m.region)
+}
+
+type wrongRegionCloudformation struct {
+	cloudformationiface.CloudFormationAPI
+	region string
+}
+
+func (m *wrongRegionCloudformation) ListStackResourcesPages(input *cloudformation.ListStackResourcesInput, fn func(p *cloudformation.ListStackResourcesOutput, lastPage bool) (shouldContinue bool)) error {
+	return fmt.Errorf("unexpected call to stack resources of region %s", m.region)
+}
+
+// mockIAMAPI makes the mock the IAM client of any session, until restored
+func mockIAMAPI(mock iamiface.IAMAPI) (restore func()) {
+	previous := newIAMAPI
+	newIAMAPI = func(*session.Session) iamiface.IAMAPI { return mock }
+	return func() { newIAMAPI = previous }
+}
+
 type mockS3 struct {
 	s3iface.S3API
-	bucketsACL       map[string][]*s3.Grant
-	bucketsPerRegion map[string][]*s3.Bucket
-	objectsPerBucket map[string][]*s3.Object
+	bucketsACL        map[string][]*s3.Grant
+	bucketsPerRegion  map[string][]*s3.Bucket
+	objectsPerBucket  map[string][]*s3.Object
+	bucketsPolicy     map[string]string
+	bucketsVersioning map[string]string
+	bucketsLifecycle  map[string][]*s3.LifecycleRule
+	bucketsEncryption map[string]*s3.ServerSideEncryptionByDefault
+	bucketsAccess     map[string]*s3.PublicAccessBlockConfiguration
+}
+
+func (m *mockS3) GetBucketEncryption(input *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	def, ok := m.bucketsEncryption[awssdk.StringValue(input.Bucket)]
+	if !ok {
+		return nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found", nil)
+	}
+	return &s3.GetBucketEncryptionOutput{ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+		Rules: []*s3.ServerSideEncryptionRule{{ApplyServerSideEncryptionByDefault: def}},
+	}}, nil
+}
+
+func (m *mockS3) GetPublicAccessBlock(input *s3.GetPublicAccessBlockInput) (*s3.GetPublicAccessBlockOutput, error) {
+	block, ok := m.bucketsAccess[awssdk.StringValue(input.Bucket)]
+	if !ok {
+		return nil, awserr.New("NoSuchPublicAccessBlockConfiguration", "The public access block configuration was not found", nil)
+	}
+	return &s3.GetPublicAccessBlockOutput{PublicAccessBlockConfiguration: block}, nil
+}
+
+func (m *mockS3) GetBucketPolicy(input *s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error) {
+	policy, ok := m.bucketsPolicy[awssdk.StringValue(input.Bucket)]
+	if !ok {
+		return nil, awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil)
+	}
+	return &s3.GetBucketPolicyOutput{Policy: awssdk.String(policy)}, nil
+}
+
+func (m *mockS3) GetBucketVersioning(input *s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error) {
+	out := &s3.GetBucketVersioningOutput{}
+	if status, ok := m.bucketsVersioning[awssdk.StringValue(input.Bucket)]; ok {
+		out.Status = awssdk.String(status)
+	}
+	return out, nil
+}
+
+func (m *mockS3) GetBucketLifecycleConfiguration(input *s3.GetBucketLifecycleConfigurationInput) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+	rules, ok := m.bucketsLifecycle[awssdk.StringValue(input.Bucket)]
+	if !ok {
+		return nil, awserr.New("NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist", nil)
+	}
+	return &s3.GetBucketLifecycleConfigurationOutput{Rules: rules}, nil
 }
 
 func (m *mockS3) GetBucketAcl(input *s3.GetBucketAclInput) (*s3.GetBucketAclOutput, error) {
@@ -163,6 +306,9 @@ func (m *mockS3) Name() string {
 func (m *mockS3) Provider() string {
 	return ""
 }
+func (m *mockS3) Region() string {
+	return ""
+}
 func (m *mockS3) ProviderAPI() string {
 	return ""
 }
@@ -188,8 +334,9 @@ func (m *mockS3) ListBuckets(input *s3.ListBucketsInput) (*s3.ListBucketsOutput,
 	}
 	return &s3.ListBucketsOutput{Buckets: buckets}, nil
 }
-func (m *mockS3) ListObjects(input *s3.ListObjectsInput) (*s3.ListObjectsOutput, error) {
-	return &s3.ListObjectsOutput{Contents: m.objectsPerBucket[awssdk.StringValue(input.Bucket)]}, nil
+func (m *mockS3) ListObjectsPages(input *s3.ListObjectsInput, fn func(p *s3.ListObjectsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&s3.ListObjectsOutput{Contents: m.objectsPerBucket[awssdk.StringValue(input.Bucket)]}, true)
+	return nil
 }
 func (m *mockS3) GetBucketLocation(input *s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error) {
 	for region, buckets := range m.bucketsPerRegion {
@@ -201,3 +348,257 @@ func (m *mockS3) GetBucketLocation(input *s3.GetBucketLocationInput) (*s3.GetBuc
 	}
 	return nil, fmt.Errorf("bucket location mock: bucket %s not found", awssdk.StringValue(input.Bucket))
 }
+
+type mockRDS struct {
+	rdsiface.RDSAPI
+	dbInstances    []*rds.DBInstance
+	dbSubnetGroups []*rds.DBSubnetGroup
+	dbParamsGroups []*rds.DBParameterGroup
+}
+
+func (m *mockRDS) DescribeDBInstancesPages(input *rds.DescribeDBInstancesInput, fn func(p *rds.DescribeDBInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&rds.DescribeDBInstancesOutput{DBInstances: m.dbInstances}, true)
+	return nil
+}
+func (m *mockRDS) DescribeDBSubnetGroupsPages(input *rds.DescribeDBSubnetGroupsInput, fn func(p *rds.DescribeDBSubnetGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&rds.DescribeDBSubnetGroupsOutput{DBSubnetGroups: m.dbSubnetGroups}, true)
+	return nil
+}
+func (m *mockRDS) DescribeDBParameterGroupsPages(input *rds.DescribeDBParameterGroupsInput, fn func(p *rds.DescribeDBParameterGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&rds.DescribeDBParameterGroupsOutput{DBParameterGroups: m.dbParamsGroups}, true)
+	return nil
+}
+
+type mockLambda struct {
+	lambdaiface.LambdaAPI
+	functions []*lambda.FunctionConfiguration
+}
+
+func (m *mockLambda) ListFunctionsPages(input *lambda.ListFunctionsInput, fn func(p *lambda.ListFunctionsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&lambda.ListFunctionsOutput{Functions: m.functions}, true)
+	return nil
+}
+
+type mockRoute53 struct {
+	route53iface.Route53API
+	zones   []*route53.HostedZone
+	records map[string][]*route53.ResourceRecordSet
+}
+
+func (m *mockRoute53) ListHostedZonesPages(input *route53.ListHostedZonesInput, fn func(p *route53.ListHostedZonesOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&route53.ListHostedZonesOutput{HostedZones: m.zones}, true)
+	return nil
+}
+func (m *mockRoute53) ListResourceRecordSetsPages(input *route53.ListResourceRecordSetsInput, fn func(p *route53.ListResourceRecordSetsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&route53.ListResourceRecordSetsOutput{ResourceRecordSets: m.records[awssdk.StringValue(input.HostedZoneId)], IsTruncated: awssdk.Bool(false)}, true)
+	return nil
+}
+
+type mockAutoscaling struct {
+	autoscalingiface.AutoScalingAPI
+	launchConfigs []*autoscaling.LaunchConfiguration
+	groups        []*autoscaling.Group
+}
+
+func (m *mockAutoscaling) DescribeLaunchConfigurationsPages(input *autoscaling.DescribeLaunchConfigurationsInput, fn func(p *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&autoscaling.DescribeLaunchConfigurationsOutput{LaunchConfigurations: m.launchConfigs}, true)
+	return nil
+}
+func (m *mockAutoscaling) DescribeAutoScalingGroupsPages(input *autoscaling.DescribeAutoScalingGroupsInput, fn func(p *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: m.groups}, true)
+	return nil
+}
+
+type mockCloudwatch struct {
+	cloudwatchiface.CloudWatchAPI
+	alarms []*cloudwatch.MetricAlarm
+}
+
+func (m *mockCloudwatch) DescribeAlarmsPages(input *cloudwatch.DescribeAlarmsInput, fn func(p *cloudwatch.DescribeAlarmsOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&cloudwatch.DescribeAlarmsOutput{MetricAlarms: m.alarms}, true)
+	return nil
+}
+
+type mockCloudformation struct {
+	cloudformationiface.CloudFormationAPI
+	stacks    []*cloudformation.Stack
+	resources map[string][]*cloudformation.StackResourceSummary
+}
+
+func (m *mockCloudformation) DescribeStacksPages(input *cloudformation.DescribeStacksInput, fn func(p *cloudformation.DescribeStacksOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&cloudformation.DescribeStacksOutput{Stacks: m.stacks}, true)
+	return nil
+}
+
+func (m *mockCloudformation) ListStackResourcesPages(input *cloudformation.ListStackResourcesInput, fn func(p *cloudformation.ListStackResourcesOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&cloudformation.ListStackResourcesOutput{StackResourceSummaries: m.resources[awssdk.StringValue(input.StackName)]}, true)
+	return nil
+}
+
+type mockEcs struct {
+	ecsiface.ECSAPI
+	clusters           []*ecs.Cluster
+	services           []*ecs.Service
+	taskDefinitions    []*ecs.TaskDefinition
+	tasks              []*ecs.Task
+	containerInstances map[string][]*ecs.ContainerInstance
+}
+
+func (m *mockEcs) ListClustersPages(input *ecs.ListClustersInput, fn func(p *ecs.ListClustersOutput, lastPage bool) (shouldContinue bool)) error {
+	var arns []*string
+	for _, c := range m.clusters {
+		arns = append(arns, c.ClusterArn)
+	}
+	fn(&ecs.ListClustersOutput{ClusterArns: arns}, true)
+	return nil
+}
+
+func (m *mockEcs) DescribeClusters(input *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error) {
+	var clusters []*ecs.Cluster
+	for _, c := range m.clusters {
+		if containsString(input.Clusters, c.ClusterArn) {
+			clusters = append(clusters, c)
+		}
+	}
+	return &ecs.DescribeClustersOutput{Clusters: clusters}, nil
+}
+
+func (m *mockEcs) ListServicesPages(input *ecs.ListServicesInput, fn func(p *ecs.ListServicesOutput, lastPage bool) (shouldContinue bool)) error {
+	var arns []*string
+	for _, s := range m.services {
+		if awssdk.StringValue(s.ClusterArn) == awssdk.StringValue(input.Cluster) {
+			arns = append(arns, s.ServiceArn)
+		}
+	}
+	fn(&ecs.ListServicesOutput{ServiceArns: arns}, true)
+	return nil
+}
+
+func (m *mockEcs) DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error) {
+	var services []*ecs.Service
+	for _, s := range m.services {
+		if containsString(input.Services, s.ServiceArn) {
+			services = append(services, s)
+		}
+	}
+	return &ecs.DescribeServicesOutput{Services: services}, nil
+}
+
+func (m *mockEcs) ListTaskDefinitionsPages(input *ecs.ListTaskDefinitionsInput, fn func(p *ecs.ListTaskDefinitionsOutput, lastPage bool) (shouldContinue bool)) error {
+	var arns []*string
+	for _, def := range m.taskDefinitions {
+		arns = append(arns, def.TaskDefinitionArn)
+	}
+	fn(&ecs.ListTaskDefinitionsOutput{TaskDefinitionArns: arns}, true)
+	return nil
+}
+
+func (m *mockEcs) DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error) {
+	for _, def := range m.taskDefinitions {
+		if awssdk.StringValue(def.TaskDefinitionArn) == awssdk.StringValue(input.TaskDefinition) {
+			return &ecs.DescribeTaskDefinitionOutput{TaskDefinition: def}, nil
+		}
+	}
+	return nil, awserr.New(ecs.ErrCodeClientException, "task definition not found", nil)
+}
+
+func (m *mockEcs) ListTasksPages(input *ecs.ListTasksInput, fn func(p *ecs.ListTasksOutput, lastPage bool) (shouldContinue bool)) error {
+	var arns []*string
+	for _, t := range m.tasks {
+		if awssdk.StringValue(t.ClusterArn) == awssdk.StringValue(input.Cluster) {
+			arns = append(arns, t.TaskArn)
+		}
+	}
+	fn(&ecs.ListTasksOutput{TaskArns: arns}, true)
+	return nil
+}
+
+func (m *mockEcs) DescribeTasks(input *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error) {
+	var tasks []*ecs.Task
+	for _, t := range m.tasks {
+		if containsString(input.Tasks, t.TaskArn) {
+			tasks = append(tasks, t)
+		}
+	}
+	return &ecs.DescribeTasksOutput{Tasks: tasks}, nil
+}
+
+func (m *mockEcs) ListContainerInstancesPages(input *ecs.ListContainerInstancesInput, fn func(p *ecs.ListContainerInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	var arns []*string
+	for _, inst := range m.containerInstances[awssdk.StringValue(input.Cluster)] {
+		arns = append(arns, inst.ContainerInstanceArn)
+	}
+	fn(&ecs.ListContainerInstancesOutput{ContainerInstanceArns: arns}, true)
+	return nil
+}
+
+func (m *mockEcs) DescribeContainerInstances(input *ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error) {
+	var instances []*ecs.ContainerInstance
+	for _, inst := range m.containerInstances[awssdk.StringValue(input.Cluster)] {
+		if containsString(input.ContainerInstances, inst.ContainerInstanceArn) {
+			instances = append(instances, inst)
+		}
+	}
+	return &ecs.DescribeContainerInstancesOutput{ContainerInstances: instances}, nil
+}
+
+type mockDynamodb struct {
+	dynamodbiface.DynamoDBAPI
+	tables []*dynamodb.TableDescription
+}
+
+func (m *mockDynamodb) ListTablesPages(input *dynamodb.ListTablesInput, fn func(p *dynamodb.ListTablesOutput, lastPage bool) (shouldContinue bool)) error {
+	var names []*string
+	for _, t := range m.tables {
+		names = append(names, t.TableName)
+	}
+	fn(&dynamodb.ListTablesOutput{TableNames: names}, true)
+	return nil
+}
+
+func (m *mockDynamodb) DescribeTable(input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	for _, t := range m.tables {
+		if awssdk.StringValue(t.TableName) == awssdk.StringValue(input.TableName) {
+			return &dynamodb.DescribeTableOutput{Table: t}, nil
+		}
+	}
+	return nil, awserr.New(dynamodb.ErrCodeResourceNotFoundException, "table not found", nil)
+}
+
+type mockKms struct {
+	kmsiface.KMSAPI
+	keys    []*kms.KeyMetadata
+	aliases []*kms.AliasListEntry
+}
+
+func (m *mockKms) ListKeysPages(input *kms.ListKeysInput, fn func(p *kms.ListKeysOutput, lastPage bool) (shouldContinue bool)) error {
+	var keys []*kms.KeyListEntry
+	for _, k := range m.keys {
+		keys = append(keys, &kms.KeyListEntry{KeyId: k.KeyId, KeyArn: k.Arn})
+	}
+	fn(&kms.ListKeysOutput{Keys: keys}, true)
+	return nil
+}
+
+func (m *mockKms) DescribeKey(input *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error) {
+	for _, k := range m.keys {
+		if awssdk.StringValue(k.KeyId) == awssdk.StringValue(input.KeyId) {
+			return &kms.DescribeKeyOutput{KeyMetadata: k}, nil
+		}
+	}
+	return nil, awserr.New(kms.ErrCodeNotFoundException, "key not found", nil)
+}
+
+func (m *mockKms) ListAliasesPages(input *kms.ListAliasesInput, fn func(p *kms.ListAliasesOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&kms.ListAliasesOutput{Aliases: m.aliases}, true)
+	return nil
+}
+
+func containsString(all []*string, s *string) bool {
+	for _, e := range all {
+		if awssdk.StringValue(e) == awssdk.StringValue(s) {
+			return true
+		}
+	}
+	return false
+}
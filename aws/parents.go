@@ -21,10 +21,21 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strings"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/graph"
 )
 
@@ -40,7 +51,8 @@ type funcBuilder struct {
 	relation                            int
 }
 
-type addParentFn func(*graph.Graph, interface{}) error
+// addParentFn adds the relations of a resource fetched by the given service to the graph
+type addParentFn func(*graph.Graph, cloud.Service, interface{}) error
 
 var addParentsFns = map[string][]addParentFn{
 	// Infra
@@ -62,10 +74,29 @@ var addParentsFns = map[string][]addParentFn{
 	graph.RouteTable.String(): {
 		funcBuilder{parent: graph.Subnet, fieldName: "SubnetId", listName: "Associations", relation: DEPENDING_ON}.build(),
 		funcBuilder{parent: graph.Vpc, fieldName: "VpcId"}.build(),
+		routeTableAddTargetsRelations,
+	},
+	graph.NatGateway.String(): {
+		funcBuilder{parent: graph.Subnet, fieldName: "SubnetId"}.build(),
+		funcBuilder{parent: graph.ElasticIP, fieldName: "AllocationId", listName: "NatGatewayAddresses", relation: APPLIES_ON}.build(),
+	},
+	graph.Image.String(): {
+		addRegionParent,
+		imageAddSnapshotsRelations,
+	},
+	graph.Snapshot.String(): {
+		addRegionParent,
+		funcBuilder{parent: graph.Volume, fieldName: "VolumeId", relation: DEPENDING_ON}.build(),
+		addKeyFieldRelation("KmsKeyId"),
+	},
+	graph.ElasticIP.String(): {
+		addRegionParent,
+		funcBuilder{parent: graph.Instance, fieldName: "InstanceId", relation: DEPENDING_ON}.build(),
 	},
 	graph.Volume.String(): {
 		funcBuilder{parent: graph.AvailabilityZone, fieldName: "AvailabilityZone"}.build(),
 		funcBuilder{parent: graph.Instance, fieldName: "InstanceId", listName: "Attachments", relation: DEPENDING_ON}.build(),
+		addKeyFieldRelation("KmsKeyId"),
 	},
 	graph.LoadBalancer.String(): {
 		funcBuilder{parent: graph.Vpc, fieldName: "VpcId"}.build(),
@@ -73,6 +104,13 @@ var addParentsFns = map[string][]addParentFn{
 		funcBuilder{parent: graph.AvailabilityZone, fieldName: "ZoneName", listName: "AvailabilityZones", relation: DEPENDING_ON}.build(),
 		funcBuilder{parent: graph.SecurityGroup, stringListName: "SecurityGroups", relation: APPLIES_ON}.build(),
 	},
+	graph.ClassicLoadBalancer.String(): {
+		classicLoadBalancerAddNetworkRelations,
+		funcBuilder{parent: graph.Subnet, stringListName: "Subnets", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: graph.AvailabilityZone, stringListName: "AvailabilityZones", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: graph.SecurityGroup, stringListName: "SecurityGroups", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: graph.Instance, fieldName: "InstanceId", listName: "Instances", relation: DEPENDING_ON}.build(),
+	},
 	graph.Listener.String(): {
 		funcBuilder{parent: graph.LoadBalancer, fieldName: "LoadBalancerArn"}.build(),
 	},
@@ -88,7 +126,75 @@ var addParentsFns = map[string][]addParentFn{
 	graph.Role.String():             {addRegionParent, addManagedPoliciesRelations},
 	graph.Group.String():            {addRegionParent, addManagedPoliciesRelations},
 	graph.Policy.String():           {addRegionParent},
-	graph.Bucket.String():           {addRegionParent},
+	graph.Bucket.String():           {addRegionParent, bucketAddKeyRelation},
+	// Database
+	graph.Database.String(): {
+		databaseAddNetworkRelations,
+		funcBuilder{parent: graph.SecurityGroup, fieldName: "VpcSecurityGroupId", listName: "VpcSecurityGroups", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: graph.DbParameterGroup, fieldName: "DBParameterGroupName", listName: "DBParameterGroups", relation: APPLIES_ON}.build(),
+		addKeyFieldRelation("KmsKeyId"),
+	},
+	graph.DbSubnetGroup.String(): {
+		funcBuilder{parent: graph.Vpc, fieldName: "VpcId"}.build(),
+		funcBuilder{parent: graph.Subnet, fieldName: "SubnetIdentifier", listName: "Subnets", relation: DEPENDING_ON}.build(),
+	},
+	graph.DbParameterGroup.String(): {addRegionParent},
+	// Lambda
+	graph.Function.String(): {
+		functionAddNetworkRelations,
+		fetchFunctionRoleAndAddRelation,
+	},
+	// DNS
+	graph.Zone.String(): {addRegionParent},
+	// Autoscaling
+	graph.LaunchConfiguration.String(): {
+		addRegionParent,
+		funcBuilder{parent: graph.SecurityGroup, stringListName: "SecurityGroups", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: graph.Keypair, fieldName: "KeyName", relation: APPLIES_ON}.build(),
+	},
+	graph.ScalingGroup.String(): {
+		addRegionParent,
+		scalingGroupAddSubnetsRelations,
+		funcBuilder{parent: graph.LaunchConfiguration, fieldName: "LaunchConfigurationName", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: graph.Instance, fieldName: "InstanceId", listName: "Instances", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: graph.TargetGroup, stringListName: "TargetGroupARNs", relation: DEPENDING_ON}.build(),
+	},
+	// Monitoring
+	graph.Alarm.String(): {
+		addRegionParent,
+		alarmAddDimensionsRelations,
+		alarmAddActionsRelations,
+	},
+	// Stack
+	graph.Stack.String(): {
+		addRegionParent,
+		stackAddResourcesRelations,
+	},
+	// Container
+	graph.Cluster.String(): {addRegionParent},
+	graph.ContainerService.String(): {
+		funcBuilder{parent: graph.Cluster, fieldName: "ClusterArn"}.build(),
+		funcBuilder{parent: graph.TaskDefinition, fieldName: "TaskDefinition", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: graph.TargetGroup, fieldName: "TargetGroupArn", listName: "LoadBalancers", relation: DEPENDING_ON}.build(),
+		fetchRoleFieldAndAddRelation("RoleArn"),
+	},
+	graph.TaskDefinition.String(): {
+		addRegionParent,
+		fetchRoleFieldAndAddRelation("TaskRoleArn"),
+	},
+	graph.Task.String(): {
+		funcBuilder{parent: graph.Cluster, fieldName: "ClusterArn"}.build(),
+		funcBuilder{parent: graph.TaskDefinition, fieldName: "TaskDefinitionArn", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: graph.ContainerInstance, fieldName: "ContainerInstanceArn", relation: DEPENDING_ON}.build(),
+	},
+	graph.ContainerInstance.String(): {
+		funcBuilder{parent: graph.Instance, fieldName: "Ec2InstanceId", relation: DEPENDING_ON}.build(),
+	},
+	// Encryption
+	graph.Key.String():      {addRegionParent},
+	graph.KeyAlias.String(): {keyAliasAddParent},
+	// Nosql
+	graph.Table.String(): {addRegionParent},
 }
 
 func (fb funcBuilder) build() addParentFn {
@@ -103,7 +209,7 @@ func (fb funcBuilder) build() addParentFn {
 }
 
 func (fb funcBuilder) addRelationWithField() addParentFn {
-	return func(g *graph.Graph, i interface{}) error {
+	return func(g *graph.Graph, srv cloud.Service, i interface{}) error {
 		structField, err := verifyValidStructField(i, fb.fieldName)
 		if err != nil {
 			return err
@@ -133,7 +239,7 @@ func (fb funcBuilder) addRelationWithField() addParentFn {
 }
 
 func (fb funcBuilder) addRelationListWithStringField() addParentFn {
-	return func(g *graph.Graph, i interface{}) error {
+	return func(g *graph.Graph, srv cloud.Service, i interface{}) error {
 		structField, err := verifyValidStructField(i, fb.stringListName)
 		if err != nil {
 			return err
@@ -171,7 +277,7 @@ func (fb funcBuilder) addRelationListWithStringField() addParentFn {
 }
 
 func (fb funcBuilder) addRelationListWithField() addParentFn {
-	return func(g *graph.Graph, i interface{}) error {
+	return func(g *graph.Graph, srv cloud.Service, i interface{}) error {
 		structField, err := verifyValidStructField(i, fb.listName)
 		if err != nil {
 			return err
@@ -252,7 +358,7 @@ func addRelation(g *graph.Graph, first, other *graph.Resource, relation int) err
 	return nil
 }
 
-func addRegionParent(g *graph.Graph, i interface{}) error {
+func addRegionParent(g *graph.Graph, srv cloud.Service, i interface{}) error {
 	resources, err := g.GetAllResources(graph.Region)
 	if err != nil {
 		return err
@@ -269,7 +375,7 @@ func addRegionParent(g *graph.Graph, i interface{}) error {
 	return nil
 }
 
-func addManagedPoliciesRelations(g *graph.Graph, i interface{}) error {
+func addManagedPoliciesRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
 	res, err := initResource(i)
 	if err != nil {
 		return err
@@ -308,7 +414,7 @@ func addManagedPoliciesRelations(g *graph.Graph, i interface{}) error {
 	return nil
 }
 
-func userAddGroupsRelations(g *graph.Graph, i interface{}) error {
+func userAddGroupsRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
 	user, ok := i.(*iam.UserDetail)
 	if !ok {
 		return fmt.Errorf("aws fetch: not a user, but a %T", i)
@@ -328,7 +434,7 @@ func userAddGroupsRelations(g *graph.Graph, i interface{}) error {
 	return nil
 }
 
-func fetchTargetsAndAddRelations(g *graph.Graph, i interface{}) error {
+func fetchTargetsAndAddRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
 	group, ok := i.(*elbv2.TargetGroup)
 	if !ok {
 		return fmt.Errorf("add targets relation: not a target group, but a %T", i)
@@ -338,7 +444,11 @@ func fetchTargetsAndAddRelations(g *graph.Graph, i interface{}) error {
 		return err
 	}
 
-	targets, err := InfraService.(*Infra).DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{TargetGroupArn: group.TargetGroupArn})
+	infra, ok := srv.(*Infra)
+	if !ok {
+		return fmt.Errorf("add targets relation: not fetched by infra, but by %T", srv)
+	}
+	targets, err := infra.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{TargetGroupArn: group.TargetGroupArn})
 	if err != nil {
 		return err
 	}
@@ -352,3 +462,433 @@ func fetchTargetsAndAddRelations(g *graph.Graph, i interface{}) error {
 	}
 	return nil
 }
+
+// classicLoadBalancerAddNetworkRelations adds a classic load balancer to its VPC,
+// or to its region when it runs in EC2-Classic
+func classicLoadBalancerAddNetworkRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	lb, ok := i.(*elb.LoadBalancerDescription)
+	if !ok {
+		return fmt.Errorf("aws fetch: not a classic load balancer, but a %T", i)
+	}
+	if awssdk.StringValue(lb.VPCId) == "" {
+		return addRegionParent(g, srv, i)
+	}
+	return funcBuilder{parent: graph.Vpc, fieldName: "VPCId"}.build()(g, srv, i)
+}
+
+func databaseAddNetworkRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	db, ok := i.(*rds.DBInstance)
+	if !ok {
+		return fmt.Errorf("aws fetch: not a database, but a %T", i)
+	}
+	if db.DBSubnetGroup == nil || awssdk.StringValue(db.DBSubnetGroup.VpcId) == "" {
+		return addRegionParent(g, srv, i)
+	}
+	n, err := initResource(db)
+	if err != nil {
+		return err
+	}
+
+	vpc, err := g.GetResource(graph.Vpc, awssdk.StringValue(db.DBSubnetGroup.VpcId))
+	if err != nil {
+		return err
+	}
+	g.AddParentRelation(vpc, n)
+
+	subnetGroup, err := g.GetResource(graph.DbSubnetGroup, awssdk.StringValue(db.DBSubnetGroup.DBSubnetGroupName))
+	if err != nil {
+		return err
+	}
+	g.AddAppliesOnRelation(subnetGroup, n)
+
+	for _, subnet := range db.DBSubnetGroup.Subnets {
+		if awssdk.StringValue(subnet.SubnetIdentifier) == "" {
+			continue
+		}
+		parent, err := g.GetResource(graph.Subnet, awssdk.StringValue(subnet.SubnetIdentifier))
+		if err != nil {
+			return err
+		}
+		g.AddAppliesOnRelation(n, parent)
+	}
+	return nil
+}
+
+func functionAddNetworkRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	function, ok := i.(*lambda.FunctionConfiguration)
+	if !ok {
+		return fmt.Errorf("aws fetch: not a function, but a %T", i)
+	}
+	vpcConfig := function.VpcConfig
+	if vpcConfig == nil || awssdk.StringValue(vpcConfig.VpcId) == "" {
+		return addRegionParent(g, srv, i)
+	}
+	n, err := initResource(function)
+	if err != nil {
+		return err
+	}
+
+	vpc, err := g.GetResource(graph.Vpc, awssdk.StringValue(vpcConfig.VpcId))
+	if err != nil {
+		return err
+	}
+	g.AddParentRelation(vpc, n)
+
+	for _, id := range vpcConfig.SubnetIds {
+		subnet, err := g.GetResource(graph.Subnet, awssdk.StringValue(id))
+		if err != nil {
+			return err
+		}
+		g.AddAppliesOnRelation(n, subnet)
+	}
+	for _, id := range vpcConfig.SecurityGroupIds {
+		group, err := g.GetResource(graph.SecurityGroup, awssdk.StringValue(id))
+		if err != nil {
+			return err
+		}
+		g.AddAppliesOnRelation(group, n)
+	}
+	return nil
+}
+
+// fetchFunctionRoleAndAddRelation resolves the execution role of a function,
+// referenced by ARN, to the role resource of the account of the function
+func fetchFunctionRoleAndAddRelation(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	function, ok := i.(*lambda.FunctionConfiguration)
+	if !ok {
+		return fmt.Errorf("aws fetch: not a function, but a %T", i)
+	}
+	n, err := initResource(function)
+	if err != nil {
+		return err
+	}
+	return addAccountRoleRelation(g, srv, awssdk.StringValue(function.Role), n)
+}
+
+// fetchRoleFieldAndAddRelation builds the relation to the role whose ARN is held by the given field
+func fetchRoleFieldAndAddRelation(fieldName string) addParentFn {
+	return func(g *graph.Graph, srv cloud.Service, i interface{}) error {
+		structField, err := verifyValidStructField(i, fieldName)
+		if err != nil {
+			return err
+		}
+		str, ok := structField.Interface().(*string)
+		if !ok {
+			return fmt.Errorf("add role to %s: %T not a string pointer", fieldName, structField.Interface())
+		}
+		n, err := initResource(i)
+		if err != nil {
+			return err
+		}
+		return addAccountRoleRelation(g, srv, awssdk.StringValue(str), n)
+	}
+}
+
+// addAccountRoleRelation resolves a role referenced by ARN, from the roles of the account
+// of the fetching service, to the role resource applying on the given resource
+func addAccountRoleRelation(g *graph.Graph, srv cloud.Service, roleArn string, n *graph.Resource) error {
+	if roleArn == "" {
+		return nil
+	}
+	roles, err := accountRolesGraph(srv)
+	if err != nil {
+		return err
+	}
+	found, err := roles.FindResourcesByProperty("Arn", roleArn)
+	if err != nil {
+		return err
+	}
+	// the role may have been deleted since
+	if len(found) == 0 {
+		return nil
+	}
+	return g.AddAppliesOnRelation(found[0], n)
+}
+
+// kmsKeyResource resolves a reference to a KMS key, given as key id, key ARN, alias name or alias ARN,
+// to the key or the alias resource
+func kmsKeyResource(g *graph.Graph, ref string) (*graph.Resource, error) {
+	// arn:aws:kms:REGION:ACCOUNT:key/ID or arn:aws:kms:REGION:ACCOUNT:alias/NAME
+	if strings.HasPrefix(ref, "arn:") {
+		splits := strings.SplitN(ref, ":", 6)
+		if len(splits) != 6 {
+			return nil, fmt.Errorf("aws fetch: invalid KMS key arn %s", ref)
+		}
+		ref = strings.TrimPrefix(splits[5], "key/")
+	}
+	if strings.HasPrefix(ref, "alias/") {
+		return g.GetResource(graph.KeyAlias, ref)
+	}
+	return g.GetResource(graph.Key, ref)
+}
+
+// addKeyRelation adds the relation of the KMS key encrypting a resource
+func addKeyRelation(g *graph.Graph, keyRef string, n *graph.Resource) error {
+	if keyRef == "" {
+		return nil
+	}
+	key, err := kmsKeyResource(g, keyRef)
+	if err != nil {
+		return err
+	}
+	g.AddAppliesOnRelation(key, n)
+	return nil
+}
+
+// addKeyFieldRelation builds the relation to the KMS key referenced by the given field
+func addKeyFieldRelation(fieldName string) addParentFn {
+	return func(g *graph.Graph, srv cloud.Service, i interface{}) error {
+		structField, err := verifyValidStructField(i, fieldName)
+		if err != nil {
+			return err
+		}
+		str, ok := structField.Interface().(*string)
+		if !ok {
+			return fmt.Errorf("add key to %s: %T not a string pointer", fieldName, structField.Interface())
+		}
+		n, err := initResource(i)
+		if err != nil {
+			return err
+		}
+		return addKeyRelation(g, awssdk.StringValue(str), n)
+	}
+}
+
+// bucketAddKeyRelation adds the relation of the KMS key encrypting by default the objects of a bucket
+func bucketAddKeyRelation(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	b, ok := i.(*s3.Bucket)
+	if !ok {
+		return fmt.Errorf("aws fetch: not a bucket, but a %T", i)
+	}
+	n, err := initResource(b)
+	if err != nil {
+		return err
+	}
+	api, err := storageAPI(srv)
+	if err != nil {
+		return err
+	}
+	key, err := getBucketEncryptionKey(api, b)
+	if err != nil {
+		return err
+	}
+	return addKeyRelation(g, key, n)
+}
+
+// keyAliasAddParent adds an alias to the key it targets, or to its region
+// for the aliases reserved by AWS whose key is not created yet
+func keyAliasAddParent(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	alias, ok := i.(*kms.AliasListEntry)
+	if !ok {
+		return fmt.Errorf("aws fetch: not a key alias, but a %T", i)
+	}
+	if !notEmpty(alias.TargetKeyId) {
+		return addRegionParent(g, srv, i)
+	}
+	return funcBuilder{parent: graph.Key, fieldName: "TargetKeyId"}.build()(g, srv, i)
+}
+
+// scalingGroupAddSubnetsRelations adds relations to the subnets of an autoscaling group,
+// given as a comma separated list
+func scalingGroupAddSubnetsRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	group, ok := i.(*autoscaling.Group)
+	if !ok {
+		return fmt.Errorf("aws fetch: not an autoscaling group, but a %T", i)
+	}
+	n, err := initResource(group)
+	if err != nil {
+		return err
+	}
+	for _, id := range strings.Split(awssdk.StringValue(group.VPCZoneIdentifier), ",") {
+		if id = strings.TrimSpace(id); id == "" {
+			continue
+		}
+		subnet, err := g.GetResource(graph.Subnet, id)
+		if err != nil {
+			return err
+		}
+		g.AddAppliesOnRelation(n, subnet)
+	}
+	return nil
+}
+
+// alarmAddDimensionsRelations adds relations to the instances, load balancers (classic or not), queues and tables
+// referenced by the dimensions of the metric of an alarm
+func alarmAddDimensionsRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	alarm, ok := i.(*cloudwatch.MetricAlarm)
+	if !ok {
+		return fmt.Errorf("aws fetch: not an alarm, but a %T", i)
+	}
+	n, err := initResource(alarm)
+	if err != nil {
+		return err
+	}
+	// arn:aws:cloudwatch:REGION:ACCOUNT:alarm:NAME
+	arn := strings.Split(awssdk.StringValue(alarm.AlarmArn), ":")
+	if len(arn) < 5 {
+		return fmt.Errorf("aws fetch: invalid arn for alarm %s", n.Id())
+	}
+	region, account := arn[3], arn[4]
+
+	for _, dim := range alarm.Dimensions {
+		value := awssdk.StringValue(dim.Value)
+		var res *graph.Resource
+		switch awssdk.StringValue(dim.Name) {
+		case "InstanceId":
+			res, err = g.GetResource(graph.Instance, value)
+		case "LoadBalancerName":
+			res, err = g.GetResource(graph.ClassicLoadBalancer, value)
+		case "LoadBalancer":
+			res, err = g.GetResource(graph.LoadBalancer, fmt.Sprintf("arn:aws:elasticloadbalancing:%s:%s:loadbalancer/%s", region, account, value))
+		case "QueueName":
+			res, err = g.GetResource(graph.Queue, fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", region, account, value))
+		case "TableName":
+			res, err = g.GetResource(graph.Table, value)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		g.AddAppliesOnRelation(n, res)
+	}
+	return nil
+}
+
+// alarmAddActionsRelations adds relations to the SNS topics notified by an alarm
+func alarmAddActionsRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	alarm, ok := i.(*cloudwatch.MetricAlarm)
+	if !ok {
+		return fmt.Errorf("aws fetch: not an alarm, but a %T", i)
+	}
+	n, err := initResource(alarm)
+	if err != nil {
+		return err
+	}
+	done := make(map[string]bool)
+	for _, actions := range [][]*string{alarm.AlarmActions, alarm.OKActions, alarm.InsufficientDataActions} {
+		for _, action := range actions {
+			arn := awssdk.StringValue(action)
+			if !strings.HasPrefix(arn, "arn:aws:sns:") || done[arn] {
+				continue
+			}
+			done[arn] = true
+			topic, err := g.GetResource(graph.Topic, arn)
+			if err != nil {
+				return err
+			}
+			g.AddAppliesOnRelation(n, topic)
+		}
+	}
+	return nil
+}
+
+// routeTableAddTargetsRelations adds relations to the internet gateways, NAT gateways
+// and instances the routes of a route table target
+func routeTableAddTargetsRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	table, ok := i.(*ec2.RouteTable)
+	if !ok {
+		return fmt.Errorf("aws fetch: not a route table, but a %T", i)
+	}
+	n, err := initResource(table)
+	if err != nil {
+		return err
+	}
+	for _, route := range table.Routes {
+		var target *graph.Resource
+		switch {
+		case strings.HasPrefix(awssdk.StringValue(route.GatewayId), "igw-"):
+			target, err = g.GetResource(graph.InternetGateway, awssdk.StringValue(route.GatewayId))
+		case awssdk.StringValue(route.NatGatewayId) != "":
+			target, err = g.GetResource(graph.NatGateway, awssdk.StringValue(route.NatGatewayId))
+		case awssdk.StringValue(route.InstanceId) != "":
+			target, err = g.GetResource(graph.Instance, awssdk.StringValue(route.InstanceId))
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		g.AddAppliesOnRelation(n, target)
+	}
+	return nil
+}
+
+// imageAddSnapshotsRelations adds relations to the EBS snapshots of the block devices of an image
+func imageAddSnapshotsRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	image, ok := i.(*ec2.Image)
+	if !ok {
+		return fmt.Errorf("aws fetch: not an image, but a %T", i)
+	}
+	n, err := initResource(image)
+	if err != nil {
+		return err
+	}
+	for _, mapping := range image.BlockDeviceMappings {
+		if mapping.Ebs == nil || awssdk.StringValue(mapping.Ebs.SnapshotId) == "" {
+			continue
+		}
+		snapshot, err := g.GetResource(graph.Snapshot, awssdk.StringValue(mapping.Ebs.SnapshotId))
+		if err != nil {
+			return err
+		}
+		g.AddAppliesOnRelation(n, snapshot)
+	}
+	return nil
+}
+
+// stackOwnedTypes are the types of the resources of a stack whose physical id is their id in the graph
+var stackOwnedTypes = map[string]graph.ResourceType{
+	"AWS::EC2::Instance":                        graph.Instance,
+	"AWS::EC2::VPC":                             graph.Vpc,
+	"AWS::EC2::Subnet":                          graph.Subnet,
+	"AWS::EC2::SecurityGroup":                   graph.SecurityGroup,
+	"AWS::EC2::Volume":                          graph.Volume,
+	"AWS::EC2::InternetGateway":                 graph.InternetGateway,
+	"AWS::EC2::RouteTable":                      graph.RouteTable,
+	"AWS::EC2::NatGateway":                      graph.NatGateway,
+	"AWS::ElasticLoadBalancing::LoadBalancer":   graph.ClassicLoadBalancer,
+	"AWS::ElasticLoadBalancingV2::LoadBalancer": graph.LoadBalancer,
+	"AWS::ElasticLoadBalancingV2::TargetGroup":  graph.TargetGroup,
+	"AWS::ElasticLoadBalancingV2::Listener":     graph.Listener,
+	"AWS::S3::Bucket":                           graph.Bucket,
+	"AWS::SNS::Topic":                           graph.Topic,
+	"AWS::SQS::Queue":                           graph.Queue,
+	"AWS::RDS::DBInstance":                      graph.Database,
+	"AWS::AutoScaling::AutoScalingGroup":        graph.ScalingGroup,
+	"AWS::AutoScaling::LaunchConfiguration":     graph.LaunchConfiguration,
+	"AWS::CloudWatch::Alarm":                    graph.Alarm,
+	"AWS::CloudFormation::Stack":                graph.Stack,
+	"AWS::ECS::Service":                         graph.ContainerService,
+	"AWS::ECS::TaskDefinition":                  graph.TaskDefinition,
+	"AWS::DynamoDB::Table":                      graph.Table,
+	"AWS::KMS::Key":                             graph.Key,
+}
+
+// stackAddResourcesRelations adds relations to the resources created by a stack
+func stackAddResourcesRelations(g *graph.Graph, srv cloud.Service, i interface{}) error {
+	stack, ok := i.(*cloudformation.Stack)
+	if !ok {
+		return fmt.Errorf("aws fetch: not a stack, but a %T", i)
+	}
+	n, err := initResource(stack)
+	if err != nil {
+		return err
+	}
+	resources, err := listStackResources(srv, stack)
+	if err != nil {
+		return err
+	}
+	for _, r := range resources {
+		typ, ok := stackOwnedTypes[awssdk.StringValue(r.ResourceType)]
+		if !ok || !notEmpty(r.PhysicalResourceId) {
+			continue
+		}
+		res, err := g.GetResource(typ, awssdk.StringValue(r.PhysicalResourceId))
+		if err != nil {
+			return err
+		}
+		g.AddAppliesOnRelation(n, res)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"sync"
+
+	awsdriver "github.com/wallix/awless/aws/driver"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	tpldriver "github.com/wallix/awless/template/driver"
+)
+
+// Driver simulates the AWS drivers by mutating an in-memory graph of resources.
+// Dry runs are played against a scratch copy of the graph.
+type Driver struct {
+	mu      sync.Mutex
+	g       *graph.Graph
+	scratch *graph.Graph
+	dryRun  bool
+	region  string
+	logger  *logger.Logger
+}
+
+func NewDriver(g *graph.Graph, region string) *Driver {
+	if g == nil {
+		g = graph.NewGraph()
+	}
+	reg := graph.InitResource(region, graph.Region)
+	if found, _ := g.FindResource(region); found == nil {
+		g.AddResource(reg)
+	}
+	return &Driver{g: g, region: region, logger: logger.DiscardLogger}
+}
+
+// Graph returns the simulated resources
+func (d *Driver) Graph() *graph.Graph {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.g
+}
+
+func (d *Driver) SetDryRun(dry bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	// every service driver forwards this call to the shared driver:
+	// only the first one snapshots the graph
+	if dry == d.dryRun {
+		return
+	}
+	d.dryRun = dry
+	if dry {
+		d.scratch = graph.NewGraph()
+		d.scratch.AddGraph(d.g)
+	} else {
+		d.scratch = nil
+	}
+}
+
+func (d *Driver) SetLogger(l *logger.Logger) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.logger = l
+}
+
+func (d *Driver) Lookup(lookups ...string) (tpldriver.DriverFn, error) {
+	if len(lookups) < 2 {
+		return nil, tpldriver.ErrDriverFnNotFound
+	}
+	action, entity := lookups[0], lookups[1]
+	if _, ok := awsdriver.AWSTemplatesDefinitions[action+entity]; !ok {
+		return nil, tpldriver.ErrDriverFnNotFound
+	}
+
+	def, ok := entities[entity]
+	if !ok {
+		def = genericEntity(entity)
+	}
+
+	return func(in map[string]interface{}) (interface{}, error) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		params := make(map[string]interface{})
+		for k, v := range in {
+			params[k] = v
+		}
+
+		s := &simulation{g: d.g, region: d.region, def: def, entity: entity}
+		if d.dryRun {
+			s.g = d.scratch
+		}
+
+		if check, ok := def.checks[action]; ok {
+			if err := check(s, params); err != nil {
+				err = fmt.Errorf("%s %s: %s", action, entity, err)
+				d.logger.Errorf("simulation: %s", err)
+				return nil, err
+			}
+		}
+
+		var result interface{}
+		var err error
+		switch action {
+		case "create":
+			result, err = s.create(params)
+		case "delete":
+			result, err = s.delete(params)
+		case "attach":
+			result, err = s.attach(params)
+		case "detach":
+			result, err = s.detach(params)
+		case "start", "stop":
+			result, err = s.setState(params, action)
+		case "check":
+			result, err = s.check(params)
+		default:
+			if fn, ok := def.actions[action]; ok {
+				result, err = fn(s, params)
+			} else {
+				result, err = s.update(params)
+			}
+		}
+		if err != nil {
+			err = fmt.Errorf("%s %s: %s", action, entity, err)
+			d.logger.Errorf("simulation: %s", err)
+			return nil, err
+		}
+
+		if d.dryRun {
+			d.logger.Verbosef("full dry run: simulated %s %s ok", action, entity)
+		} else {
+			d.logger.Verbosef("simulated %s %s '%v' done", action, entity, result)
+		}
+
+		return result, nil
+	}, nil
+}
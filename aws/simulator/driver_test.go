@@ -0,0 +1,827 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver"
+)
+
+func TestSimulateInfraTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	tpl := template.MustParse(`vpc = create vpc cidr=10.0.0.0/16
+sub = create subnet vpc=$vpc cidr=10.0.1.0/24
+inst = create instance subnet=$sub image=ami-123456 type=t2.micro count=1
+igw = create internetgateway
+attach internetgateway id=$igw vpc=$vpc`)
+
+	if _, err := tpl.Compile(d); err != nil {
+		t.Fatal(err)
+	}
+	if vpcs, _ := d.Graph().GetAllResources(graph.Vpc); len(vpcs) != 0 {
+		t.Fatalf("dry run: got %d vpcs, want none", len(vpcs))
+	}
+
+	ran, err := tpl.Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := make(map[string]string)
+	for _, cmd := range ran.CommandNodesIterator() {
+		if id, ok := cmd.CmdResult.(string); ok {
+			results[cmd.Entity] = id
+		}
+	}
+
+	ids := []struct{ entity, pattern string }{
+		{"vpc", `^vpc-[0-9a-f]{8}$`},
+		{"subnet", `^subnet-[0-9a-f]{8}$`},
+		{"instance", `^i-[0-9a-f]{17}$`},
+		{"internetgateway", `^igw-[0-9a-f]{8}$`},
+	}
+	for _, id := range ids {
+		if got := results[id.entity]; !regexp.MustCompile(id.pattern).MatchString(got) {
+			t.Fatalf("%s: got id %s, want matching %s", id.entity, got, id.pattern)
+		}
+	}
+
+	g := d.Graph()
+	var parents []*graph.Resource
+	inst, _ := g.GetResource(graph.Instance, results["instance"])
+	if err := g.Accept(&graph.ParentsVisitor{From: inst, Each: graph.VisitorCollectFunc(&parents)}); err != nil {
+		t.Fatal(err)
+	}
+	var parentIds []string
+	for _, p := range parents {
+		parentIds = append(parentIds, p.Id())
+	}
+	if got, want := strings.Join(parentIds, ","), strings.Join([]string{results["subnet"], results["vpc"], "eu-west-1"}, ","); got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if ip := inst.Properties["PrivateIp"]; !strings.HasPrefix(ip.(string), "10.0.1.") {
+		t.Fatalf("got ip %v, want within 10.0.1.0/24", ip)
+	}
+	if got, want := inst.Properties["VpcId"], results["vpc"]; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	vpc, _ := g.GetResource(graph.Vpc, results["vpc"])
+	attached, err := g.ListResourcesDependingOn(vpc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attached) != 1 || attached[0].Id() != results["internetgateway"] {
+		t.Fatalf("got %v, want internet gateway attached on vpc", attached)
+	}
+
+	tcases := []struct {
+		tpl    string
+		expErr string
+	}{
+		{tpl: "create subnet vpc=" + results["vpc"] + " cidr=10.1.0.0/24", expErr: "not within"},
+		{tpl: "create subnet vpc=" + results["vpc"] + " cidr=10.0.1.128/25", expErr: "conflicts"},
+		{tpl: "create subnet vpc=vpc-unknown cidr=10.0.2.0/24", expErr: "not found"},
+		{tpl: "create vpc cidr=10.0.0.0/8", expErr: "netmask"},
+		{tpl: "create instance subnet=" + results["subnet"] + " image=ami-123456 type=t2.micro count=1 ip=10.0.2.4", expErr: "not within"},
+		{tpl: "delete vpc id=" + results["vpc"], expErr: "DependencyViolation"},
+		{tpl: "delete subnet id=" + results["subnet"], expErr: "DependencyViolation"},
+		{tpl: "attach internetgateway id=" + results["internetgateway"] + " vpc=" + results["vpc"], expErr: "AlreadyAssociated"},
+	}
+	for i, tcase := range tcases {
+		_, err := template.MustParse(tcase.tpl).Run(d)
+		if err == nil {
+			t.Fatalf("%d: expected error, got none", i+1)
+		}
+		if !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got '%s', want containing '%s'", i+1, err, tcase.expErr)
+		}
+	}
+
+	teardown := template.MustParse("delete instance id=" + results["instance"] + "\ncheck instance id=" + results["instance"] + " state=terminated timeout=180" +
+		"\ndelete subnet id=" + results["subnet"] + "\ndetach internetgateway id=" + results["internetgateway"] + " vpc=" + results["vpc"] +
+		"\ndelete internetgateway id=" + results["internetgateway"] + "\ndelete vpc id=" + results["vpc"])
+	if _, err := teardown.Run(d); err != nil {
+		t.Fatal(err)
+	}
+	for _, typ := range []graph.ResourceType{graph.Vpc, graph.Subnet, graph.Instance, graph.InternetGateway} {
+		if all, _ := g.GetAllResources(typ); len(all) != 0 {
+			t.Fatalf("%s: got %d resources, want none", typ, len(all))
+		}
+	}
+}
+
+func TestSimulateAccessTemplate(t *testing.T) {
+	d := NewDriver(nil, "us-east-1")
+
+	tpl := template.MustParse(`create user name=john
+create group name=admins
+attach user name=john group=admins
+attach policy arn=arn:aws:iam::aws:policy/AdministratorAccess group=admins
+create bucket name=my-bucket`)
+	if _, err := tpl.Run(d); err != nil {
+		t.Fatal(err)
+	}
+
+	g := d.Graph()
+	groups, _ := g.FindResourcesByProperty("Name", "admins")
+	if len(groups) != 1 {
+		t.Fatalf("got %d groups, want 1", len(groups))
+	}
+	if !regexp.MustCompile(`^AGPA[A-Z2-7]{17}$`).MatchString(groups[0].Id()) {
+		t.Fatalf("got group id %s", groups[0].Id())
+	}
+	appliesOn, _ := g.ListResourcesAppliedOn(groups[0])
+	if len(appliesOn) != 1 || appliesOn[0].Properties["Name"] != "john" {
+		t.Fatalf("got %v, want group applying on user john", appliesOn)
+	}
+	policies, _ := g.GetAllResources(graph.Policy)
+	if len(policies) != 1 {
+		t.Fatalf("got %d policies, want 1", len(policies))
+	}
+
+	if _, err := template.MustParse("create bucket name=my-bucket").Run(d); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("got %v, want already exists error", err)
+	}
+	if _, err := template.MustParse("detach user name=john group=admins\ndelete group name=admins\ndelete user name=john").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	if users, _ := g.GetAllResources(graph.User); len(users) != 0 {
+		t.Fatalf("got %d users, want none", len(users))
+	}
+}
+
+func TestSimulateDatabaseTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	if _, err := template.MustParse("create database id=mydb type=db.t2.micro engine=postgres username=admin password=secret size=5\nstop database id=mydb").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	db, _ := d.Graph().GetResource(graph.Database, "mydb")
+	if got, want := db.Properties["State"], "stopped"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+
+	if _, err := template.MustParse("start database id=mydb").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	db, _ = d.Graph().GetResource(graph.Database, "mydb")
+	if got, want := db.Properties["State"], "available"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+
+	if _, err := template.MustParse("delete database id=mydb skipsnapshot=true").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	if all, _ := d.Graph().GetAllResources(graph.Database); len(all) != 0 {
+		t.Fatalf("got %d databases, want none", len(all))
+	}
+}
+
+func TestSimulateDnsTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	ran, err := template.MustParse("create record zone=Z1 name=www.example.com type=A value=1.2.3.4 ttl=300\nupdate record zone=Z1 name=www.example.com type=A value=5.6.7.8 ttl=60").Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cmd := range ran.CommandNodesIterator() {
+		if id, ok := cmd.CmdResult.(string); !ok || !regexp.MustCompile(`^/change/C[0-9A-F]{13}$`).MatchString(id) {
+			t.Fatalf("got change id %v", cmd.CmdResult)
+		}
+	}
+	records, _ := d.Graph().GetAllResources(graph.Record)
+	if len(records) != 1 {
+		t.Fatalf("got %d records, want 1", len(records))
+	}
+	if got, want := records[0].Properties["Records"], "5.6.7.8"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+
+	if _, err := template.MustParse("create record zone=Z1 name=www.example.com type=A value=1.2.3.4 ttl=300").Run(d); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("got %v, want already exists error", err)
+	}
+	if _, err := template.MustParse("delete record zone=Z1 name=www.example.com type=A value=5.6.7.8 ttl=60\ncheck record id=/change/C1 timeout=60").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	if records, _ := d.Graph().GetAllResources(graph.Record); len(records) != 0 {
+		t.Fatalf("got %d records, want none", len(records))
+	}
+}
+
+func TestSimulateAutoscalingTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	tpl := template.MustParse(`vpc = create vpc cidr=10.0.0.0/16
+sub = create subnet vpc=$vpc cidr=10.0.1.0/24
+create launchconfiguration name=lc image=ami-123456 type=t2.micro
+create scalinggroup name=asg launchconfiguration=lc minsize=1 maxsize=3 subnets=$sub`)
+	if _, err := tpl.Run(d); err != nil {
+		t.Fatal(err)
+	}
+	asg, _ := d.Graph().GetResource(graph.ScalingGroup, "asg")
+	if got, want := fmt.Sprint(asg.Properties["DesiredCapacity"]), "1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	ran, err := template.MustParse("update scalinggroup name=asg desiredcapacity=2\ncheck scalinggroup id=asg count=2 timeout=60").Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ran.CommandNodesIterator()[0].CmdResult, "desiredcapacity=1"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+
+	tcases := []struct {
+		tpl    string
+		expErr string
+	}{
+		{tpl: "update scalinggroup name=asg desiredcapacity=4", expErr: "between"},
+		{tpl: "create scalinggroup name=other launchconfiguration=lc minsize=2 maxsize=3 desiredcapacity=1 subnets=subnet-12345678", expErr: "between"},
+		{tpl: "delete launchconfiguration id=lc", expErr: "ResourceInUse"},
+	}
+	for i, tcase := range tcases {
+		_, err := template.MustParse(tcase.tpl).Run(d)
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want error containing '%s'", i+1, err, tcase.expErr)
+		}
+	}
+
+	if _, err := template.MustParse("delete scalinggroup id=asg force=true\ncheck scalinggroup id=asg count=0 timeout=60\ndelete launchconfiguration id=lc").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	if all, _ := d.Graph().GetAllResources(graph.LaunchConfiguration); len(all) != 0 {
+		t.Fatalf("got %d launch configurations, want none", len(all))
+	}
+}
+
+func TestSimulateMonitoringTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	tpl := template.MustParse(`topic = create topic name=alerts
+create alarm name=cpu-high metric=CPUUtilization namespace=AWS/EC2 statistic=Average operator=GreaterThanThreshold threshold=80 period=300 evaluationperiods=2 dimensions=InstanceId:i-12345678 alarmactions=$topic`)
+	ran, err := tpl.Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ran.CommandNodesIterator()[1].CmdResult, "cpu-high"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+	alarm, _ := d.Graph().GetResource(graph.Alarm, "cpu-high")
+	applied, err := d.Graph().ListResourcesAppliedOn(alarm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, res := range applied {
+		ids = append(ids, res.Id())
+	}
+	sort.Strings(ids)
+	if got, want := ids, []string{"arn:aws:sns:eu-west-1:123456789012:alerts", "i-12345678"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	tcases := []struct {
+		tpl    string
+		expErr string
+	}{
+		{tpl: "create alarm name=cpu-high metric=CPUUtilization namespace=AWS/EC2 statistic=Average operator=GreaterThanThreshold threshold=80 period=300 evaluationperiods=2", expErr: "already exists"},
+		{tpl: "create alarm name=other metric=CPUUtilization namespace=AWS/EC2 statistic=Average operator=GreaterThanThreshold threshold=80 period=300 evaluationperiods=2 dimensions=InstanceId", expErr: "invalid dimension"},
+	}
+	for i, tcase := range tcases {
+		_, err := template.MustParse(tcase.tpl).Run(d)
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want error containing '%s'", i+1, err, tcase.expErr)
+		}
+	}
+
+	if _, err := template.MustParse("delete alarm id=cpu-high").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	if all, _ := d.Graph().GetAllResources(graph.Alarm); len(all) != 0 {
+		t.Fatalf("got %d alarms, want none", len(all))
+	}
+}
+
+func TestSimulateNatGatewayTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	tpl := template.MustParse(`vpc = create vpc cidr=10.0.0.0/16
+pub = create subnet vpc=$vpc cidr=10.0.1.0/24
+eip = create elasticip domain=vpc
+nat = create natgateway elasticip=$eip subnet=$pub
+table = create routetable vpc=$vpc
+create route table=$table cidr=0.0.0.0/0 natgateway=$nat
+inst = create instance subnet=$pub image=ami-123456 type=t2.micro count=1
+other = create elasticip domain=vpc
+attach elasticip id=$other instance=$inst`)
+	ran, err := tpl.Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds := ran.CommandNodesIterator()
+	eip, nat, other, assoc := fmt.Sprint(cmds[2].CmdResult), fmt.Sprint(cmds[3].CmdResult), fmt.Sprint(cmds[7].CmdResult), fmt.Sprint(cmds[8].CmdResult)
+	if !strings.HasPrefix(nat, "nat-") || !strings.HasPrefix(eip, "eipalloc-") || !strings.HasPrefix(assoc, "eipassoc-") {
+		t.Fatalf("got natgateway %s, elasticip %s, association %s", nat, eip, assoc)
+	}
+
+	tcases := []struct {
+		tpl    string
+		expErr string
+	}{
+		{tpl: "delete elasticip id=" + eip, expErr: "InUse"},
+		{tpl: "create natgateway elasticip=" + eip + " subnet=" + fmt.Sprint(cmds[1].CmdResult), expErr: "AlreadyAssociated"},
+		{tpl: "attach elasticip id=" + other + " instance=" + fmt.Sprint(cmds[6].CmdResult), expErr: "AlreadyAssociated"},
+		{tpl: "create route table=" + fmt.Sprint(cmds[4].CmdResult) + " cidr=10.1.0.0/16 natgateway=nat-12345678", expErr: "not found"},
+	}
+	for i, tcase := range tcases {
+		_, err := template.MustParse(tcase.tpl).Run(d)
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want error containing '%s'", i+1, err, tcase.expErr)
+		}
+	}
+
+	if _, err := template.MustParse(fmt.Sprintf("detach elasticip association=%s\ndelete elasticip id=%s\ndelete natgateway id=%s\ncheck natgateway id=%s state=deleted timeout=180\ndelete elasticip id=%s", assoc, other, nat, nat, eip)).Run(d); err != nil {
+		t.Fatal(err)
+	}
+	if all, _ := d.Graph().GetAllResources(graph.ElasticIP); len(all) != 0 {
+		t.Fatalf("got %d elastic IPs, want none", len(all))
+	}
+}
+
+func TestSimulateImageTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	tpl := template.MustParse(`vpc = create vpc cidr=10.0.0.0/16
+sub = create subnet vpc=$vpc cidr=10.0.1.0/24
+inst = create instance subnet=$sub image=ami-123456 type=t2.micro count=1
+vol = create volume zone=eu-west-1a size=10
+snap = create snapshot volume=$vol description=backup
+img = create image instance=$inst name=myimage
+copy image id=$img sourceregion=eu-west-1 name=mycopy`)
+	ran, err := tpl.Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds := ran.CommandNodesIterator()
+	snap, img, copied := fmt.Sprint(cmds[4].CmdResult), fmt.Sprint(cmds[5].CmdResult), fmt.Sprint(cmds[6].CmdResult)
+	if !strings.HasPrefix(snap, "snap-") || !strings.HasPrefix(img, "ami-") || !strings.HasPrefix(copied, "ami-") || img == copied {
+		t.Fatalf("got snapshot %s, image %s, copy %s", snap, img, copied)
+	}
+	if all, _ := d.Graph().GetAllResources(graph.Snapshot); len(all) != 3 {
+		t.Fatalf("got %d snapshots, want 3", len(all))
+	}
+
+	image, _ := d.Graph().GetResource(graph.Image, img)
+	imageSnaps, err := d.Graph().ListResourcesAppliedOn(image)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(imageSnaps), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	tcases := []struct {
+		tpl    string
+		expErr string
+	}{
+		{tpl: "delete snapshot id=" + imageSnaps[0].Id(), expErr: "InUse"},
+		{tpl: "create image instance=i-12345678 name=other", expErr: "not found"},
+		{tpl: "copy image id=ami-12345678 sourceregion=eu-west-1 name=other", expErr: "not found"},
+		{tpl: "create snapshot volume=vol-12345678", expErr: "not found"},
+	}
+	for i, tcase := range tcases {
+		_, err := template.MustParse(tcase.tpl).Run(d)
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want error containing '%s'", i+1, err, tcase.expErr)
+		}
+	}
+
+	if _, err := template.MustParse(fmt.Sprintf("delete image id=%s\ndelete image id=%s\ndelete snapshot id=%s", copied, img, snap)).Run(d); err != nil {
+		t.Fatal(err)
+	}
+	if all, _ := d.Graph().GetAllResources(graph.Snapshot); len(all) != 0 {
+		t.Fatalf("got %d snapshots, want none", len(all))
+	}
+	if all, _ := d.Graph().GetAllResources(graph.Image); len(all) != 0 {
+		t.Fatalf("got %d images, want none", len(all))
+	}
+}
+
+func TestSimulateIamTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	tpl := template.MustParse(`role = create role name=myrole principal=ec2.amazonaws.com
+profile = create instanceprofile name=myprofile
+attach role name=myrole instanceprofile=$profile
+pol = create policy name=mypolicy document=./policy.json
+attach policy arn=$pol role=myrole
+user = create user name=bob
+key = create accesskey user=bob`)
+	ran, err := tpl.Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds := ran.CommandNodesIterator()
+	role, profile, pol, key := fmt.Sprint(cmds[0].CmdResult), fmt.Sprint(cmds[1].CmdResult), fmt.Sprint(cmds[3].CmdResult), fmt.Sprint(cmds[6].CmdResult)
+	if got, want := role, "arn:aws:iam::123456789012:role/myrole"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := profile, "myprofile"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := pol, "arn:aws:iam::123456789012:policy/mypolicy"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if !strings.HasPrefix(key, "AKIA") {
+		t.Fatalf("got access key %s", key)
+	}
+
+	tcases := []struct {
+		tpl    string
+		expErr string
+	}{
+		{tpl: "create role name=other", expErr: "principal, trustpolicy"},
+		{tpl: "create role name=other principal=lambda.amazonaws.com\nattach role name=other instanceprofile=myprofile", expErr: "LimitExceeded"},
+		{tpl: "delete role name=myrole", expErr: "DeleteConflict"},
+		{tpl: "delete instanceprofile name=myprofile", expErr: "DeleteConflict"},
+		{tpl: "delete policy arn=" + pol, expErr: "DeleteConflict"},
+		{tpl: "delete user name=bob", expErr: "DependencyViolation"},
+	}
+	for i, tcase := range tcases {
+		_, err := template.MustParse(tcase.tpl).Run(d)
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want error containing '%s'", i+1, err, tcase.expErr)
+		}
+	}
+
+	if _, err := template.MustParse(fmt.Sprintf("delete accesskey id=%s user=bob\ndelete user name=bob\ndetach policy arn=%s role=myrole\ndelete policy arn=%s\ndetach role name=myrole instanceprofile=myprofile\ndelete instanceprofile name=myprofile\ndelete role name=myrole\ndelete role name=other", key, pol, pol)).Run(d); err != nil {
+		t.Fatal(err)
+	}
+	for _, typ := range []graph.ResourceType{graph.Role, graph.InstanceProfile, graph.Policy, graph.AccessKey} {
+		if all, _ := d.Graph().GetAllResources(typ); len(all) != 0 {
+			t.Fatalf("got %d %s, want none", len(all), typ)
+		}
+	}
+}
+
+func TestSimulateUpdateBucketTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	if _, err := template.MustParse("create bucket name=mybucket\nupdate bucket name=mybucket acl=private versioning=enabled policy=./policy.json").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	bucket, err := d.Graph().GetResource(graph.Bucket, "mybucket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := bucket.Properties["Versioning"], "Enabled"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := bucket.Properties["Public"], false; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := bucket.Properties["Policy"], "./policy.json"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if _, err := template.MustParse("update bucket name=mybucket policy=none encryption=kms publicaccessblock=true").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	bucket, _ = d.Graph().GetResource(graph.Bucket, "mybucket")
+	if _, ok := bucket.Properties["Policy"]; ok {
+		t.Fatalf("got policy %v, want none", bucket.Properties["Policy"])
+	}
+	if got, want := bucket.Properties["Encryption"], "aws:kms"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := len(bucket.Properties["PublicAccessBlock"].([]interface{})), 4; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	if _, err := template.MustParse("update bucket name=unknown versioning=enabled").Run(d); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("got %v, want bucket not found", err)
+	}
+}
+
+func TestSimulateStackTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	tpl := template.MustParse(`stack = create stack name=web templatefile=./web.json parameters=InstanceType:t2.micro capabilities=CAPABILITY_IAM
+check stack id=$stack state=CREATE_COMPLETE timeout=600
+update stack id=$stack parameters=InstanceType:t2.large
+check stack id=$stack state=UPDATE_COMPLETE timeout=600`)
+	ran, err := tpl.Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := fmt.Sprint(ran.CommandNodesIterator()[0].CmdResult)
+	if !regexp.MustCompile(`^arn:aws:cloudformation:eu-west-1:123456789012:stack/web/[0-9a-f-]{36}$`).MatchString(id) {
+		t.Fatalf("got stack id %s", id)
+	}
+	if got, want := ran.CommandNodesIterator()[2].CmdResult, id; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+	stack, err := d.Graph().GetResource(graph.Stack, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := stack.Properties["Parameters"], "InstanceType:t2.large"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+
+	tcases := []struct {
+		tpl    string
+		expErr string
+	}{
+		{tpl: "create stack name=web templatefile=./web.json", expErr: "already exists"},
+		{tpl: "update stack id=unknown", expErr: "does not exist"},
+		{tpl: "check stack id=" + id + " state=DELETE_COMPLETE timeout=600", expErr: "expected 'DELETE_COMPLETE'"},
+	}
+	for i, tcase := range tcases {
+		_, err := template.MustParse(tcase.tpl).Run(d)
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want error containing '%s'", i+1, err, tcase.expErr)
+		}
+	}
+
+	if _, err := template.MustParse(fmt.Sprintf("delete stack id=%s\ncheck stack id=%s state=DELETE_COMPLETE timeout=600", id, id)).Run(d); err != nil {
+		t.Fatal(err)
+	}
+	if all, _ := d.Graph().GetAllResources(graph.Stack); len(all) != 0 {
+		t.Fatalf("got %d stacks, want none", len(all))
+	}
+}
+
+func TestSimulateClassicLoadBalancerTemplate(t *testing.T) {
+	g := graph.NewGraph()
+	lb := graph.InitResource("legacy-web", graph.ClassicLoadBalancer)
+	g.AddResource(lb, graph.InitResource("i-1234", graph.Instance), graph.InitResource("i-5678", graph.Instance))
+	d := NewDriver(g, "eu-west-1")
+
+	tpl := template.MustParse(`attach classicloadbalancer name=legacy-web instances=i-1234,i-5678
+detach classicloadbalancer name=legacy-web instances=i-5678`)
+	if _, err := tpl.Run(d); err != nil {
+		t.Fatal(err)
+	}
+	appliesOn, err := d.Graph().ListResourcesAppliedOn(lb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var instances []string
+	for _, r := range appliesOn {
+		instances = append(instances, r.Id())
+	}
+	if got, want := instances, []string{"i-1234"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if _, err := template.MustParse("attach classicloadbalancer name=unknown instances=i-1234").Run(d); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("got %v, want not found error", err)
+	}
+}
+
+func TestSimulateTableTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	tpl := template.MustParse(`table = create table name=events hashkey=id:S rangekey=timestamp:N readcapacity=5 writecapacity=5 streamview=NEW_IMAGE
+check table id=$table state=ACTIVE timeout=300
+update table id=$table readcapacity=10 writecapacity=20`)
+	ran, err := tpl.Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ran.CommandNodesIterator()[0].CmdResult, "events"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+	table, err := d.Graph().GetResource(graph.Table, "events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(table.Properties["ReadCapacity"]), "10"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(table.Properties["WriteCapacity"]), "20"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	if _, err := template.MustParse("create table name=events hashkey=id:S readcapacity=1 writecapacity=1").Run(d); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("got %v, want already exists error", err)
+	}
+
+	if _, err := template.MustParse("delete table id=events\ncheck table id=events state=not-found timeout=300").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	if all, _ := d.Graph().GetAllResources(graph.Table); len(all) != 0 {
+		t.Fatalf("got %d tables, want none", len(all))
+	}
+}
+
+func TestSimulateKeyTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	tpl := template.MustParse(`key = create key description=backups
+create keyalias name=alias/backups key=$key`)
+	ran, err := tpl.Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds := ran.CommandNodesIterator()
+	key := fmt.Sprint(cmds[0].CmdResult)
+	if !regexp.MustCompile(`^[0-9a-f-]{36}$`).MatchString(key) {
+		t.Fatalf("got key id %s", key)
+	}
+	if got, want := cmds[1].CmdResult, "alias/backups"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+	alias, err := d.Graph().GetResource(graph.KeyAlias, "alias/backups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parents []*graph.Resource
+	if err := d.Graph().Accept(&graph.ParentsVisitor{From: alias, Each: graph.VisitorCollectFunc(&parents)}); err != nil {
+		t.Fatal(err)
+	}
+	if len(parents) == 0 || parents[0].Id() != key {
+		t.Fatalf("got parents %v, want key %s first", parents, key)
+	}
+
+	if _, err := template.MustParse("create keyalias name=alias/backups key=" + key).Run(d); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("got %v, want already exists error", err)
+	}
+
+	if _, err := template.MustParse("delete key id=" + key + " pendingdays=3").Run(d); err == nil || !strings.Contains(err.Error(), "between 7 and 30") {
+		t.Fatalf("got %v, want pending window error", err)
+	}
+	if _, err := template.MustParse("delete keyalias name=alias/backups\ndelete key id=" + key + " pendingdays=7").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	res, err := d.Graph().GetResource(graph.Key, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res.Properties["State"], "PendingDeletion"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+	if all, _ := d.Graph().GetAllResources(graph.KeyAlias); len(all) != 0 {
+		t.Fatalf("got %d aliases, want none", len(all))
+	}
+}
+
+func TestSimulateMessagingTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	tpl := template.MustParse(`topic = create topic name=ops
+queue = create queue name=jobs
+dlq = create queue name=jobs-failed
+update queue url=$queue visibilityTimeout=120 deadletterqueue=$dlq maxreceive=3
+send queue url=$queue message="resize image-1.png"
+send queue url=$queue message="resize image-2.png"
+receive queue url=$queue max=1 delete=true
+publish topic arn=$topic message="jobs queued" subject=jobs`)
+	ran, err := tpl.Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds := ran.CommandNodesIterator()
+	for _, i := range []int{4, 5, 7} {
+		if id := fmt.Sprint(cmds[i].CmdResult); !regexp.MustCompile(`^[0-9a-f-]{36}$`).MatchString(id) {
+			t.Fatalf("%d: got message id %s", i+1, id)
+		}
+	}
+	queue, err := d.Graph().GetResource(graph.Queue, "https://sqs.eu-west-1.amazonaws.com/123456789012/jobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := queue.Properties["ApproximateNumberOfMessages"], "1"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(queue.Properties["VisibilityTimeout"]), "120"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	if _, err := template.MustParse("purge queue url=https://sqs.eu-west-1.amazonaws.com/123456789012/jobs").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	if queue, err = d.Graph().GetResource(graph.Queue, "https://sqs.eu-west-1.amazonaws.com/123456789012/jobs"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := queue.Properties["ApproximateNumberOfMessages"], "0"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+
+	if _, err := template.MustParse("send queue url=https://sqs.eu-west-1.amazonaws.com/123456789012/unknown message=hello").Run(d); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("got %v, want not found error", err)
+	}
+}
+
+func TestSimulateContainerTemplate(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+
+	tpl := template.MustParse(`create service cluster=web name=nginx taskdefinition=nginx:2 desiredcount=2 targetgroup=arn:aws:elasticloadbalancing:eu-west-1:123456789012:targetgroup/web/1234 containername=nginx containerport=80
+update service cluster=web id=nginx desiredcount=3
+task = start task cluster=web taskdefinition=batch:1`)
+	ran, err := tpl.Run(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds := ran.CommandNodesIterator()
+	if got, want := cmds[0].CmdResult, "arn:aws:ecs:eu-west-1:123456789012:service/nginx"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+	service, err := d.Graph().GetResource(graph.ContainerService, "arn:aws:ecs:eu-west-1:123456789012:service/nginx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(service.Properties["DesiredCount"]), "3"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	task := fmt.Sprint(cmds[2].CmdResult)
+	if !regexp.MustCompile(`^arn:aws:ecs:eu-west-1:123456789012:task/[0-9a-f-]{36}$`).MatchString(task) {
+		t.Fatalf("got task id %s", task)
+	}
+
+	if _, err = template.MustParse("stop task cluster=web id=" + task).Run(d); err != nil {
+		t.Fatal(err)
+	}
+	stopped, _ := d.Graph().GetResource(graph.Task, task)
+	if got, want := stopped.Properties["State"], "STOPPED"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+
+	tcases := []struct {
+		tpl    string
+		expErr string
+	}{
+		{tpl: "create service cluster=web name=nginx taskdefinition=nginx:2 desiredcount=1", expErr: "already exists"},
+		{tpl: "update service cluster=web id=unknown desiredcount=1", expErr: "not found"},
+		{tpl: "stop task cluster=web id=unknown", expErr: "not found"},
+	}
+	for i, tcase := range tcases {
+		_, err := template.MustParse(tcase.tpl).Run(d)
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want error containing '%s'", i+1, err, tcase.expErr)
+		}
+	}
+
+	if _, err := template.MustParse("delete service cluster=web id=nginx").Run(d); err != nil {
+		t.Fatal(err)
+	}
+	if all, _ := d.Graph().GetAllResources(graph.ContainerService); len(all) != 0 {
+		t.Fatalf("got %d services, want none", len(all))
+	}
+}
+
+func TestServiceDriversShareOneDryRunSnapshot(t *testing.T) {
+	d := NewDriver(nil, "eu-west-1")
+	var drivers []driver.Driver
+	for _, name := range []string{"infra", "access", "storage"} {
+		drivers = append(drivers, (&Service{name: name, driver: d}).Drivers()...)
+	}
+	multi := driver.NewMultiDriver(drivers...)
+
+	multi.SetDryRun(true)
+	scratch := d.scratch
+	if scratch == nil {
+		t.Fatal("expected a dry run snapshot")
+	}
+	multi.SetDryRun(true)
+	if d.scratch != scratch {
+		t.Fatal("expected dry run snapshot to be taken once")
+	}
+
+	tpl := template.MustParse(`vpc = create vpc cidr=10.0.0.0/16
+create subnet vpc=$vpc cidr=10.0.1.0/24
+create user name=john`)
+	if _, err := tpl.Compile(multi); err != nil {
+		t.Fatal(err)
+	}
+	if d.scratch != nil {
+		t.Fatal("expected dry run snapshot to be dropped")
+	}
+	if vpcs, _ := d.Graph().GetAllResources(graph.Vpc); len(vpcs) != 0 {
+		t.Fatalf("dry run: got %d vpcs, want none", len(vpcs))
+	}
+}
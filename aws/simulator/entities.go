@@ -0,0 +1,1311 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/wallix/awless/graph"
+)
+
+const simulatedAccount = "123456789012"
+
+var entities map[string]*entityDef
+
+func init() {
+	entities = map[string]*entityDef{
+		graph.Vpc.String(): {
+			typ: graph.Vpc, ref: "id", refProps: []string{"Name"},
+			newId:      prefixedHexId("vpc-", 8),
+			properties: map[string]string{"cidr": "CidrBlock"},
+			initial:    map[string]interface{}{"State": "available", "IsDefault": false},
+			removable:  isDefaultVpcResource,
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"create": checkCidrParam,
+				"delete": checkNothingAttachedOn,
+			},
+		},
+		graph.Subnet.String(): {
+			typ: graph.Subnet, ref: "id", refProps: []string{"Name"},
+			newId:      prefixedHexId("subnet-", 8),
+			properties: map[string]string{"cidr": "CidrBlock", "vpc": "VpcId", "zone": "AvailabilityZone", "public": "MapPublicIpOnLaunch"},
+			initial:    map[string]interface{}{"State": "available", "MapPublicIpOnLaunch": false, "DefaultForAz": false},
+			relations:  []relation{{param: "vpc", typ: graph.Vpc, kind: parentOf}},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"create": checkSubnetCidr,
+			},
+		},
+		graph.Instance.String(): {
+			typ: graph.Instance, ref: "id", refProps: []string{"Name"},
+			newId:      prefixedHexId("i-", 17),
+			properties: map[string]string{"type": "Type", "image": "ImageId", "subnet": "SubnetId", "vpc": "VpcId", "key": "KeyName", "ip": "PrivateIp", "group": "SecurityGroups"},
+			initial:    map[string]interface{}{"State": "running"},
+			relations: []relation{
+				{param: "subnet", typ: graph.Subnet, kind: parentOf},
+				{param: "group", typ: graph.SecurityGroup, kind: appliesOn},
+				{param: "key", typ: graph.Keypair, kind: appliesOn},
+			},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"create": checkInstanceIp,
+			},
+		},
+		graph.SecurityGroup.String(): {
+			typ: graph.SecurityGroup, ref: "id", refProps: []string{"Name"},
+			newId:      prefixedHexId("sg-", 8),
+			properties: map[string]string{"name": "Name", "description": "Description", "vpc": "VpcId"},
+			relations:  []relation{{param: "vpc", typ: graph.Vpc, kind: parentOf}},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"update": existsOnly,
+			},
+		},
+		graph.Volume.String(): {
+			typ: graph.Volume, ref: "id", refProps: []string{"Name"},
+			newId:      prefixedHexId("vol-", 17),
+			properties: map[string]string{"zone": "AvailabilityZone", "size": "Size"},
+			initial:    map[string]interface{}{"State": "available", "VolumeType": "standard", "Encrypted": false},
+			attach:     []relation{{param: "instance", typ: graph.Instance, kind: dependingOn}},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"attach": checkVolumeNotAttached,
+			},
+		},
+		graph.Snapshot.String(): {
+			typ: graph.Snapshot, ref: "id", refProps: []string{"Name"},
+			newId:      prefixedHexId("snap-", 17),
+			properties: map[string]string{"volume": "VolumeId", "description": "Description"},
+			initial:    map[string]interface{}{"State": "completed", "Progress": "100%", "Encrypted": false},
+			relations:  []relation{{param: "volume", typ: graph.Volume, kind: dependingOn}},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"delete": checkSnapshotNotInUse,
+			},
+		},
+		graph.Image.String(): {
+			typ: graph.Image, ref: "id", refProps: []string{"Name"},
+			newId:      prefixedHexId("ami-", 17),
+			properties: map[string]string{"name": "Name", "description": "Description"},
+			initial:    map[string]interface{}{"State": "available", "Public": false},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"create": createImage,
+				"copy":   copyImage,
+				"delete": deleteImage,
+			},
+		},
+		graph.InternetGateway.String(): {
+			typ: graph.InternetGateway, ref: "id", refProps: []string{"Name"},
+			newId:  prefixedHexId("igw-", 8),
+			attach: []relation{{param: "vpc", typ: graph.Vpc, kind: dependingOn}},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"attach": checkNoGatewayAttachedOnVpc,
+			},
+		},
+		graph.RouteTable.String(): {
+			typ: graph.RouteTable, ref: "id", refProps: []string{"Name"},
+			newId:      prefixedHexId("rtb-", 8),
+			properties: map[string]string{"vpc": "VpcId"},
+			initial:    map[string]interface{}{"Main": false},
+			relations:  []relation{{param: "vpc", typ: graph.Vpc, kind: parentOf}},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"attach": attachRouteTable,
+				"detach": detachRouteTable,
+			},
+		},
+		graph.NatGateway.String(): {
+			typ: graph.NatGateway, ref: "id",
+			newId:      prefixedHexId("nat-", 17),
+			properties: map[string]string{"subnet": "SubnetId", "elasticip": "ElasticIPs"},
+			initial:    map[string]interface{}{"State": "available"},
+			relations: []relation{
+				{param: "subnet", typ: graph.Subnet, kind: parentOf},
+				{param: "elasticip", typ: graph.ElasticIP, kind: appliesOn},
+			},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"create": checkElasticIPNotAssociated,
+			},
+		},
+		graph.ElasticIP.String(): {
+			typ: graph.ElasticIP, ref: "id",
+			newId:      prefixedHexId("eipalloc-", 8),
+			properties: map[string]string{"domain": "Domain"},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"delete": checkElasticIPNotInUse,
+			},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"attach": attachElasticIP,
+				"detach": detachElasticIP,
+			},
+		},
+		"route": {
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"create": checkRoute,
+				"delete": checkRoute,
+			},
+		},
+		"tag": {
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"create": createTag,
+			},
+		},
+		graph.Keypair.String(): {
+			typ: graph.Keypair, ref: "id", refProps: []string{"Name"},
+			newId:      paramId("name"),
+			properties: map[string]string{"name": "Name"},
+		},
+		graph.LoadBalancer.String(): {
+			typ: graph.LoadBalancer, ref: "arn", refProps: []string{"Name"},
+			newId: func(s *simulation, params map[string]interface{}) string {
+				return fmt.Sprintf("arn:aws:elasticloadbalancing:%s:%s:loadbalancer/app/%v/%s", s.region, simulatedAccount, params["name"], randHex(16))
+			},
+			properties: map[string]string{"name": "Name", "subnets": "Subnets", "scheme": "Scheme", "iptype": "IpAddressType", "groups": "SecurityGroups"},
+			initial:    map[string]interface{}{"State": "active"},
+			relations: []relation{
+				{param: "subnets", typ: graph.Subnet, kind: dependingOn},
+				{param: "groups", typ: graph.SecurityGroup, kind: appliesOn},
+			},
+		},
+		graph.ClassicLoadBalancer.String(): {
+			typ: graph.ClassicLoadBalancer, ref: "name",
+			attach: []relation{{param: "instances", typ: graph.Instance, kind: dependingOn}},
+		},
+		graph.User.String(): {
+			typ: graph.User, ref: "name", refProps: []string{"Name", "Arn"},
+			newId:      prefixedUpperId("AIDA", 17),
+			properties: map[string]string{"name": "Name"},
+			attach:     []relation{{param: "group", typ: graph.Group, kind: appliesOn}},
+		},
+		graph.Group.String(): {
+			typ: graph.Group, ref: "name", refProps: []string{"Name", "Arn"},
+			newId:      prefixedUpperId("AGPA", 17),
+			properties: map[string]string{"name": "Name"},
+		},
+		graph.Policy.String(): {
+			typ: graph.Policy, ref: "arn", refProps: []string{"Arn", "Name"},
+			newId: func(s *simulation, params map[string]interface{}) string {
+				return fmt.Sprintf("arn:aws:iam::%s:policy/%v", simulatedAccount, params["name"])
+			},
+			properties: map[string]string{"name": "Name", "description": "Description"},
+			attach: []relation{
+				{param: "user", typ: graph.User, kind: dependingOn},
+				{param: "group", typ: graph.Group, kind: dependingOn},
+				{param: "role", typ: graph.Role, kind: dependingOn},
+			},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"delete": checkPolicyNotAttached,
+			},
+		},
+		graph.Role.String(): {
+			typ: graph.Role, ref: "name", refProps: []string{"Name", "Arn"},
+			output:     "Arn",
+			newId:      prefixedUpperId("AROA", 17),
+			properties: map[string]string{"name": "Name"},
+			attach:     []relation{{param: "instanceprofile", typ: graph.InstanceProfile, kind: appliesOn}},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"create": checkRolePrincipal,
+				"attach": checkInstanceProfileHasNoRole,
+				"delete": checkRoleNotInUse,
+			},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"create": createRole,
+			},
+		},
+		graph.InstanceProfile.String(): {
+			typ: graph.InstanceProfile, ref: "name", refProps: []string{"Name"},
+			output:     "Name",
+			newId:      prefixedUpperId("AIPA", 17),
+			properties: map[string]string{"name": "Name"},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"delete": checkInstanceProfileHasNoRole,
+			},
+		},
+		graph.AccessKey.String(): {
+			typ: graph.AccessKey, ref: "id",
+			newId:      prefixedUpperId("AKIA", 16),
+			properties: map[string]string{"user": "UserName"},
+			relations:  []relation{{param: "user", typ: graph.User, kind: parentOf}},
+		},
+		graph.Bucket.String(): {
+			typ: graph.Bucket, ref: "name", refProps: []string{"Name"},
+			newId:      paramId("name"),
+			properties: map[string]string{"name": "Name"},
+			initial:    map[string]interface{}{"Public": false},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"update": updateBucket,
+			},
+		},
+		graph.Object.String(): {
+			typ: graph.Object, ref: "key", refProps: []string{"Key"},
+			newId: func(s *simulation, params map[string]interface{}) string {
+				if name, ok := params["name"]; ok {
+					return fmt.Sprint(name)
+				}
+				return filepath.Base(fmt.Sprint(params["file"]))
+			},
+			properties: map[string]string{"bucket": "BucketName"},
+			relations:  []relation{{param: "bucket", typ: graph.Bucket, kind: parentOf}},
+		},
+		graph.Record.String(): {
+			typ: graph.Record, ref: "id",
+			newId:      prefixedHexId("awls-", 16),
+			properties: map[string]string{"zone": "Zone", "name": "Name", "type": "Type", "value": "Records", "ttl": "TTL"},
+			relations:  []relation{{param: "zone", typ: graph.Zone, kind: parentOf, optional: true}},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"create": changeRecord("create"),
+				"update": changeRecord("update"),
+				"delete": changeRecord("delete"),
+				"check":  func(*simulation, map[string]interface{}) (interface{}, error) { return nil, nil },
+			},
+		},
+		graph.LaunchConfiguration.String(): {
+			typ: graph.LaunchConfiguration, ref: "id", refProps: []string{"Name"},
+			newId:      paramId("name"),
+			properties: map[string]string{"name": "Name", "image": "ImageId", "type": "Type", "keypair": "KeyName", "groups": "SecurityGroups"},
+			relations:  []relation{{param: "groups", typ: graph.SecurityGroup, kind: appliesOn}},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"delete": checkLaunchConfigurationNotInUse,
+			},
+		},
+		graph.ScalingGroup.String(): {
+			typ: graph.ScalingGroup, ref: "id", refProps: []string{"Name"},
+			newId:      paramId("name"),
+			properties: scalingGroupProperties,
+			relations: []relation{
+				{param: "launchconfiguration", typ: graph.LaunchConfiguration, kind: appliesOn},
+				{param: "subnets", typ: graph.Subnet, kind: dependingOn},
+				{param: "targetgroups", typ: graph.TargetGroup, kind: dependingOn, optional: true},
+			},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"create": checkScalingGroupCapacity,
+			},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"update": updateScalingGroup,
+				"check":  checkScalingGroup,
+			},
+		},
+		graph.Alarm.String(): {
+			typ: graph.Alarm, ref: "id", refProps: []string{"Name"},
+			newId:      paramId("name"),
+			properties: map[string]string{"name": "Name", "metric": "MetricName", "namespace": "Namespace", "statistic": "Statistic", "operator": "Operator", "threshold": "Threshold", "period": "Period", "evaluationperiods": "EvaluationPeriods", "dimensions": "Dimensions", "alarmactions": "AlarmActions", "okactions": "OKActions", "insufficientdataactions": "InsufficientDataActions"},
+			initial:    map[string]interface{}{"State": "INSUFFICIENT_DATA"},
+			relations: []relation{
+				{param: "instances", typ: graph.Instance, kind: dependingOn, optional: true},
+				{param: "loadbalancers", typ: graph.LoadBalancer, kind: dependingOn, optional: true},
+				{param: "queues", typ: graph.Queue, kind: dependingOn, optional: true},
+				{param: "topics", typ: graph.Topic, kind: dependingOn, optional: true},
+			},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"create": checkAlarmTargets,
+			},
+		},
+		graph.Stack.String(): {
+			typ: graph.Stack, ref: "id", refProps: []string{"Name"},
+			newId: func(s *simulation, params map[string]interface{}) string {
+				return fmt.Sprintf("arn:aws:cloudformation:%s:%s:stack/%v/%s-%s-%s-%s-%s", s.region, simulatedAccount, params["name"], randHex(8), randHex(4), randHex(4), randHex(4), randHex(12))
+			},
+			properties: map[string]string{"name": "Name", "parameters": "Parameters", "capabilities": "Capabilities", "notifications": "Notifications", "role": "Role", "disablerollback": "DisableRollback", "timeout": "Timeout"},
+			initial:    map[string]interface{}{"State": "CREATE_COMPLETE"},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"create": checkStackNameAvailable,
+			},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"update": updateStack,
+				"check":  checkStack,
+			},
+		},
+		graph.ContainerService.String(): {
+			typ: graph.ContainerService, ref: "id", refProps: []string{"Name"},
+			newId: func(s *simulation, params map[string]interface{}) string {
+				return fmt.Sprintf("arn:aws:ecs:%s:%s:service/%v", s.region, simulatedAccount, params["name"])
+			},
+			properties: map[string]string{"name": "Name", "cluster": "Cluster", "taskdefinition": "TaskDefinition", "desiredcount": "DesiredCount", "role": "Role", "targetgroup": "TargetGroups", "deploymentmaxpercent": "DeploymentMaxPercent", "deploymentminpercent": "DeploymentMinPercent"},
+			initial:    map[string]interface{}{"State": "ACTIVE"},
+			relations: []relation{
+				{param: "cluster", typ: graph.Cluster, kind: parentOf, optional: true},
+				{param: "taskdefinition", typ: graph.TaskDefinition, kind: dependingOn, optional: true},
+				{param: "targetgroup", typ: graph.TargetGroup, kind: dependingOn, optional: true},
+			},
+		},
+		graph.Task.String(): {
+			typ: graph.Task, ref: "id",
+			newId: func(s *simulation, params map[string]interface{}) string {
+				return fmt.Sprintf("arn:aws:ecs:%s:%s:task/%s-%s-%s-%s-%s", s.region, simulatedAccount, randHex(8), randHex(4), randHex(4), randHex(4), randHex(12))
+			},
+			properties: map[string]string{"cluster": "Cluster", "taskdefinition": "TaskDefinition", "group": "Group", "startedby": "StartedBy"},
+			initial:    map[string]interface{}{"State": "RUNNING", "DesiredState": "RUNNING"},
+			relations: []relation{
+				{param: "cluster", typ: graph.Cluster, kind: parentOf, optional: true},
+				{param: "taskdefinition", typ: graph.TaskDefinition, kind: dependingOn, optional: true},
+			},
+			states: map[string]string{"stop": "STOPPED"},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"start": startTask,
+			},
+		},
+		graph.Table.String(): {
+			typ: graph.Table, ref: "id",
+			newId:      paramId("name"),
+			properties: map[string]string{"name": "Name", "readcapacity": "ReadCapacity", "writecapacity": "WriteCapacity", "streamview": "StreamView"},
+			initial:    map[string]interface{}{"State": "ACTIVE"},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"create": func(s *simulation, params map[string]interface{}) error {
+					if existing, _ := s.find(graph.Table, fmt.Sprint(params["name"])); existing != nil {
+						return fmt.Errorf("ResourceInUseException: table '%v' already exists", params["name"])
+					}
+					return nil
+				},
+			},
+		},
+		graph.Key.String(): {
+			typ: graph.Key, ref: "id",
+			newId: func(s *simulation, params map[string]interface{}) string {
+				return fmt.Sprintf("%s-%s-%s-%s-%s", randHex(8), randHex(4), randHex(4), randHex(4), randHex(12))
+			},
+			properties: map[string]string{"description": "Description"},
+			initial:    map[string]interface{}{"State": "Enabled", "Enabled": true, "Usage": "ENCRYPT_DECRYPT"},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"delete": scheduleKeyDeletion,
+			},
+		},
+		graph.KeyAlias.String(): {
+			typ: graph.KeyAlias, ref: "name",
+			newId:      paramId("name"),
+			properties: map[string]string{"name": "Name", "key": "Key"},
+			relations:  []relation{{param: "key", typ: graph.Key, kind: parentOf}},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"create": func(s *simulation, params map[string]interface{}) error {
+					if existing, _ := s.find(graph.KeyAlias, fmt.Sprint(params["name"])); existing != nil {
+						return fmt.Errorf("AlreadyExistsException: alias '%v' already exists", params["name"])
+					}
+					return nil
+				},
+			},
+		},
+		graph.Topic.String(): {
+			typ: graph.Topic, ref: "arn",
+			newId: func(s *simulation, params map[string]interface{}) string {
+				return fmt.Sprintf("arn:aws:sns:%s:%s:%v", s.region, simulatedAccount, params["name"])
+			},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"publish": func(s *simulation, params map[string]interface{}) (interface{}, error) {
+					if _, err := s.mustFind(params); err != nil {
+						return nil, err
+					}
+					return newMessageId(), nil
+				},
+			},
+		},
+		graph.Subscription.String(): {
+			typ: graph.Subscription, ref: "arn", refProps: []string{"SubscriptionArn"},
+			output:     "SubscriptionArn",
+			newId:      paramId("endpoint"),
+			properties: map[string]string{"endpoint": "Endpoint", "protocol": "Protocol", "topic": "TopicArn", "arn": "SubscriptionArn"},
+			relations:  []relation{{param: "topic", typ: graph.Topic, kind: parentOf}},
+			checks: map[string]func(*simulation, map[string]interface{}) error{
+				"create": func(s *simulation, params map[string]interface{}) error {
+					params["arn"] = fmt.Sprintf("%v:%s-%s-%s-%s-%s", params["topic"], randHex(8), randHex(4), randHex(4), randHex(4), randHex(12))
+					return nil
+				},
+			},
+		},
+		graph.Database.String(): {
+			typ: graph.Database, ref: "id", refProps: []string{"Name", "Arn"},
+			newId:      paramId("id"),
+			properties: map[string]string{"type": "Class", "engine": "Engine", "version": "EngineVersion", "username": "Username", "size": "Storage", "dbname": "DBName", "zone": "AvailabilityZone", "port": "Port", "groups": "SecurityGroups", "subnetgroup": "SubnetGroup"},
+			initial:    map[string]interface{}{"State": "available"},
+			relations:  []relation{{param: "groups", typ: graph.SecurityGroup, kind: appliesOn}},
+			states:     map[string]string{"start": "available", "stop": "stopped"},
+		},
+		graph.Function.String(): {
+			typ: graph.Function, ref: "id", refProps: []string{"Name", "Arn"},
+			newId: func(s *simulation, params map[string]interface{}) string {
+				return fmt.Sprintf("arn:aws:lambda:%s:%s:function:%v", s.region, simulatedAccount, params["name"])
+			},
+			properties: map[string]string{"name": "Name", "handler": "Handler", "role": "Role", "runtime": "Runtime", "memory": "Memory", "timeout": "Timeout", "description": "Description", "subnets": "Subnets", "groups": "SecurityGroups"},
+			relations: []relation{
+				{param: "subnets", typ: graph.Subnet, kind: dependingOn},
+				{param: "groups", typ: graph.SecurityGroup, kind: appliesOn},
+			},
+		},
+		graph.Queue.String(): {
+			typ: graph.Queue, ref: "url",
+			newId: func(s *simulation, params map[string]interface{}) string {
+				return fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%v", s.region, simulatedAccount, params["name"])
+			},
+			properties: map[string]string{"delay": "DelaySeconds", "maxMsgSize": "MaximumMessageSize", "retentionPeriod": "MessageRetentionPeriod", "msgWait": "ReceiveMessageWaitTimeSeconds", "visibilityTimeout": "VisibilityTimeout"},
+			initial:    map[string]interface{}{"ApproximateNumberOfMessages": "0"},
+			actions: map[string]func(*simulation, map[string]interface{}) (interface{}, error){
+				"send": func(s *simulation, params map[string]interface{}) (interface{}, error) {
+					if err := addQueueMessages(s, params, 1); err != nil {
+						return nil, err
+					}
+					return newMessageId(), nil
+				},
+				"receive": receiveQueueMessages,
+				"purge": func(s *simulation, params map[string]interface{}) (interface{}, error) {
+					res, err := s.mustFind(params)
+					if err != nil {
+						return nil, err
+					}
+					res.Properties["ApproximateNumberOfMessages"] = "0"
+					return nil, s.g.UpdateResource(res)
+				},
+			},
+		},
+	}
+}
+
+// genericEntity simulates entities without dedicated definition as resources of the same name
+// attached to the region and referenced by id
+func genericEntity(entity string) *entityDef {
+	return &entityDef{
+		typ: graph.ResourceType(entity), ref: "id", refProps: []string{"Name", "Arn"},
+		newId:      prefixedHexId(entity+"-", 8),
+		properties: map[string]string{"name": "Name"},
+	}
+}
+
+func isDefaultVpcResource(child *graph.Resource) bool {
+	switch child.Type() {
+	case graph.RouteTable:
+		return child.Properties["Main"] == true
+	case graph.SecurityGroup:
+		return child.Properties["Name"] == "default"
+	}
+	return false
+}
+
+func checkCidrParam(s *simulation, params map[string]interface{}) error {
+	_, err := parseCidr(params["cidr"])
+	return err
+}
+
+func checkSubnetCidr(s *simulation, params map[string]interface{}) error {
+	cidr, err := parseCidr(params["cidr"])
+	if err != nil {
+		return err
+	}
+	vpc, err := s.find(graph.Vpc, fmt.Sprint(params["vpc"]))
+	if err != nil {
+		return err
+	}
+	if vpc == nil {
+		return fmt.Errorf("vpc '%v' not found", params["vpc"])
+	}
+	vpcCidr, err := parseCidr(vpc.Properties["CidrBlock"])
+	if err != nil {
+		return fmt.Errorf("%s: %s", vpc, err)
+	}
+	if !containsNet(vpcCidr, cidr) {
+		return fmt.Errorf("InvalidSubnet.Range: cidr %s is not within %s cidr %s", cidr, vpc, vpcCidr)
+	}
+
+	subnets, err := s.g.GetAllResources(graph.Subnet)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subnets {
+		if fmt.Sprint(sub.Properties["VpcId"]) != vpc.Id() {
+			continue
+		}
+		if other, err := parseCidr(sub.Properties["CidrBlock"]); err == nil && (other.Contains(cidr.IP) || cidr.Contains(other.IP)) {
+			return fmt.Errorf("InvalidSubnet.Conflict: cidr %s conflicts with %s cidr %s", cidr, sub, other)
+		}
+	}
+	if _, ok := params["zone"]; !ok {
+		params["zone"] = s.region + "a"
+	}
+	return nil
+}
+
+func checkInstanceIp(s *simulation, params map[string]interface{}) error {
+	subnet, err := s.find(graph.Subnet, fmt.Sprint(params["subnet"]))
+	if err != nil || subnet == nil {
+		return err
+	}
+	params["vpc"] = subnet.Properties["VpcId"]
+	cidr, err := parseCidr(subnet.Properties["CidrBlock"])
+	if err != nil {
+		return nil
+	}
+	if ipParam, ok := params["ip"]; ok {
+		if ip := net.ParseIP(fmt.Sprint(ipParam)); ip == nil || !cidr.Contains(ip) {
+			return fmt.Errorf("InvalidParameterValue: address %v is not within %s cidr %s", ipParam, subnet, cidr)
+		}
+		return nil
+	}
+	params["ip"] = randomIpIn(cidr).String()
+	return nil
+}
+
+func checkNothingAttachedOn(s *simulation, params map[string]interface{}) error {
+	res, err := s.mustFind(params)
+	if err != nil {
+		return err
+	}
+	attached, err := s.g.ListResourcesDependingOn(res)
+	if err != nil {
+		return err
+	}
+	if len(attached) > 0 {
+		return fmt.Errorf("DependencyViolation: %s has attached resources: %s", res, attached[0])
+	}
+	return nil
+}
+
+func checkVolumeNotAttached(s *simulation, params map[string]interface{}) error {
+	res, err := s.mustFind(params)
+	if err != nil {
+		return err
+	}
+	attached, err := s.g.ListResourcesAppliedOn(res)
+	if err != nil {
+		return err
+	}
+	for _, a := range attached {
+		if a.Type() == graph.Instance {
+			return fmt.Errorf("VolumeInUse: %s is already attached to %s", res, a)
+		}
+	}
+	return nil
+}
+
+func checkSnapshotNotInUse(s *simulation, params map[string]interface{}) error {
+	snap, err := s.mustFind(params)
+	if err != nil {
+		return err
+	}
+	images, err := s.g.ListResourcesDependingOn(snap)
+	if err != nil {
+		return err
+	}
+	if len(images) > 0 {
+		return fmt.Errorf("InvalidSnapshot.InUse: %s is in use by %s", snap, images[0])
+	}
+	return nil
+}
+
+func checkRolePrincipal(s *simulation, params map[string]interface{}) error {
+	_, hasPrincipal := params["principal"]
+	_, hasTrustPolicy := params["trustpolicy"]
+	if !hasPrincipal && !hasTrustPolicy {
+		return fmt.Errorf("missing one of 'principal, trustpolicy' param")
+	}
+	return nil
+}
+
+func createRole(s *simulation, params map[string]interface{}) (interface{}, error) {
+	res, err := s.createOne(params)
+	if err != nil {
+		return nil, err
+	}
+	res.Properties["Arn"] = fmt.Sprintf("arn:aws:iam::%s:role/%v", simulatedAccount, params["name"])
+	if err := s.g.UpdateResource(res); err != nil {
+		return nil, err
+	}
+	return res.Properties["Arn"], nil
+}
+
+// checkInstanceProfileHasNoRole ensures an instance profile does not hold a role yet
+// on attach, and no more before its deletion
+func checkInstanceProfileHasNoRole(s *simulation, params map[string]interface{}) error {
+	ref := params["name"]
+	if s.def.typ == graph.Role {
+		ref = params["instanceprofile"]
+	}
+	profile, err := s.find(graph.InstanceProfile, fmt.Sprint(ref))
+	if err != nil || profile == nil {
+		return err
+	}
+	roles, err := s.g.ListResourcesAppliedOn(profile)
+	if err != nil {
+		return err
+	}
+	if len(roles) > 0 {
+		if s.def.typ == graph.Role {
+			return fmt.Errorf("LimitExceeded: %s already holds %s", profile, roles[0])
+		}
+		return fmt.Errorf("DeleteConflict: %s still holds %s", profile, roles[0])
+	}
+	return nil
+}
+
+func checkRoleNotInUse(s *simulation, params map[string]interface{}) error {
+	role, err := s.mustFind(params)
+	if err != nil {
+		return err
+	}
+	// instance profiles holding the role and policies attached to it both apply on the role
+	users, err := s.g.ListResourcesDependingOn(role)
+	if err != nil {
+		return err
+	}
+	if len(users) > 0 {
+		return fmt.Errorf("DeleteConflict: %s is still used by %s", role, users[0])
+	}
+	return nil
+}
+
+func checkPolicyNotAttached(s *simulation, params map[string]interface{}) error {
+	policy, err := s.mustFind(params)
+	if err != nil {
+		return err
+	}
+	attached, err := s.g.ListResourcesAppliedOn(policy)
+	if err != nil {
+		return err
+	}
+	if len(attached) > 0 {
+		return fmt.Errorf("DeleteConflict: %s is attached to %s", policy, attached[0])
+	}
+	return nil
+}
+
+func updateBucket(s *simulation, params map[string]interface{}) (interface{}, error) {
+	bucket, err := s.mustFind(params)
+	if err != nil {
+		return nil, err
+	}
+	if acl, ok := params["acl"]; ok {
+		bucket.Properties["Public"] = acl != "private"
+	}
+	if versioning, ok := params["versioning"]; ok {
+		bucket.Properties["Versioning"] = strings.Title(fmt.Sprint(versioning))
+	}
+	switch params["encryption"] {
+	case nil:
+	case "none":
+		delete(bucket.Properties, "Encryption")
+	case "kms":
+		bucket.Properties["Encryption"] = "aws:kms"
+	default:
+		bucket.Properties["Encryption"] = "AES256"
+	}
+	switch params["publicaccessblock"] {
+	case nil:
+	case "true":
+		bucket.Properties["PublicAccessBlock"] = []string{"BlockPublicAcls", "IgnorePublicAcls", "BlockPublicPolicy", "RestrictPublicBuckets"}
+		bucket.Properties["Public"] = false
+	default:
+		delete(bucket.Properties, "PublicAccessBlock")
+	}
+	if policy, ok := params["policy"]; ok {
+		if policy == "none" {
+			delete(bucket.Properties, "Policy")
+		} else {
+			bucket.Properties["Policy"] = policy
+		}
+	}
+	if err := s.g.UpdateResource(bucket); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func createImage(s *simulation, params map[string]interface{}) (interface{}, error) {
+	instance, err := s.find(graph.Instance, fmt.Sprint(params["instance"]))
+	if err != nil {
+		return nil, err
+	}
+	if instance == nil {
+		return nil, fmt.Errorf("instance '%v' not found", params["instance"])
+	}
+	return newImage(s, params)
+}
+
+func copyImage(s *simulation, params map[string]interface{}) (interface{}, error) {
+	// only images of the simulated region are known
+	if fmt.Sprint(params["sourceregion"]) == s.region {
+		if _, err := s.mustFind(params); err != nil {
+			return nil, err
+		}
+	}
+	return newImage(s, map[string]interface{}{"name": params["name"], "description": params["description"]})
+}
+
+// newImage creates an image along with the snapshot of its root device
+func newImage(s *simulation, params map[string]interface{}) (interface{}, error) {
+	image, err := s.createOne(params)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := &simulation{g: s.g, region: s.region, def: entities[graph.Snapshot.String()], entity: graph.Snapshot.String()}
+	snap, err := snapshots.createOne(map[string]interface{}{"description": fmt.Sprintf("Created for %s", image.Id())})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.g.AddAppliesOnRelation(image, snap); err != nil {
+		return nil, err
+	}
+	return image.Id(), nil
+}
+
+func deleteImage(s *simulation, params map[string]interface{}) (interface{}, error) {
+	image, err := s.mustFind(params)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := s.g.ListResourcesAppliedOn(image)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.g.DeleteResource(image); err != nil {
+		return nil, err
+	}
+	for _, snap := range snapshots {
+		if err := s.g.DeleteResource(snap); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func checkNoGatewayAttachedOnVpc(s *simulation, params map[string]interface{}) error {
+	vpc, err := s.find(graph.Vpc, fmt.Sprint(params["vpc"]))
+	if err != nil || vpc == nil {
+		return err
+	}
+	attached, err := s.g.ListResourcesDependingOn(vpc)
+	if err != nil {
+		return err
+	}
+	for _, a := range attached {
+		if a.Type() == graph.InternetGateway {
+			return fmt.Errorf("Resource.AlreadyAssociated: %s already has %s attached", vpc, a)
+		}
+	}
+	return nil
+}
+
+func attachRouteTable(s *simulation, params map[string]interface{}) (interface{}, error) {
+	table, err := s.mustFind(params)
+	if err != nil {
+		return nil, err
+	}
+	subnet, err := s.find(graph.Subnet, fmt.Sprint(params["subnet"]))
+	if err != nil {
+		return nil, err
+	}
+	if subnet == nil {
+		return nil, fmt.Errorf("subnet '%v' not found", params["subnet"])
+	}
+	if err := s.g.AddAppliesOnRelation(table, subnet); err != nil {
+		return nil, err
+	}
+	return associationId(table, subnet), nil
+}
+
+func detachRouteTable(s *simulation, params map[string]interface{}) (interface{}, error) {
+	tables, err := s.g.GetAllResources(graph.RouteTable)
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range tables {
+		subnets, err := s.g.ListResourcesAppliedOn(table)
+		if err != nil {
+			return nil, err
+		}
+		for _, subnet := range subnets {
+			if associationId(table, subnet) == fmt.Sprint(params["association"]) {
+				return nil, s.g.RemoveAppliesOnRelation(table, subnet)
+			}
+		}
+	}
+	return nil, fmt.Errorf("association '%v' not found", params["association"])
+}
+
+func checkRoute(s *simulation, params map[string]interface{}) (interface{}, error) {
+	// unlike VPCs and subnets, any destination range is valid (ex: 0.0.0.0/0)
+	if _, _, err := net.ParseCIDR(fmt.Sprint(params["cidr"])); err != nil {
+		return nil, fmt.Errorf("invalid cidr '%v'", params["cidr"])
+	}
+	if table, err := s.find(graph.RouteTable, fmt.Sprint(params["table"])); err != nil || table == nil {
+		return nil, fmt.Errorf("routetable '%v' not found", params["table"])
+	}
+	for _, target := range []string{"gateway", "natgateway", "instance"} {
+		if id, ok := params[target]; ok {
+			if found, err := s.g.FindResource(fmt.Sprint(id)); err != nil || found == nil {
+				return nil, fmt.Errorf("%s '%v' not found", target, id)
+			}
+		}
+	}
+	return nil, nil
+}
+
+func checkElasticIPNotAssociated(s *simulation, params map[string]interface{}) error {
+	eip, err := s.find(graph.ElasticIP, fmt.Sprint(params["elasticip"]))
+	if err != nil || eip == nil {
+		return err
+	}
+	associated, err := s.g.ListResourcesAppliedOn(eip)
+	if err != nil {
+		return err
+	}
+	if len(associated) > 0 {
+		return fmt.Errorf("Resource.AlreadyAssociated: %s is already associated with %s", eip, associated[0])
+	}
+	return nil
+}
+
+func checkElasticIPNotInUse(s *simulation, params map[string]interface{}) error {
+	eip, err := s.mustFind(params)
+	if err != nil {
+		return err
+	}
+	associated, err := s.g.ListResourcesAppliedOn(eip)
+	if err != nil {
+		return err
+	}
+	if len(associated) > 0 {
+		return fmt.Errorf("InvalidIPAddress.InUse: %s is associated with %s", eip, associated[0])
+	}
+	return nil
+}
+
+func attachElasticIP(s *simulation, params map[string]interface{}) (interface{}, error) {
+	eip, err := s.mustFind(params)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := s.find(graph.Instance, fmt.Sprint(params["instance"]))
+	if err != nil {
+		return nil, err
+	}
+	if instance == nil {
+		return nil, fmt.Errorf("instance '%v' not found", params["instance"])
+	}
+	associated, err := s.g.ListResourcesAppliedOn(eip)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range associated {
+		if reassociate, _ := strconv.ParseBool(fmt.Sprint(params["allowreassociation"])); !reassociate {
+			return nil, fmt.Errorf("Resource.AlreadyAssociated: %s is already associated with %s", eip, a)
+		}
+		if err := s.g.RemoveAppliesOnRelation(eip, a); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.g.AddAppliesOnRelation(eip, instance); err != nil {
+		return nil, err
+	}
+	return elasticIPAssociationId(eip, instance), nil
+}
+
+func detachElasticIP(s *simulation, params map[string]interface{}) (interface{}, error) {
+	eips, err := s.g.GetAllResources(graph.ElasticIP)
+	if err != nil {
+		return nil, err
+	}
+	for _, eip := range eips {
+		associated, err := s.g.ListResourcesAppliedOn(eip)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range associated {
+			if a.Type() == graph.Instance && elasticIPAssociationId(eip, a) == fmt.Sprint(params["association"]) {
+				return nil, s.g.RemoveAppliesOnRelation(eip, a)
+			}
+		}
+	}
+	return nil, fmt.Errorf("association '%v' not found", params["association"])
+}
+
+func createTag(s *simulation, params map[string]interface{}) (interface{}, error) {
+	res, err := s.g.FindResource(fmt.Sprint(params["resource"]))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("resource '%v' not found", params["resource"])
+	}
+	if fmt.Sprint(params["key"]) == "Name" {
+		res.Properties["Name"] = params["value"]
+		if err := s.g.UpdateResource(res); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+var scalingGroupProperties = map[string]string{"name": "Name", "launchconfiguration": "LaunchConfigurationName", "minsize": "MinSize", "maxsize": "MaxSize", "desiredcapacity": "DesiredCapacity", "subnets": "Subnets", "targetgroups": "TargetGroups"}
+
+func checkLaunchConfigurationNotInUse(s *simulation, params map[string]interface{}) error {
+	res, err := s.mustFind(params)
+	if err != nil {
+		return err
+	}
+	groups, err := s.g.ListResourcesAppliedOn(res)
+	if err != nil {
+		return err
+	}
+	if len(groups) > 0 {
+		return fmt.Errorf("ResourceInUse: %s is attached to %s", res, groups[0])
+	}
+	return nil
+}
+
+// checkScalingGroupCapacity validates the capacity of a new scaling group,
+// whose desired capacity defaults to its min size
+func checkScalingGroupCapacity(s *simulation, params map[string]interface{}) error {
+	if _, ok := params["desiredcapacity"]; !ok {
+		params["desiredcapacity"] = params["minsize"]
+	}
+	return validateCapacity(params["minsize"], params["desiredcapacity"], params["maxsize"])
+}
+
+func validateCapacity(min, desired, max interface{}) error {
+	var sizes []int
+	for _, v := range []interface{}{min, desired, max} {
+		n, err := strconv.Atoi(fmt.Sprint(v))
+		if err != nil {
+			return fmt.Errorf("ValidationError: invalid size '%v'", v)
+		}
+		sizes = append(sizes, n)
+	}
+	if sizes[1] < sizes[0] || sizes[1] > sizes[2] {
+		return fmt.Errorf("ValidationError: desired capacity %d must be between the min size %d and the max size %d", sizes[1], sizes[0], sizes[2])
+	}
+	return nil
+}
+
+// updateScalingGroup returns the previous values of the updated params, as the AWS driver does
+func updateScalingGroup(s *simulation, params map[string]interface{}) (interface{}, error) {
+	res, err := s.find(graph.ScalingGroup, fmt.Sprint(params["name"]))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("ValidationError: scaling group '%v' not found", params["name"])
+	}
+	capacity := func(param string) interface{} {
+		if v, ok := params[param]; ok {
+			return v
+		}
+		return res.Properties[scalingGroupProperties[param]]
+	}
+	if err = validateCapacity(capacity("minsize"), capacity("desiredcapacity"), capacity("maxsize")); err != nil {
+		return nil, err
+	}
+
+	var previous []string
+	for _, param := range []string{"launchconfiguration", "maxsize", "minsize", "desiredcapacity", "subnets"} {
+		v, ok := params[param]
+		if !ok {
+			continue
+		}
+		prop := scalingGroupProperties[param]
+		if old := paramValues(res.Properties, prop); len(old) > 0 {
+			previous = append(previous, fmt.Sprintf("%s=%s", param, strings.Join(old, ",")))
+		}
+		res.Properties[prop] = v
+	}
+	if err = s.g.UpdateResource(res); err != nil {
+		return nil, err
+	}
+	return strings.Join(previous, " "), nil
+}
+
+// checkScalingGroup considers all the desired instances of a simulated scaling group in service
+func checkScalingGroup(s *simulation, params map[string]interface{}) (interface{}, error) {
+	res, err := s.find(graph.ScalingGroup, fmt.Sprint(params["id"]))
+	if err != nil {
+		return nil, err
+	}
+	count := fmt.Sprint(params["count"])
+	switch {
+	case res == nil && count == "0":
+		return nil, nil
+	case res == nil:
+		return nil, fmt.Errorf("scalinggroup '%v' not found", params["id"])
+	case fmt.Sprint(res.Properties["DesiredCapacity"]) != count:
+		return nil, fmt.Errorf("timeout of %vs: %s has %v instances in service, expected %s", params["timeout"], res, res.Properties["DesiredCapacity"], count)
+	}
+	return nil, nil
+}
+
+func checkStackNameAvailable(s *simulation, params map[string]interface{}) error {
+	if existing, _ := s.find(graph.Stack, fmt.Sprint(params["name"])); existing != nil {
+		return fmt.Errorf("AlreadyExistsException: stack '%v' already exists", params["name"])
+	}
+	return nil
+}
+
+// updateStack completes the update of a simulated stack at once and returns its id
+func updateStack(s *simulation, params map[string]interface{}) (interface{}, error) {
+	res, err := s.find(graph.Stack, fmt.Sprint(params["id"]))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("ValidationError: stack '%v' does not exist", params["id"])
+	}
+	for param, prop := range s.def.properties {
+		if v, ok := params[param]; ok && param != "name" {
+			res.Properties[prop] = v
+		}
+	}
+	res.Properties["State"] = "UPDATE_COMPLETE"
+	if err = s.g.UpdateResource(res); err != nil {
+		return nil, err
+	}
+	return res.Id(), nil
+}
+
+func newMessageId() string {
+	return fmt.Sprintf("%s-%s-%s-%s-%s", randHex(8), randHex(4), randHex(4), randHex(4), randHex(12))
+}
+
+// addQueueMessages counts the messages of a simulated queue, as their bodies are not kept
+func addQueueMessages(s *simulation, params map[string]interface{}, n int) error {
+	res, err := s.mustFind(params)
+	if err != nil {
+		return err
+	}
+	count, _ := strconv.Atoi(fmt.Sprint(res.Properties["ApproximateNumberOfMessages"]))
+	if count += n; count < 0 {
+		count = 0
+	}
+	res.Properties["ApproximateNumberOfMessages"] = strconv.Itoa(count)
+	return s.g.UpdateResource(res)
+}
+
+// receiveQueueMessages removes the received messages from a simulated queue only when they are deleted
+func receiveQueueMessages(s *simulation, params map[string]interface{}) (interface{}, error) {
+	max := 1
+	if v, ok := params["max"]; ok {
+		n, err := strconv.Atoi(fmt.Sprint(v))
+		if err != nil || n < 1 || n > 10 {
+			return nil, fmt.Errorf("InvalidParameterValue: max must be between 1 and 10, got '%v'", v)
+		}
+		max = n
+	}
+	if fmt.Sprint(params["delete"]) != "true" {
+		max = 0
+	}
+	return nil, addQueueMessages(s, params, -max)
+}
+
+// scheduleKeyDeletion leaves the simulated key in the PendingDeletion state, as KMS does during the waiting period
+func scheduleKeyDeletion(s *simulation, params map[string]interface{}) (interface{}, error) {
+	res, err := s.mustFind(params)
+	if err != nil {
+		return nil, err
+	}
+	if days, ok := params["pendingdays"]; ok {
+		if n, err := strconv.Atoi(fmt.Sprint(days)); err != nil || n < 7 || n > 30 {
+			return nil, fmt.Errorf("ValidationException: pending window of %v days is not between 7 and 30", days)
+		}
+	}
+	if res.Properties["State"] == "PendingDeletion" {
+		return nil, fmt.Errorf("KMSInvalidStateException: key '%s' is pending deletion", res.Id())
+	}
+	res.Properties["State"] = "PendingDeletion"
+	res.Properties["Enabled"] = false
+	if err = s.g.UpdateResource(res); err != nil {
+		return nil, err
+	}
+	return res.Id(), nil
+}
+
+// checkStack considers a deleted simulated stack in the DELETE_COMPLETE state, as CloudFormation does
+func checkStack(s *simulation, params map[string]interface{}) (interface{}, error) {
+	res, err := s.find(graph.Stack, fmt.Sprint(params["id"]))
+	if err != nil {
+		return nil, err
+	}
+	expected := fmt.Sprint(params["state"])
+	switch {
+	case res == nil && expected == "DELETE_COMPLETE":
+		return nil, nil
+	case res == nil:
+		return nil, fmt.Errorf("ValidationError: stack '%v' does not exist", params["id"])
+	case fmt.Sprint(res.Properties["State"]) != expected:
+		return nil, fmt.Errorf("%s is in state '%v', expected '%s'", res, res.Properties["State"], expected)
+	}
+	return nil, nil
+}
+
+// startTask runs a new task, as starting a task never resumes a stopped one
+func startTask(s *simulation, params map[string]interface{}) (interface{}, error) {
+	res, err := s.createOne(params)
+	if err != nil {
+		return nil, err
+	}
+	return res.Id(), nil
+}
+
+// changeRecord applies a change batch on the record identified by its zone, name and type
+// and returns the id of the change, which is always in sync
+func changeRecord(action string) func(*simulation, map[string]interface{}) (interface{}, error) {
+	return func(s *simulation, params map[string]interface{}) (interface{}, error) {
+		var record *graph.Resource
+		records, err := s.g.GetAllResources(graph.Record)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range records {
+			if fmt.Sprint(r.Properties["Zone"]) == fmt.Sprint(params["zone"]) && fmt.Sprint(r.Properties["Name"]) == fmt.Sprint(params["name"]) && fmt.Sprint(r.Properties["Type"]) == fmt.Sprint(params["type"]) {
+				record = r
+				break
+			}
+		}
+
+		switch {
+		case action == "create" && record != nil:
+			return nil, fmt.Errorf("InvalidChangeBatch: %v record '%v' already exists", params["type"], params["name"])
+		case action == "create":
+			if _, err := s.createOne(params); err != nil {
+				return nil, err
+			}
+		case record == nil:
+			return nil, fmt.Errorf("InvalidChangeBatch: %v record '%v' not found", params["type"], params["name"])
+		case action == "update":
+			for param, prop := range s.def.properties {
+				if v, ok := params[param]; ok {
+					record.Properties[prop] = v
+				}
+			}
+			if err := s.g.UpdateResource(record); err != nil {
+				return nil, err
+			}
+		case action == "delete":
+			if err := s.g.DeleteResource(record); err != nil {
+				return nil, err
+			}
+		}
+
+		return "/change/C" + strings.ToUpper(randHex(13)), nil
+	}
+}
+
+// checkAlarmTargets validates the dimensions of an alarm and resolves the resources
+// they reference, as well as the topics notified by its actions
+func checkAlarmTargets(s *simulation, params map[string]interface{}) error {
+	if _, err := strconv.ParseFloat(fmt.Sprint(params["threshold"]), 64); err != nil {
+		return fmt.Errorf("ValidationError: invalid threshold '%v'", params["threshold"])
+	}
+	targets := make(map[string][]string)
+	for _, dim := range paramValues(params, "dimensions") {
+		splits := strings.SplitN(dim, ":", 2)
+		if len(splits) != 2 || splits[0] == "" || splits[1] == "" {
+			return fmt.Errorf("ValidationError: invalid dimension '%s'", dim)
+		}
+		switch splits[0] {
+		case "InstanceId":
+			targets["instances"] = append(targets["instances"], splits[1])
+		case "LoadBalancer":
+			targets["loadbalancers"] = append(targets["loadbalancers"], fmt.Sprintf("arn:aws:elasticloadbalancing:%s:%s:loadbalancer/%s", s.region, simulatedAccount, splits[1]))
+		case "QueueName":
+			targets["queues"] = append(targets["queues"], fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", s.region, simulatedAccount, splits[1]))
+		}
+	}
+	for _, key := range []string{"alarmactions", "okactions", "insufficientdataactions"} {
+		for _, action := range paramValues(params, key) {
+			if strings.HasPrefix(action, "arn:aws:sns:") {
+				targets["topics"] = append(targets["topics"], action)
+			}
+		}
+	}
+	for key, ids := range targets {
+		params[key] = ids
+	}
+	return nil
+}
+
+func existsOnly(s *simulation, params map[string]interface{}) (interface{}, error) {
+	_, err := s.mustFind(params)
+	return nil, err
+}
+
+func associationId(table, subnet *graph.Resource) string {
+	sum := sha1.Sum([]byte(table.Id() + subnet.Id()))
+	return fmt.Sprintf("rtbassoc-%x", sum[:4])
+}
+
+func elasticIPAssociationId(eip, instance *graph.Resource) string {
+	sum := sha1.Sum([]byte(eip.Id() + instance.Id()))
+	return fmt.Sprintf("eipassoc-%x", sum[:4])
+}
+
+func paramId(param string) func(*simulation, map[string]interface{}) string {
+	return func(s *simulation, params map[string]interface{}) string {
+		return fmt.Sprint(params[param])
+	}
+}
+
+func prefixedHexId(prefix string, length int) func(*simulation, map[string]interface{}) string {
+	return func(*simulation, map[string]interface{}) string {
+		return prefix + randHex(length)
+	}
+}
+
+func prefixedUpperId(prefix string, length int) func(*simulation, map[string]interface{}) string {
+	const chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+	return func(*simulation, map[string]interface{}) string {
+		b := make([]byte, length)
+		for i := range b {
+			b[i] = chars[rand.Intn(len(chars))]
+		}
+		return prefix + string(b)
+	}
+}
+
+func randHex(length int) string {
+	var all []string
+	for l := 0; l < length; l += 8 {
+		all = append(all, fmt.Sprintf("%08x", rand.Uint32()))
+	}
+	return strings.Join(all, "")[:length]
+}
+
+func parseCidr(v interface{}) (*net.IPNet, error) {
+	_, cidr, err := net.ParseCIDR(fmt.Sprint(v))
+	if err != nil {
+		return nil, fmt.Errorf("invalid cidr '%v'", v)
+	}
+	if ones, _ := cidr.Mask.Size(); cidr.IP.To4() != nil && (ones < 16 || ones > 28) {
+		return nil, fmt.Errorf("InvalidVpc.Range: cidr %s: netmask must be between /16 and /28", cidr)
+	}
+	return cidr, nil
+}
+
+func containsNet(outer, inner *net.IPNet) bool {
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outer.Contains(inner.IP) && innerOnes >= outerOnes
+}
+
+// randomIpIn returns an address of the network, skipping the 4 first and the last addresses reserved by AWS
+func randomIpIn(cidr *net.IPNet) net.IP {
+	ip := cidr.IP.To4()
+	if ip == nil {
+		return cidr.IP
+	}
+	ones, bits := cidr.Mask.Size()
+	size := uint32(1) << uint(bits-ones)
+	offset := uint32(4)
+	if size > 5 {
+		offset += rand.Uint32() % (size - 5)
+	}
+	out := make(net.IP, 4)
+	binary.BigEndian.PutUint32(out, binary.BigEndian.Uint32(ip)+offset)
+	return out
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/wallix/awless/aws"
+	awsdriver "github.com/wallix/awless/aws/driver"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/template/driver"
+)
+
+// RegisterServices replaces all AWS services of the cloud registry with
+// services whose drivers act on the simulated graph
+func RegisterServices(d *Driver) {
+	for _, name := range aws.ServiceNames {
+		cloud.ServiceRegistry[name] = &Service{name: name, driver: d}
+	}
+}
+
+// A Service is a cloud service backed by a simulation. It is never synced.
+type Service struct {
+	name   string
+	driver *Driver
+}
+
+func (s *Service) Name() string {
+	return s.name
+}
+
+func (s *Service) Region() string {
+	return s.driver.region
+}
+
+func (s *Service) Drivers() []driver.Driver {
+	return []driver.Driver{&serviceDriver{Driver: s.driver, service: s.name}}
+}
+
+func (s *Service) ResourceTypes() (all []string) {
+	for _, t := range aws.ResourceTypes {
+		if aws.ServicePerResourceType[t] == s.name {
+			all = append(all, t)
+		}
+	}
+	return
+}
+
+func (s *Service) FetchResources() (*graph.Graph, error) {
+	return s.driver.Graph(), nil
+}
+
+func (s *Service) IsSyncDisabled() bool {
+	return true
+}
+
+func (s *Service) FetchByType(t string) (*graph.Graph, error) {
+	g := graph.NewGraph()
+	resources, err := s.driver.Graph().GetAllResources(graph.ResourceType(t))
+	if err != nil {
+		return g, err
+	}
+	return g, g.AddResource(resources...)
+}
+
+// serviceDriver only serves the driver functions of the entities managed by its service
+type serviceDriver struct {
+	*Driver
+	service string
+}
+
+func (d *serviceDriver) Lookup(lookups ...string) (driver.DriverFn, error) {
+	if len(lookups) < 2 {
+		return nil, driver.ErrDriverFnNotFound
+	}
+	def, ok := awsdriver.AWSTemplatesDefinitions[lookups[0]+lookups[1]]
+	if !ok || aws.ServicePerAPI[def.Api] != d.service {
+		return nil, driver.ErrDriverFnNotFound
+	}
+	return d.Driver.Lookup(lookups...)
+}
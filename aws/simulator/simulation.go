@@ -0,0 +1,418 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/graph"
+)
+
+const (
+	parentOf = iota
+	appliesOn
+	dependingOn
+)
+
+// A relation links the resource of an entity to the resource referenced by a param
+type relation struct {
+	param string
+	typ   graph.ResourceType
+	kind  int
+	// optional is set when the referenced resource may legitimately not be known (ex: AWS managed policies)
+	optional bool
+}
+
+type entityDef struct {
+	typ graph.ResourceType
+	// ref is the param referencing an existing resource of this entity
+	ref string
+	// refProps are the properties (besides the id) a ref can match
+	refProps []string
+	// output is the property returned on create (default to the resource id)
+	output     string
+	newId      func(s *simulation, params map[string]interface{}) string
+	properties map[string]string
+	initial    map[string]interface{}
+	relations  []relation
+	attach     []relation
+	// removable tells which children are deleted along with the resource instead of preventing its deletion
+	removable func(child *graph.Resource) bool
+	// states are the states set by the start and stop actions (default to running and stopped)
+	states  map[string]string
+	checks  map[string]func(s *simulation, params map[string]interface{}) error
+	actions map[string]func(s *simulation, params map[string]interface{}) (interface{}, error)
+}
+
+type simulation struct {
+	g      *graph.Graph
+	region string
+	entity string
+	def    *entityDef
+}
+
+func (s *simulation) create(params map[string]interface{}) (interface{}, error) {
+	if fn, ok := s.def.actions["create"]; ok {
+		return fn(s, params)
+	}
+
+	count := 1
+	if c, ok := params["count"]; ok {
+		n, err := strconv.Atoi(fmt.Sprint(c))
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid count '%v'", c)
+		}
+		count = n
+	}
+
+	var first *graph.Resource
+	for i := 0; i < count; i++ {
+		res, err := s.createOne(params)
+		if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			first = res
+		}
+	}
+
+	if s.def.output != "" {
+		return first.Properties[s.def.output], nil
+	}
+	return first.Id(), nil
+}
+
+func (s *simulation) createOne(params map[string]interface{}) (*graph.Resource, error) {
+	id := s.def.newId(s, params)
+	if existing, _ := s.g.FindResource(id); existing != nil {
+		return nil, fmt.Errorf("%s '%s' already exists", s.entity, id)
+	}
+
+	res := graph.InitResource(id, s.def.typ)
+	res.Properties["Id"] = id
+	for k, v := range s.def.initial {
+		res.Properties[k] = v
+	}
+	for param, prop := range s.def.properties {
+		if v, ok := params[param]; ok {
+			res.Properties[prop] = v
+		}
+	}
+
+	var hasParent bool
+	var links []func() error
+	for _, rel := range s.def.relations {
+		rel := rel
+		for _, ref := range paramValues(params, rel.param) {
+			target, err := s.findOrStub(rel, ref)
+			if err != nil {
+				return nil, err
+			}
+			if rel.kind == parentOf {
+				hasParent = true
+			}
+			links = append(links, func() error { return addRelation(s.g, target, res, rel.kind) })
+		}
+	}
+
+	if err := s.g.AddResource(res); err != nil {
+		return nil, err
+	}
+	if !hasParent {
+		if err := s.g.AddParentRelation(graph.InitResource(s.region, graph.Region), res); err != nil {
+			return nil, err
+		}
+	}
+	for _, link := range links {
+		if err := link(); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (s *simulation) delete(params map[string]interface{}) (interface{}, error) {
+	if fn, ok := s.def.actions["delete"]; ok {
+		return fn(s, params)
+	}
+
+	res, err := s.mustFind(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var children, removables []*graph.Resource
+	err = s.g.Accept(&graph.ChildrenVisitor{From: res, Each: func(child *graph.Resource, depth int) error {
+		if depth != 1 {
+			return nil
+		}
+		if s.def.removable != nil && s.def.removable(child) {
+			removables = append(removables, child)
+		} else {
+			children = append(children, child)
+		}
+		return nil
+	}})
+	if err != nil {
+		return nil, err
+	}
+	if len(children) > 0 {
+		var all []string
+		for _, c := range children {
+			all = append(all, c.String())
+		}
+		return nil, fmt.Errorf("DependencyViolation: %s has dependent resources: %s", res, strings.Join(all, ", "))
+	}
+
+	for _, r := range append(removables, res) {
+		if err := s.g.DeleteResource(r); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *simulation) attach(params map[string]interface{}) (interface{}, error) {
+	if fn, ok := s.def.actions["attach"]; ok {
+		return fn(s, params)
+	}
+	return s.link(params, true)
+}
+
+func (s *simulation) detach(params map[string]interface{}) (interface{}, error) {
+	if fn, ok := s.def.actions["detach"]; ok {
+		return fn(s, params)
+	}
+	return s.link(params, false)
+}
+
+func (s *simulation) link(params map[string]interface{}, attach bool) (interface{}, error) {
+	res, err := s.findOrStub(relation{param: s.def.ref, typ: s.def.typ, optional: s.def.typ == graph.Policy}, fmt.Sprint(params[s.def.ref]))
+	if err != nil {
+		return nil, err
+	}
+
+	var linked bool
+	for _, rel := range s.def.attach {
+		for _, ref := range paramValues(params, rel.param) {
+			target, err := s.findOrStub(rel, ref)
+			if err != nil {
+				return nil, err
+			}
+			if attach {
+				err = addRelation(s.g, target, res, rel.kind)
+			} else {
+				err = removeRelation(s.g, target, res, rel.kind)
+			}
+			if err != nil {
+				return nil, err
+			}
+			linked = true
+		}
+	}
+	if !linked {
+		var expected []string
+		for _, rel := range s.def.attach {
+			expected = append(expected, rel.param)
+		}
+		return nil, fmt.Errorf("missing one of '%s' param", strings.Join(expected, ", "))
+	}
+
+	if attach && s.def.output == "" {
+		return res.Id(), nil
+	}
+	return nil, nil
+}
+
+var defaultStates = map[string]string{"start": "running", "stop": "stopped"}
+
+func (s *simulation) setState(params map[string]interface{}, action string) (interface{}, error) {
+	if fn, ok := s.def.actions[action]; ok {
+		return fn(s, params)
+	}
+
+	state := defaultStates[action]
+	if st, ok := s.def.states[action]; ok {
+		state = st
+	}
+	res, err := s.mustFind(params)
+	if err != nil {
+		return nil, err
+	}
+	res.Properties["State"] = state
+	if err := s.g.UpdateResource(res); err != nil {
+		return nil, err
+	}
+	return res.Id(), nil
+}
+
+func (s *simulation) check(params map[string]interface{}) (interface{}, error) {
+	if fn, ok := s.def.actions["check"]; ok {
+		return fn(s, params)
+	}
+
+	expected := fmt.Sprint(params["state"])
+	res, err := s.find(s.def.typ, fmt.Sprint(params[s.def.ref]))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		if expected == "terminated" || expected == "deleted" || expected == "not-found" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s '%v' not found", s.entity, params[s.def.ref])
+	}
+	if got := fmt.Sprint(res.Properties["State"]); got != expected {
+		return nil, fmt.Errorf("timeout of %vs: %s is '%s', expected '%s'", params["timeout"], res, got, expected)
+	}
+	return nil, nil
+}
+
+func (s *simulation) update(params map[string]interface{}) (interface{}, error) {
+	if fn, ok := s.def.actions["update"]; ok {
+		return fn(s, params)
+	}
+
+	res, err := s.mustFind(params)
+	if err != nil {
+		return nil, err
+	}
+	for param, prop := range s.def.properties {
+		if v, ok := params[param]; ok && param != s.def.ref {
+			res.Properties[prop] = v
+		}
+	}
+	if err := s.g.UpdateResource(res); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (s *simulation) mustFind(params map[string]interface{}) (*graph.Resource, error) {
+	ref, ok := params[s.def.ref]
+	if !ok {
+		return nil, fmt.Errorf("missing required param '%s'", s.def.ref)
+	}
+	res, err := s.find(s.def.typ, fmt.Sprint(ref))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("%s '%v' not found", s.entity, ref)
+	}
+	return res, nil
+}
+
+// find resolves a resource of the given type on its id or on its reference properties
+func (s *simulation) find(typ graph.ResourceType, ref string) (*graph.Resource, error) {
+	if typ == "" {
+		return nil, errors.New("entity not simulated as a resource")
+	}
+	all, err := s.g.GetAllResources(typ)
+	if err != nil {
+		return nil, err
+	}
+	var props []string
+	if def, ok := entities[typ.String()]; ok {
+		props = def.refProps
+	}
+	for _, res := range all {
+		if res.Id() == ref {
+			return res, nil
+		}
+		for _, p := range props {
+			if v, ok := res.Properties[p]; ok && fmt.Sprint(v) == ref {
+				return res, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (s *simulation) findOrStub(rel relation, ref string) (*graph.Resource, error) {
+	res, err := s.find(rel.typ, ref)
+	if err != nil {
+		return nil, err
+	}
+	if res != nil {
+		return res, nil
+	}
+	if !rel.optional {
+		return nil, fmt.Errorf("%s '%s' not found", rel.typ, ref)
+	}
+	res = graph.InitResource(ref, rel.typ)
+	res.Properties["Id"] = ref
+	if err := s.g.AddResource(res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func paramValues(params map[string]interface{}, key string) []string {
+	v, ok := params[key]
+	if !ok || v == nil {
+		return nil
+	}
+	switch vv := v.(type) {
+	case []string:
+		return vv
+	case []interface{}:
+		var all []string
+		for _, e := range vv {
+			all = append(all, fmt.Sprint(e))
+		}
+		return all
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+func addRelation(g *graph.Graph, first, other *graph.Resource, kind int) error {
+	switch kind {
+	case parentOf:
+		return g.AddParentRelation(first, other)
+	case appliesOn:
+		return g.AddAppliesOnRelation(first, other)
+	case dependingOn:
+		return g.AddAppliesOnRelation(other, first)
+	default:
+		return errors.New("unknown relation type")
+	}
+}
+
+func removeRelation(g *graph.Graph, first, other *graph.Resource, kind int) error {
+	switch kind {
+	case parentOf:
+		return g.RemoveParentRelation(first, other)
+	case appliesOn:
+		return g.RemoveAppliesOnRelation(first, other)
+	case dependingOn:
+		return g.RemoveAppliesOnRelation(other, first)
+	default:
+		return errors.New("unknown relation type")
+	}
+}
+
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
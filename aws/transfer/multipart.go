@@ -0,0 +1,203 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transfer
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// ETag computes the ETag S3 gives to a file: the MD5 of its content when uploaded in one request,
+// the MD5 of the MD5s of its parts followed by the number of parts otherwise
+func ETag(file string, partSize int64) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	if partSize <= 0 || stat.Size() <= partSize {
+		h := md5.New()
+		if _, err = io.Copy(h, f); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	sums := md5.New()
+	count := partsCount(stat.Size(), partSize)
+	for i := int64(0); i < count; i++ {
+		sum, err := partMD5(f, i, partSize, stat.Size())
+		if err != nil {
+			return "", err
+		}
+		sums.Write(sum)
+	}
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sums.Sum(nil)), count), nil
+}
+
+func partsCount(size, partSize int64) int64 {
+	return (size + partSize - 1) / partSize
+}
+
+func partSection(f io.ReaderAt, index, partSize, size int64) *io.SectionReader {
+	offset := index * partSize
+	length := partSize
+	if offset+length > size {
+		length = size - offset
+	}
+	return io.NewSectionReader(f, offset, length)
+}
+
+func partMD5(f io.ReaderAt, index, partSize, size int64) ([]byte, error) {
+	h := md5.New()
+	if _, err := io.Copy(h, partSection(f, index, partSize, size)); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// multipartUpload uploads a file in parts. An upload of the same key left pending by a previous run
+// is resumed: its parts already uploaded with the same content are not sent again
+func (t *Transfer) multipartUpload(f *os.File, size int64, bucket, key, contentType string) error {
+	uploadID, uploaded, err := t.pendingUpload(bucket, key)
+	if err != nil {
+		return err
+	}
+	if uploadID == "" {
+		out, err := t.API.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+			Bucket:      aws.String(bucket),
+			Key:         aws.String(key),
+			ContentType: aws.String(contentType),
+		})
+		if err != nil {
+			return err
+		}
+		uploadID = aws.StringValue(out.UploadId)
+	} else {
+		t.Logger.Verbosef("resuming upload of %s%s/%s (%d part(s) already uploaded)", s3Scheme, bucket, key, len(uploaded))
+	}
+
+	count := partsCount(size, t.PartSize)
+	completed := make([]*s3.CompletedPart, count)
+	parallel := t.Parallel
+	if parallel < 1 {
+		parallel = 1
+	}
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var failure error
+
+	for i := int64(0); i < count; i++ {
+		number := i + 1
+		sum, err := partMD5(f, i, t.PartSize, size)
+		if err != nil {
+			return err
+		}
+		etag := hex.EncodeToString(sum)
+		if uploaded[number] == etag {
+			completed[i] = &s3.CompletedPart{PartNumber: aws.Int64(number), ETag: aws.String(`"` + etag + `"`)}
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(index int64) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			out, err := t.API.UploadPart(&s3.UploadPartInput{
+				Bucket:     aws.String(bucket),
+				Key:        aws.String(key),
+				UploadId:   aws.String(uploadID),
+				PartNumber: aws.Int64(index + 1),
+				Body:       partSection(f, index, t.PartSize, size),
+			})
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if failure == nil {
+					failure = err
+				}
+				return
+			}
+			completed[index] = &s3.CompletedPart{PartNumber: aws.Int64(index + 1), ETag: out.ETag}
+		}(i)
+	}
+	wg.Wait()
+
+	// the upload is not aborted so that the parts already sent are reused by the next run
+	if failure != nil {
+		return fmt.Errorf("upload of part failed (run again to resume): %s", failure)
+	}
+
+	_, err = t.API.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucket),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	})
+	return err
+}
+
+// pendingUpload returns the most recent multipart upload pending for a key with the ETags of its uploaded parts
+func (t *Transfer) pendingUpload(bucket, key string) (string, map[int64]string, error) {
+	uploads, err := t.API.ListMultipartUploads(&s3.ListMultipartUploadsInput{Bucket: aws.String(bucket), Prefix: aws.String(key)})
+	if err != nil {
+		return "", nil, err
+	}
+	var uploadID string
+	var initiated int64
+	for _, up := range uploads.Uploads {
+		if aws.StringValue(up.Key) != key {
+			continue
+		}
+		if at := aws.TimeValue(up.Initiated).UnixNano(); uploadID == "" || at > initiated {
+			uploadID, initiated = aws.StringValue(up.UploadId), at
+		}
+	}
+	if uploadID == "" {
+		return "", nil, nil
+	}
+
+	parts := make(map[int64]string)
+	err = t.API.ListPartsPages(&s3.ListPartsInput{Bucket: aws.String(bucket), Key: aws.String(key), UploadId: aws.String(uploadID)},
+		func(out *s3.ListPartsOutput, lastPage bool) bool {
+			for _, p := range out.Parts {
+				parts[aws.Int64Value(p.PartNumber)] = strings.Trim(aws.StringValue(p.ETag), `"`)
+			}
+			return true
+		})
+	if err != nil {
+		return "", nil, err
+	}
+	return uploadID, parts, nil
+}
@@ -0,0 +1,367 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package transfer copies files between the local filesystem and S3 buckets.
+// Files larger than a part are uploaded in multipart uploads, resumed on the next run when interrupted.
+package transfer
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/wallix/awless/logger"
+)
+
+const (
+	// DefaultPartSize is the size of the parts of multipart uploads, as used by the AWS CLI
+	DefaultPartSize int64 = 8 * 1024 * 1024
+	// MinPartSize is the minimum size S3 accepts for the parts of a multipart upload (but the last one)
+	MinPartSize int64 = 5 * 1024 * 1024
+	// DefaultParallel is the default number of concurrent transfers
+	DefaultParallel = 4
+
+	s3Scheme = "s3://"
+)
+
+const (
+	Upload   = "upload"
+	Download = "download"
+)
+
+// Location is either a local path or an object (or prefix) of a bucket, given as s3://BUCKET/KEY
+type Location struct {
+	Bucket, Key, Path string
+}
+
+func ParseLocation(s string) Location {
+	if !strings.HasPrefix(s, s3Scheme) {
+		return Location{Path: s}
+	}
+	splits := strings.SplitN(strings.TrimPrefix(s, s3Scheme), "/", 2)
+	loc := Location{Bucket: splits[0]}
+	if len(splits) == 2 {
+		loc.Key = splits[1]
+	}
+	return loc
+}
+
+func (l Location) IsRemote() bool {
+	return l.Bucket != ""
+}
+
+func (l Location) String() string {
+	if l.IsRemote() {
+		return s3Scheme + l.Bucket + "/" + l.Key
+	}
+	return l.Path
+}
+
+// Object is the known state of a remote object, used to skip unchanged files
+type Object struct {
+	Key  string
+	Size int64
+	ETag string
+}
+
+// Action is the transfer of a file to or from a bucket
+type Action struct {
+	Kind        string
+	Local       string
+	Bucket, Key string
+	Size        int64
+}
+
+func (a *Action) String() string {
+	if a.Kind == Upload {
+		return fmt.Sprintf("upload %s to %s%s/%s (%d bytes)", a.Local, s3Scheme, a.Bucket, a.Key, a.Size)
+	}
+	return fmt.Sprintf("download %s%s/%s to %s (%d bytes)", s3Scheme, a.Bucket, a.Key, a.Local, a.Size)
+}
+
+// PlanUpload lists the uploads of the file or directory src to dst. Files are skipped when the remote
+// objects (when known) have the same size and ETag. It returns the uploads and the number of skipped files
+func PlanUpload(src string, dst Location, remote map[string]*Object, recursive bool, partSize int64) ([]*Action, int, error) {
+	stat, err := os.Stat(src)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var actions []*Action
+	var skipped int
+	add := func(file string, size int64, key string) error {
+		if obj, ok := remote[key]; ok {
+			same, err := isSameFile(file, size, obj, partSize)
+			if err != nil {
+				return err
+			}
+			if same {
+				skipped++
+				return nil
+			}
+		}
+		actions = append(actions, &Action{Kind: Upload, Local: file, Bucket: dst.Bucket, Key: key, Size: size})
+		return nil
+	}
+
+	if !stat.IsDir() {
+		key := dst.Key
+		if key == "" || strings.HasSuffix(key, "/") {
+			key = key + filepath.Base(src)
+		}
+		return actions, skipped, add(src, stat.Size(), key)
+	}
+	if !recursive {
+		return nil, 0, fmt.Errorf("'%s' is a directory (use recursive to upload its content)", src)
+	}
+
+	err = filepath.Walk(src, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(src, file)
+		if err != nil {
+			return err
+		}
+		return add(file, info.Size(), joinKey(dst.Key, filepath.ToSlash(rel)))
+	})
+	return actions, skipped, err
+}
+
+// PlanDownload lists the downloads of the remote objects of src to the file or directory dst.
+// With skipUnchanged, objects are skipped when the local files have the same size and ETag.
+// It returns the downloads and the number of skipped objects
+func PlanDownload(src Location, objects []*Object, dst string, recursive, skipUnchanged bool) ([]*Action, int, error) {
+	var actions []*Action
+	var skipped int
+
+	if !recursive {
+		for _, obj := range objects {
+			if obj.Key != src.Key {
+				continue
+			}
+			file := dst
+			if stat, err := os.Stat(dst); (err == nil && stat.IsDir()) || strings.HasSuffix(dst, string(os.PathSeparator)) {
+				file = filepath.Join(dst, path.Base(obj.Key))
+			}
+			return []*Action{{Kind: Download, Local: file, Bucket: src.Bucket, Key: obj.Key, Size: obj.Size}}, 0, nil
+		}
+		return nil, 0, fmt.Errorf("object '%s' not found", src)
+	}
+
+	prefix := src.Key
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix = prefix + "/"
+	}
+	for _, obj := range objects {
+		// keys ending with a slash are folders created from the console
+		if !strings.HasPrefix(obj.Key, prefix) || strings.HasSuffix(obj.Key, "/") {
+			continue
+		}
+		file := filepath.Join(dst, filepath.FromSlash(strings.TrimPrefix(obj.Key, prefix)))
+		if stat, err := os.Stat(file); skipUnchanged && err == nil && !stat.IsDir() {
+			same, err := isSameFile(file, stat.Size(), obj, DefaultPartSize)
+			if err != nil {
+				return nil, 0, err
+			}
+			if same {
+				skipped++
+				continue
+			}
+		}
+		actions = append(actions, &Action{Kind: Download, Local: file, Bucket: src.Bucket, Key: obj.Key, Size: obj.Size})
+	}
+	return actions, skipped, nil
+}
+
+func joinKey(prefix, rel string) string {
+	if prefix == "" || strings.HasSuffix(prefix, "/") {
+		return prefix + rel
+	}
+	return prefix + "/" + rel
+}
+
+// isSameFile compares a local file to a remote object given their size and ETag.
+// The ETag of an object uploaded in several parts can only be compared when uploaded with the same part size
+func isSameFile(file string, size int64, obj *Object, partSize int64) (bool, error) {
+	remoteETag := strings.Trim(obj.ETag, `"`)
+	if size != obj.Size || remoteETag == "" {
+		return false, nil
+	}
+	if i := strings.Index(remoteETag, "-"); i < 0 {
+		partSize = 0
+	} else if partSize <= 0 || remoteETag[i+1:] != fmt.Sprint(partsCount(size, partSize)) {
+		return false, nil
+	}
+	etag, err := ETag(file, partSize)
+	if err != nil {
+		return false, err
+	}
+	return etag == remoteETag, nil
+}
+
+// Transfer runs uploads and downloads with a bounded number of concurrent transfers
+type Transfer struct {
+	API      s3iface.S3API
+	Parallel int
+	PartSize int64
+	Logger   *logger.Logger
+}
+
+func New(api s3iface.S3API) *Transfer {
+	return &Transfer{API: api, Parallel: DefaultParallel, PartSize: DefaultPartSize, Logger: logger.DiscardLogger}
+}
+
+// Run transfers all the actions, returning the errors of the failed ones once all are done
+func (t *Transfer) Run(actions []*Action) error {
+	parallel := t.Parallel
+	if parallel < 1 {
+		parallel = 1
+	}
+	sem := make(chan struct{}, parallel)
+	var mu sync.Mutex
+	var errs []string
+	var wg sync.WaitGroup
+
+	for _, action := range actions {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(a *Action) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			var err error
+			switch a.Kind {
+			case Upload:
+				err = t.Upload(a)
+			case Download:
+				err = t.Download(a)
+			default:
+				err = fmt.Errorf("unknown transfer '%s'", a.Kind)
+			}
+			if err != nil {
+				t.Logger.Errorf("%s: %s", a, err)
+				mu.Lock()
+				errs = append(errs, err.Error())
+				mu.Unlock()
+				return
+			}
+			t.Logger.Verbosef("%s done", a)
+		}(action)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("%d/%d transfer(s) failed:\n%s", len(errs), len(actions), strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// Upload uploads a file in one request when it fits in a part, in a multipart upload otherwise
+func (t *Transfer) Upload(a *Action) error {
+	f, err := os.Open(a.Local)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	contentType := ContentType(a.Local, f)
+	if stat.Size() > t.PartSize {
+		return t.multipartUpload(f, stat.Size(), a.Bucket, a.Key, contentType)
+	}
+
+	_, err = t.API.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(a.Bucket),
+		Key:         aws.String(a.Key),
+		Body:        io.NewSectionReader(f, 0, stat.Size()),
+		ContentType: aws.String(contentType),
+	})
+	return err
+}
+
+// Download writes an object to a temporary file renamed once complete, so that an interrupted
+// download never leaves a truncated file
+func (t *Transfer) Download(a *Action) error {
+	if err := os.MkdirAll(filepath.Dir(a.Local), 0755); err != nil {
+		return err
+	}
+	out, err := t.API.GetObject(&s3.GetObjectInput{Bucket: aws.String(a.Bucket), Key: aws.String(a.Key)})
+	if err != nil {
+		return err
+	}
+	defer out.Body.Close()
+
+	tmp := a.Local + ".awless-download"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, out.Body); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err = f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, a.Local)
+}
+
+// ContentType detects the content type of a file from its extension, or from its first bytes otherwise
+func ContentType(file string, r io.ReaderAt) string {
+	if ct := mime.TypeByExtension(filepath.Ext(file)); ct != "" {
+		return ct
+	}
+	buf := make([]byte, 512)
+	n, err := r.ReadAt(buf, 0)
+	if err != nil && err != io.EOF {
+		return "application/octet-stream"
+	}
+	return http.DetectContentType(buf[:n])
+}
+
+// ListObjects lists the objects of a bucket under a prefix
+func (t *Transfer) ListObjects(bucket, prefix string) ([]*Object, error) {
+	var objects []*Object
+	err := t.API.ListObjectsPages(&s3.ListObjectsInput{Bucket: aws.String(bucket), Prefix: aws.String(prefix)},
+		func(out *s3.ListObjectsOutput, lastPage bool) bool {
+			for _, obj := range out.Contents {
+				objects = append(objects, &Object{Key: aws.StringValue(obj.Key), Size: aws.Int64Value(obj.Size), ETag: aws.StringValue(obj.ETag)})
+			}
+			return true
+		})
+	return objects, err
+}
@@ -0,0 +1,462 @@
+package transfer
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+func TestParseLocation(t *testing.T) {
+	tcases := []struct {
+		in  string
+		exp Location
+	}{
+		{in: "s3://mybucket", exp: Location{Bucket: "mybucket"}},
+		{in: "s3://mybucket/", exp: Location{Bucket: "mybucket"}},
+		{in: "s3://mybucket/dir/file.txt", exp: Location{Bucket: "mybucket", Key: "dir/file.txt"}},
+		{in: "./dir/file.txt", exp: Location{Path: "./dir/file.txt"}},
+	}
+	for i, tcase := range tcases {
+		if got, want := ParseLocation(tcase.in), tcase.exp; got != want {
+			t.Fatalf("%d: got %+v, want %+v", i+1, got, want)
+		}
+	}
+}
+
+func TestETag(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	content := []byte("0123456789")
+	file := writeFile(t, dir, "file", content)
+
+	if got, want := mustETag(t, file, 0), md5Hex(content); got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := mustETag(t, file, 10), md5Hex(content); got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	var sums []byte
+	for _, part := range []string{"0123", "4567", "89"} {
+		sum := md5.Sum([]byte(part))
+		sums = append(sums, sum[:]...)
+	}
+	if got, want := mustETag(t, file, 4), md5Hex(sums)+"-3"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestContentType(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	tcases := []struct {
+		name, content, exp string
+	}{
+		{name: "index.html", content: "<p>hello</p>", exp: "text/html; charset=utf-8"},
+		{name: "image.png", content: "", exp: "image/png"},
+		{name: "README", content: "hello world", exp: "text/plain; charset=utf-8"},
+		{name: "page", content: "<!DOCTYPE html><html></html>", exp: "text/html; charset=utf-8"},
+		{name: "archive", content: "\x1f\x8b\x08", exp: "application/x-gzip"},
+	}
+	for i, tcase := range tcases {
+		f, err := os.Open(writeFile(t, dir, tcase.name, []byte(tcase.content)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := ContentType(tcase.name, f), tcase.exp; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+		f.Close()
+	}
+}
+
+func TestPlanUpload(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	writeFile(t, dir, "unchanged.txt", []byte("same"))
+	writeFile(t, dir, "modified.txt", []byte("new content"))
+	writeFile(t, dir, "sub/new.txt", []byte("new"))
+
+	remote := map[string]*Object{
+		"backup/unchanged.txt": {Key: "backup/unchanged.txt", Size: 4, ETag: `"` + md5Hex([]byte("same")) + `"`},
+		"backup/modified.txt":  {Key: "backup/modified.txt", Size: 11, ETag: `"` + md5Hex([]byte("old content")) + `"`},
+	}
+
+	actions, skipped, err := PlanUpload(dir, Location{Bucket: "mybucket", Key: "backup"}, remote, true, DefaultPartSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := skipped, 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := actionKeys(actions), []string{"backup/modified.txt", "backup/sub/new.txt"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for _, a := range actions {
+		if a.Kind != Upload || a.Bucket != "mybucket" {
+			t.Fatalf("unexpected action %s", a)
+		}
+	}
+
+	if _, _, err = PlanUpload(dir, Location{Bucket: "mybucket"}, nil, false, DefaultPartSize); err == nil {
+		t.Fatal("expected error for directory without recursive")
+	}
+
+	actions, _, err = PlanUpload(filepath.Join(dir, "sub", "new.txt"), Location{Bucket: "mybucket", Key: "dest/"}, nil, false, DefaultPartSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := actionKeys(actions), []string{"dest/new.txt"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestPlanDownload(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	writeFile(t, dir, "unchanged.txt", []byte("same"))
+
+	objects := []*Object{
+		{Key: "backup/", Size: 0},
+		{Key: "backup/unchanged.txt", Size: 4, ETag: `"` + md5Hex([]byte("same")) + `"`},
+		{Key: "backup/sub/new.txt", Size: 3, ETag: `"` + md5Hex([]byte("new")) + `"`},
+		{Key: "other/file.txt", Size: 3},
+	}
+
+	actions, skipped, err := PlanDownload(Location{Bucket: "mybucket", Key: "backup"}, objects, dir, true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := skipped, 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := len(actions), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := actions[0].Local, filepath.Join(dir, "sub", "new.txt"); got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	actions, skipped, err = PlanDownload(Location{Bucket: "mybucket", Key: "backup"}, objects, dir, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(actions), 2; got != want || skipped != 0 {
+		t.Fatalf("got %d (%d skipped), want %d", got, skipped, want)
+	}
+
+	actions, _, err = PlanDownload(Location{Bucket: "mybucket", Key: "other/file.txt"}, objects, dir, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := actions[0].Local, filepath.Join(dir, "file.txt"); got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if _, _, err = PlanDownload(Location{Bucket: "mybucket", Key: "missing"}, objects, dir, false, false); err == nil {
+		t.Fatal("expected error for missing object")
+	}
+}
+
+func TestUploadAndDownload(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	writeFile(t, dir, "src/small.txt", []byte("small"))
+	big := bytes.Repeat([]byte("0123456789"), 5)
+	writeFile(t, dir, "src/big.bin", big)
+
+	mock := newMockS3()
+	tr := New(mock)
+	tr.PartSize = 16
+	tr.Parallel = 2
+
+	actions, _, err := PlanUpload(filepath.Join(dir, "src"), Location{Bucket: "mybucket", Key: "data"}, nil, true, tr.PartSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = tr.Run(actions); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(mock.objects["data/small.txt"]), "small"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := mock.objects["data/big.bin"], big; !bytes.Equal(got, want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := mock.contentTypes["data/small.txt"], "text/plain; charset=utf-8"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := mock.partsUploaded, 4; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	objects, err := tr.ListObjects("mybucket", "data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	remote := make(map[string]*Object)
+	for _, obj := range objects {
+		remote[obj.Key] = obj
+	}
+	actions, skipped, err := PlanUpload(filepath.Join(dir, "src"), Location{Bucket: "mybucket", Key: "data"}, remote, true, tr.PartSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(actions), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := skipped, 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	actions, _, err = PlanDownload(Location{Bucket: "mybucket", Key: "data"}, objects, filepath.Join(dir, "dst"), true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = tr.Run(actions); err != nil {
+		t.Fatal(err)
+	}
+	content, err := ioutil.ReadFile(filepath.Join(dir, "dst", "big.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := content, big; !bytes.Equal(got, want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestResumeMultipartUpload(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	content := bytes.Repeat([]byte("abcdefgh"), 6)
+	file := writeFile(t, dir, "big.bin", content)
+
+	mock := newMockS3()
+	mock.failPart = 3
+	tr := New(mock)
+	tr.PartSize = 16
+	tr.Parallel = 1
+
+	action := &Action{Kind: Upload, Local: file, Bucket: "mybucket", Key: "big.bin", Size: int64(len(content))}
+	if err := tr.Run([]*Action{action}); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, ok := mock.objects["big.bin"]; ok {
+		t.Fatal("object should not be complete")
+	}
+	if got, want := mock.partsUploaded, 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	mock.failPart = 0
+	if err := tr.Run([]*Action{action}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := mock.partsUploaded, 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := mock.objects["big.bin"], content; !bytes.Equal(got, want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := len(mock.uploads), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+type mockUpload struct {
+	key, contentType string
+	parts            map[int64][]byte
+}
+
+type mockS3 struct {
+	s3iface.S3API
+	mu            sync.Mutex
+	objects       map[string][]byte
+	etags         map[string]string
+	contentTypes  map[string]string
+	uploads       map[string]*mockUpload
+	partsUploaded int
+	failPart      int64
+}
+
+func newMockS3() *mockS3 {
+	return &mockS3{objects: make(map[string][]byte), etags: make(map[string]string), contentTypes: make(map[string]string), uploads: make(map[string]*mockUpload)}
+}
+
+func (m *mockS3) PutObject(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	content, err := ioutil.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := aws.StringValue(input.Key)
+	m.objects[key] = content
+	m.etags[key] = `"` + md5Hex(content) + `"`
+	m.contentTypes[key] = aws.StringValue(input.ContentType)
+	return &s3.PutObjectOutput{ETag: aws.String(m.etags[key])}, nil
+}
+
+func (m *mockS3) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	content, ok := m.objects[aws.StringValue(input.Key)]
+	if !ok {
+		return nil, errors.New("NoSuchKey")
+	}
+	return &s3.GetObjectOutput{Body: ioutil.NopCloser(bytes.NewReader(content))}, nil
+}
+
+func (m *mockS3) ListObjectsPages(input *s3.ListObjectsInput, fn func(*s3.ListObjectsOutput, bool) bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []string
+	for k := range m.objects {
+		if strings.HasPrefix(k, aws.StringValue(input.Prefix)) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	// one object per page to exercise pagination
+	for i, k := range keys {
+		out := &s3.ListObjectsOutput{Contents: []*s3.Object{{Key: aws.String(k), Size: aws.Int64(int64(len(m.objects[k]))), ETag: aws.String(m.etags[k])}}}
+		if !fn(out, i == len(keys)-1) {
+			break
+		}
+	}
+	return nil
+}
+
+func (m *mockS3) CreateMultipartUpload(input *s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := fmt.Sprintf("upload-%d", len(m.uploads)+1)
+	m.uploads[id] = &mockUpload{key: aws.StringValue(input.Key), contentType: aws.StringValue(input.ContentType), parts: make(map[int64][]byte)}
+	return &s3.CreateMultipartUploadOutput{UploadId: aws.String(id)}, nil
+}
+
+func (m *mockS3) ListMultipartUploads(input *s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := &s3.ListMultipartUploadsOutput{}
+	for id, up := range m.uploads {
+		if strings.HasPrefix(up.key, aws.StringValue(input.Prefix)) {
+			out.Uploads = append(out.Uploads, &s3.MultipartUpload{Key: aws.String(up.key), UploadId: aws.String(id)})
+		}
+	}
+	return out, nil
+}
+
+func (m *mockS3) ListPartsPages(input *s3.ListPartsInput, fn func(*s3.ListPartsOutput, bool) bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	up, ok := m.uploads[aws.StringValue(input.UploadId)]
+	if !ok {
+		return errors.New("NoSuchUpload")
+	}
+	out := &s3.ListPartsOutput{}
+	for n, content := range up.parts {
+		out.Parts = append(out.Parts, &s3.Part{PartNumber: aws.Int64(n), ETag: aws.String(`"` + md5Hex(content) + `"`)})
+	}
+	fn(out, true)
+	return nil
+}
+
+func (m *mockS3) UploadPart(input *s3.UploadPartInput) (*s3.UploadPartOutput, error) {
+	if aws.Int64Value(input.PartNumber) == m.failPart {
+		return nil, errors.New("connection reset")
+	}
+	content, err := ioutil.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	up, ok := m.uploads[aws.StringValue(input.UploadId)]
+	if !ok {
+		return nil, errors.New("NoSuchUpload")
+	}
+	up.parts[aws.Int64Value(input.PartNumber)] = content
+	m.partsUploaded++
+	return &s3.UploadPartOutput{ETag: aws.String(`"` + md5Hex(content) + `"`)}, nil
+}
+
+func (m *mockS3) CompleteMultipartUpload(input *s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := aws.StringValue(input.UploadId)
+	up, ok := m.uploads[id]
+	if !ok {
+		return nil, errors.New("NoSuchUpload")
+	}
+	var content, sums []byte
+	for i, part := range input.MultipartUpload.Parts {
+		n := aws.Int64Value(part.PartNumber)
+		if n != int64(i+1) {
+			return nil, fmt.Errorf("InvalidPartOrder: %d", n)
+		}
+		if got, want := aws.StringValue(part.ETag), `"`+md5Hex(up.parts[n])+`"`; got != want {
+			return nil, fmt.Errorf("InvalidPart %d: %s, want %s", n, got, want)
+		}
+		sum := md5.Sum(up.parts[n])
+		sums = append(sums, sum[:]...)
+		content = append(content, up.parts[n]...)
+	}
+	m.objects[up.key] = content
+	m.etags[up.key] = fmt.Sprintf(`"%s-%d"`, md5Hex(sums), len(input.MultipartUpload.Parts))
+	m.contentTypes[up.key] = up.contentType
+	delete(m.uploads, id)
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "awless-transfer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func writeFile(t *testing.T, dir, name string, content []byte) string {
+	file := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(file, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func mustETag(t *testing.T, file string, partSize int64) string {
+	etag, err := ETag(file, partSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return etag
+}
+
+func md5Hex(b []byte) string {
+	sum := md5.Sum(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func actionKeys(actions []*Action) (keys []string) {
+	for _, a := range actions {
+		keys = append(keys, a.Key)
+	}
+	sort.Strings(keys)
+	return
+}
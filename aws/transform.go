@@ -17,20 +17,34 @@ limitations under the License.
 package aws
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/graph"
 )
 
@@ -54,6 +68,19 @@ func initResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(awssdk.StringValue(ss.InternetGatewayId), graph.InternetGateway)
 	case *ec2.RouteTable:
 		res = graph.InitResource(awssdk.StringValue(ss.RouteTableId), graph.RouteTable)
+	case *ec2.NatGateway:
+		res = graph.InitResource(awssdk.StringValue(ss.NatGatewayId), graph.NatGateway)
+	case *ec2.Address:
+		// Addresses of EC2-Classic have no allocation id
+		id := awssdk.StringValue(ss.AllocationId)
+		if id == "" {
+			id = awssdk.StringValue(ss.PublicIp)
+		}
+		res = graph.InitResource(id, graph.ElasticIP)
+	case *ec2.Image:
+		res = graph.InitResource(awssdk.StringValue(ss.ImageId), graph.Image)
+	case *ec2.Snapshot:
+		res = graph.InitResource(awssdk.StringValue(ss.SnapshotId), graph.Snapshot)
 	case *ec2.AvailabilityZone:
 		res = graph.InitResource(awssdk.StringValue(ss.ZoneName), graph.AvailabilityZone)
 	// Loadbalancer
@@ -61,6 +88,8 @@ func initResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(awssdk.StringValue(ss.LoadBalancerArn), graph.LoadBalancer)
 	case *elbv2.TargetGroup:
 		res = graph.InitResource(awssdk.StringValue(ss.TargetGroupArn), graph.TargetGroup)
+	case *elb.LoadBalancerDescription:
+		res = graph.InitResource(awssdk.StringValue(ss.LoadBalancerName), graph.ClassicLoadBalancer)
 	case *elbv2.Listener:
 		res = graph.InitResource(awssdk.StringValue(ss.ListenerArn), graph.Listener)
 	// IAM
@@ -86,13 +115,61 @@ func initResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(awssdk.StringValue(ss.Endpoint), graph.Subscription)
 	case *sns.Topic:
 		res = graph.InitResource(awssdk.StringValue(ss.TopicArn), graph.Topic)
+	// Database
+	case *rds.DBInstance:
+		res = graph.InitResource(awssdk.StringValue(ss.DBInstanceIdentifier), graph.Database)
+	case *rds.DBSubnetGroup:
+		res = graph.InitResource(awssdk.StringValue(ss.DBSubnetGroupName), graph.DbSubnetGroup)
+	case *rds.DBParameterGroup:
+		res = graph.InitResource(awssdk.StringValue(ss.DBParameterGroupName), graph.DbParameterGroup)
+	// Lambda
+	case *lambda.FunctionConfiguration:
+		res = graph.InitResource(awssdk.StringValue(ss.FunctionArn), graph.Function)
+	// DNS
+	case *route53.HostedZone:
+		res = graph.InitResource(awssdk.StringValue(ss.Id), graph.Zone)
+	case *route53.ResourceRecordSet:
+		// Records are only unique within their zone: the fetcher sets their final id (see newRecordResource)
+		res = graph.InitResource(recordId("", ss), graph.Record)
+	// Autoscaling
+	case *autoscaling.LaunchConfiguration:
+		res = graph.InitResource(awssdk.StringValue(ss.LaunchConfigurationName), graph.LaunchConfiguration)
+	case *autoscaling.Group:
+		res = graph.InitResource(awssdk.StringValue(ss.AutoScalingGroupName), graph.ScalingGroup)
+	// Monitoring
+	case *cloudwatch.MetricAlarm:
+		res = graph.InitResource(awssdk.StringValue(ss.AlarmName), graph.Alarm)
+	// Stack
+	case *cloudformation.Stack:
+		res = graph.InitResource(awssdk.StringValue(ss.StackId), graph.Stack)
+	// Container
+	case *ecs.Cluster:
+		res = graph.InitResource(awssdk.StringValue(ss.ClusterArn), graph.Cluster)
+	case *ecs.Service:
+		res = graph.InitResource(awssdk.StringValue(ss.ServiceArn), graph.ContainerService)
+	case *ecs.TaskDefinition:
+		res = graph.InitResource(awssdk.StringValue(ss.TaskDefinitionArn), graph.TaskDefinition)
+	case *ecs.Task:
+		res = graph.InitResource(awssdk.StringValue(ss.TaskArn), graph.Task)
+	case *ecs.ContainerInstance:
+		res = graph.InitResource(awssdk.StringValue(ss.ContainerInstanceArn), graph.ContainerInstance)
+	// Encryption
+	case *kms.KeyMetadata:
+		res = graph.InitResource(awssdk.StringValue(ss.KeyId), graph.Key)
+	case *kms.AliasListEntry:
+		res = graph.InitResource(awssdk.StringValue(ss.AliasName), graph.KeyAlias)
+	// Nosql
+	case *dynamodb.TableDescription:
+		res = graph.InitResource(awssdk.StringValue(ss.TableName), graph.Table)
 	default:
 		return nil, fmt.Errorf("Unknown type of resource %T", source)
 	}
 	return res, nil
 }
 
-func newResource(source interface{}) (*graph.Resource, error) {
+// newResource builds the resource of a cloud object, fetching the properties that
+// need extra API calls with the clients of the given service
+func newResource(srv cloud.Service, source interface{}) (*graph.Resource, error) {
 	res, err := initResource(source)
 	if err != nil {
 		return res, err
@@ -116,7 +193,7 @@ func newResource(source interface{}) (*graph.Resource, error) {
 				sourceField := nodeV.FieldByName(t.name)
 				if sourceField.IsValid() && !sourceField.IsNil() {
 					val, err := t.transform(sourceField.Interface())
-					if err == ErrTagNotFound {
+					if err == ErrTagNotFound || err == ErrFieldNotSet {
 						return
 					}
 					if err != nil {
@@ -127,7 +204,10 @@ func newResource(source interface{}) (*graph.Resource, error) {
 				}
 			}
 			if t.fetch != nil {
-				val, err := t.fetch(source)
+				val, err := t.fetch(srv, source)
+				if err == ErrFieldNotSet {
+					return
+				}
 				if err != nil {
 					errc <- err
 				}
@@ -161,6 +241,7 @@ func newResource(source interface{}) (*graph.Resource, error) {
 
 var ErrTagNotFound = errors.New("aws tag key not found")
 var ErrFieldNotFound = errors.New("aws struct field not found")
+var ErrFieldNotSet = errors.New("aws struct field not set")
 
 type propertyTransform struct {
 	name      string
@@ -169,7 +250,9 @@ type propertyTransform struct {
 }
 
 type transformFn func(i interface{}) (interface{}, error)
-type fetchFn func(i interface{}) (interface{}, error)
+
+// fetchFn fetches a property of a resource with the clients of the service fetching it
+type fetchFn func(srv cloud.Service, i interface{}) (interface{}, error)
 
 var extractValueFn = func(i interface{}) (interface{}, error) {
 	iv := reflect.ValueOf(i)
@@ -188,6 +271,25 @@ var extractTimeFn = func(i interface{}) (interface{}, error) {
 	return t.UTC(), nil
 }
 
+const lambdaTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+const imageTimeLayout = "2006-01-02T15:04:05.000Z"
+
+// Extract time from a string pointer formatted with layout, forcing timezone to UTC
+var extractStringTimeFn = func(layout string) transformFn {
+	return func(i interface{}) (interface{}, error) {
+		s, ok := i.(*string)
+		if !ok {
+			return nil, fmt.Errorf("expected string pointer, got: %T", i)
+		}
+		t, err := time.Parse(layout, awssdk.StringValue(s))
+		if err != nil {
+			return nil, err
+		}
+		return t.UTC(), nil
+	}
+}
+
 var extractIpPermissionSliceFn = func(i interface{}) (interface{}, error) {
 	if _, ok := i.([]*ec2.IpPermission); !ok {
 		return nil, fmt.Errorf("aws type unknown: %T", i)
@@ -252,11 +354,155 @@ var extractFieldFn = func(field string) transformFn {
 		if !structField.IsValid() {
 			return nil, ErrFieldNotFound
 		}
+		if structField.Kind() == reflect.Ptr && structField.IsNil() {
+			return nil, ErrFieldNotSet
+		}
 
 		return extractValueFn(structField.Interface())
 	}
 }
 
+var extractStringSliceFieldFn = func(field string) transformFn {
+	return func(i interface{}) (interface{}, error) {
+		value := reflect.ValueOf(i)
+		if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+			return nil, fmt.Errorf("aws type unknown: %T", i)
+		}
+		structField := value.Elem().FieldByName(field)
+		if !structField.IsValid() {
+			return nil, ErrFieldNotFound
+		}
+		strs, ok := structField.Interface().([]*string)
+		if !ok {
+			return nil, fmt.Errorf("aws type invalid: %s is %T, expected string slice", field, structField.Interface())
+		}
+		var res []interface{}
+		for _, s := range strs {
+			res = append(res, awssdk.StringValue(s))
+		}
+		return res, nil
+	}
+}
+
+var extractStringSliceFn = func(i interface{}) (interface{}, error) {
+	strs, ok := i.([]*string)
+	if !ok {
+		return nil, fmt.Errorf("aws type invalid: %T, expected string slice", i)
+	}
+	var res []interface{}
+	for _, s := range strs {
+		res = append(res, awssdk.StringValue(s))
+	}
+	return res, nil
+}
+
+// Extract the values of a comma separated list (ex: subnets of an autoscaling group)
+var extractCommaSeparatedFn = func(i interface{}) (interface{}, error) {
+	s, ok := i.(*string)
+	if !ok {
+		return nil, fmt.Errorf("expected string pointer, got: %T", i)
+	}
+	var res []interface{}
+	for _, v := range strings.Split(awssdk.StringValue(s), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+// Extract the ETag of an object without the double quotes S3 wraps it with
+var extractETagFn = func(i interface{}) (interface{}, error) {
+	s, ok := i.(*string)
+	if !ok {
+		return nil, fmt.Errorf("expected string pointer, got: %T", i)
+	}
+	return strings.Trim(awssdk.StringValue(s), `"`), nil
+}
+
+// Extract the dimensions of a metric as Name:Value (ex: InstanceId:i-12345678)
+var extractAlarmDimensionsFn = func(i interface{}) (interface{}, error) {
+	dimensions, ok := i.([]*cloudwatch.Dimension)
+	if !ok {
+		return nil, fmt.Errorf("aws model: unexpected type %T", i)
+	}
+	var res []interface{}
+	for _, d := range dimensions {
+		res = append(res, fmt.Sprintf("%s:%s", awssdk.StringValue(d.Name), awssdk.StringValue(d.Value)))
+	}
+	return res, nil
+}
+
+// Extract the parameters of a stack as Key:Value
+var extractStackParametersFn = func(i interface{}) (interface{}, error) {
+	parameters, ok := i.([]*cloudformation.Parameter)
+	if !ok {
+		return nil, fmt.Errorf("aws model: unexpected type %T", i)
+	}
+	var res []interface{}
+	for _, p := range parameters {
+		res = append(res, fmt.Sprintf("%s:%s", awssdk.StringValue(p.ParameterKey), awssdk.StringValue(p.ParameterValue)))
+	}
+	return res, nil
+}
+
+// Extract the outputs of a stack as Key:Value
+var extractStackOutputsFn = func(i interface{}) (interface{}, error) {
+	outputs, ok := i.([]*cloudformation.Output)
+	if !ok {
+		return nil, fmt.Errorf("aws model: unexpected type %T", i)
+	}
+	var res []interface{}
+	for _, o := range outputs {
+		res = append(res, fmt.Sprintf("%s:%s", awssdk.StringValue(o.OutputKey), awssdk.StringValue(o.OutputValue)))
+	}
+	return res, nil
+}
+
+// Extract the listeners of a classic load balancer as Protocol:Port->InstanceProtocol:InstancePort (ex: HTTP:80->HTTP:8080)
+var extractClassicListenersFn = func(i interface{}) (interface{}, error) {
+	listeners, ok := i.([]*elb.ListenerDescription)
+	if !ok {
+		return nil, fmt.Errorf("aws model: unexpected type %T", i)
+	}
+	var res []interface{}
+	for _, l := range listeners {
+		if l.Listener == nil {
+			continue
+		}
+		res = append(res, fmt.Sprintf("%s:%d->%s:%d", awssdk.StringValue(l.Listener.Protocol), awssdk.Int64Value(l.Listener.LoadBalancerPort), awssdk.StringValue(l.Listener.InstanceProtocol), awssdk.Int64Value(l.Listener.InstancePort)))
+	}
+	return res, nil
+}
+
+// Extract the key schema of a table as AttributeName:KeyType (ex: id:HASH)
+var extractTableKeySchemaFn = func(i interface{}) (interface{}, error) {
+	keys, ok := i.([]*dynamodb.KeySchemaElement)
+	if !ok {
+		return nil, fmt.Errorf("aws model: unexpected type %T", i)
+	}
+	var res []interface{}
+	for _, k := range keys {
+		res = append(res, fmt.Sprintf("%s:%s", awssdk.StringValue(k.AttributeName), awssdk.StringValue(k.KeyType)))
+	}
+	return res, nil
+}
+
+// Extract the ids of the EBS snapshots of the block devices of an image
+var extractImageSnapshotsFn = func(i interface{}) (interface{}, error) {
+	mappings, ok := i.([]*ec2.BlockDeviceMapping)
+	if !ok {
+		return nil, fmt.Errorf("aws model: unexpected type %T", i)
+	}
+	var res []interface{}
+	for _, m := range mappings {
+		if m.Ebs != nil && notEmpty(m.Ebs.SnapshotId) {
+			res = append(res, awssdk.StringValue(m.Ebs.SnapshotId))
+		}
+	}
+	return res, nil
+}
+
 var extractTagFn = func(key string) transformFn {
 	return func(i interface{}) (interface{}, error) {
 		tags, ok := i.([]*ec2.Tag)
@@ -365,13 +611,17 @@ var extractHasATrueBoolInStructSliceFn = func(key string) transformFn {
 	}
 }
 
-var fetchAndExtractGrantsFn = func(i interface{}) (interface{}, error) {
+var fetchAndExtractGrantsFn = func(srv cloud.Service, i interface{}) (interface{}, error) {
 	b, ok := i.(*s3.Bucket)
 	if !ok {
 		return nil, fmt.Errorf("aws type unknown: %T", i)
 	}
+	api, err := storageAPI(srv)
+	if err != nil {
+		return nil, err
+	}
 
-	acls, err := StorageService.(s3iface.S3API).GetBucketAcl(&s3.GetBucketAclInput{Bucket: b.Name})
+	acls, err := api.GetBucketAcl(&s3.GetBucketAclInput{Bucket: b.Name})
 	if err != nil {
 		return nil, err
 	}
@@ -394,6 +644,329 @@ var fetchAndExtractGrantsFn = func(i interface{}) (interface{}, error) {
 	return grants, nil
 }
 
+var fetchBucketPolicyFn = func(srv cloud.Service, i interface{}) (interface{}, error) {
+	b, ok := i.(*s3.Bucket)
+	if !ok {
+		return nil, fmt.Errorf("aws type unknown: %T", i)
+	}
+	api, err := storageAPI(srv)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := getBucketPolicy(api, b)
+	if err != nil {
+		return nil, err
+	}
+	if policy == "" {
+		return nil, ErrFieldNotSet
+	}
+	return policy, nil
+}
+
+// storageAPI returns the client of the storage service fetching a bucket
+func storageAPI(srv cloud.Service) (s3iface.S3API, error) {
+	storage, ok := srv.(*Storage)
+	if !ok {
+		return nil, fmt.Errorf("fetch bucket: not fetched by storage, but by %T", srv)
+	}
+	return storage.S3API, nil
+}
+
+func getBucketPolicy(api s3iface.S3API, b *s3.Bucket) (string, error) {
+	out, err := api.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: b.Name})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucketPolicy" {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return awssdk.StringValue(out.Policy), nil
+}
+
+// getBucketEncryption returns the default encryption of the objects of a bucket, or nil when not encrypted
+func getBucketEncryption(api s3iface.S3API, b *s3.Bucket) (*s3.ServerSideEncryptionByDefault, error) {
+	out, err := api.GetBucketEncryption(&s3.GetBucketEncryptionInput{Bucket: b.Name})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ServerSideEncryptionConfigurationNotFoundError" {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if out.ServerSideEncryptionConfiguration == nil {
+		return nil, nil
+	}
+	for _, r := range out.ServerSideEncryptionConfiguration.Rules {
+		if r.ApplyServerSideEncryptionByDefault != nil {
+			return r.ApplyServerSideEncryptionByDefault, nil
+		}
+	}
+	return nil, nil
+}
+
+// getBucketEncryptionKey returns the KMS key encrypting by default the objects of a bucket,
+// or an empty string when the bucket is not encrypted with KMS
+func getBucketEncryptionKey(api s3iface.S3API, b *s3.Bucket) (string, error) {
+	def, err := getBucketEncryption(api, b)
+	if err != nil || def == nil {
+		return "", err
+	}
+	if awssdk.StringValue(def.SSEAlgorithm) != s3.ServerSideEncryptionAwsKms {
+		return "", nil
+	}
+	return awssdk.StringValue(def.KMSMasterKeyID), nil
+}
+
+// fetchBucketEncryptionFn returns the algorithm encrypting by default the objects of a bucket (AES256 or aws:kms)
+var fetchBucketEncryptionFn = func(srv cloud.Service, i interface{}) (interface{}, error) {
+	b, ok := i.(*s3.Bucket)
+	if !ok {
+		return nil, fmt.Errorf("aws type unknown: %T", i)
+	}
+	api, err := storageAPI(srv)
+	if err != nil {
+		return nil, err
+	}
+	def, err := getBucketEncryption(api, b)
+	if err != nil {
+		return nil, err
+	}
+	if def == nil || !notEmpty(def.SSEAlgorithm) {
+		return nil, ErrFieldNotSet
+	}
+	return awssdk.StringValue(def.SSEAlgorithm), nil
+}
+
+// getBucketPublicAccessBlock returns the public access block of a bucket, or nil when not configured
+func getBucketPublicAccessBlock(api s3iface.S3API, b *s3.Bucket) (*s3.PublicAccessBlockConfiguration, error) {
+	out, err := api.GetPublicAccessBlock(&s3.GetPublicAccessBlockInput{Bucket: b.Name})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchPublicAccessBlockConfiguration" {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out.PublicAccessBlockConfiguration, nil
+}
+
+// fetchBucketPublicAccessBlockFn returns the settings of the public access block of a bucket that are on
+var fetchBucketPublicAccessBlockFn = func(srv cloud.Service, i interface{}) (interface{}, error) {
+	b, ok := i.(*s3.Bucket)
+	if !ok {
+		return nil, fmt.Errorf("aws type unknown: %T", i)
+	}
+	api, err := storageAPI(srv)
+	if err != nil {
+		return nil, err
+	}
+	block, err := getBucketPublicAccessBlock(api, b)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, ErrFieldNotSet
+	}
+	var settings []string
+	for _, setting := range []struct {
+		name string
+		on   *bool
+	}{
+		{"BlockPublicAcls", block.BlockPublicAcls},
+		{"IgnorePublicAcls", block.IgnorePublicAcls},
+		{"BlockPublicPolicy", block.BlockPublicPolicy},
+		{"RestrictPublicBuckets", block.RestrictPublicBuckets},
+	} {
+		if awssdk.BoolValue(setting.on) {
+			settings = append(settings, setting.name)
+		}
+	}
+	if len(settings) == 0 {
+		return nil, ErrFieldNotSet
+	}
+	return settings, nil
+}
+
+var fetchBucketVersioningFn = func(srv cloud.Service, i interface{}) (interface{}, error) {
+	b, ok := i.(*s3.Bucket)
+	if !ok {
+		return nil, fmt.Errorf("aws type unknown: %T", i)
+	}
+	api, err := storageAPI(srv)
+	if err != nil {
+		return nil, err
+	}
+	out, err := api.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: b.Name})
+	if err != nil {
+		return nil, err
+	}
+	// the status of a bucket on which versioning has never been enabled is empty
+	if !notEmpty(out.Status) {
+		return nil, ErrFieldNotSet
+	}
+	return awssdk.StringValue(out.Status), nil
+}
+
+// Extract the lifecycle rules of a bucket as "ID (Status) prefix=... expire=30d transition=GLACIER:7d"
+var fetchBucketLifecycleFn = func(srv cloud.Service, i interface{}) (interface{}, error) {
+	b, ok := i.(*s3.Bucket)
+	if !ok {
+		return nil, fmt.Errorf("aws type unknown: %T", i)
+	}
+	api, err := storageAPI(srv)
+	if err != nil {
+		return nil, err
+	}
+	out, err := api.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{Bucket: b.Name})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchLifecycleConfiguration" {
+		return nil, ErrFieldNotSet
+	}
+	if err != nil {
+		return nil, err
+	}
+	var rules []string
+	for _, r := range out.Rules {
+		rule := []string{fmt.Sprintf("%s (%s)", awssdk.StringValue(r.ID), awssdk.StringValue(r.Status))}
+		prefix := awssdk.StringValue(r.Prefix)
+		if r.Filter != nil && notEmpty(r.Filter.Prefix) {
+			prefix = awssdk.StringValue(r.Filter.Prefix)
+		}
+		if prefix != "" {
+			rule = append(rule, "prefix="+prefix)
+		}
+		if r.Expiration != nil && r.Expiration.Days != nil {
+			rule = append(rule, fmt.Sprintf("expire=%dd", awssdk.Int64Value(r.Expiration.Days)))
+		}
+		for _, t := range r.Transitions {
+			rule = append(rule, fmt.Sprintf("transition=%s:%dd", awssdk.StringValue(t.StorageClass), awssdk.Int64Value(t.Days)))
+		}
+		rules = append(rules, strings.Join(rule, " "))
+	}
+	return rules, nil
+}
+
+const (
+	allUsersGranteeURI           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	authenticatedUsersGranteeURI = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+// A bucket is public when its ACL grants access to all (authenticated) users
+// or when its policy allows anyone, unless its public access block ignores them
+var fetchBucketPublicFn = func(srv cloud.Service, i interface{}) (interface{}, error) {
+	b, ok := i.(*s3.Bucket)
+	if !ok {
+		return nil, fmt.Errorf("aws type unknown: %T", i)
+	}
+	api, err := storageAPI(srv)
+	if err != nil {
+		return nil, err
+	}
+	block, err := getBucketPublicAccessBlock(api, b)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		block = &s3.PublicAccessBlockConfiguration{}
+	}
+	if !awssdk.BoolValue(block.IgnorePublicAcls) {
+		acls, err := api.GetBucketAcl(&s3.GetBucketAclInput{Bucket: b.Name})
+		if err != nil {
+			return nil, err
+		}
+		for _, acl := range acls.Grants {
+			if acl.Grantee == nil {
+				continue
+			}
+			if uri := awssdk.StringValue(acl.Grantee.URI); uri == allUsersGranteeURI || uri == authenticatedUsersGranteeURI {
+				return true, nil
+			}
+		}
+	}
+	if awssdk.BoolValue(block.RestrictPublicBuckets) {
+		return false, nil
+	}
+	policy, err := getBucketPolicy(api, b)
+	if err != nil {
+		return nil, err
+	}
+	return isPublicPolicy(policy), nil
+}
+
+func isPublicPolicy(policy string) bool {
+	if policy == "" {
+		return false
+	}
+	var doc struct {
+		Statement []struct {
+			Effect    string
+			Principal interface{}
+		}
+	}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false
+	}
+	for _, st := range doc.Statement {
+		if st.Effect != "Allow" {
+			continue
+		}
+		switch p := st.Principal.(type) {
+		case string:
+			if p == "*" {
+				return true
+			}
+		case map[string]interface{}:
+			switch principals := p["AWS"].(type) {
+			case string:
+				if principals == "*" {
+					return true
+				}
+			case []interface{}:
+				for _, a := range principals {
+					if a == "*" {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
 func notEmpty(str *string) bool {
 	return awssdk.StringValue(str) != ""
 }
+
+// Fetch the physical ids of the resources of a stack
+var fetchStackResourcesFn = func(srv cloud.Service, i interface{}) (interface{}, error) {
+	stack, ok := i.(*cloudformation.Stack)
+	if !ok {
+		return nil, fmt.Errorf("aws type unknown: %T", i)
+	}
+	resources, err := listStackResources(srv, stack)
+	if err != nil {
+		return nil, err
+	}
+	var res []interface{}
+	for _, r := range resources {
+		if notEmpty(r.PhysicalResourceId) {
+			res = append(res, awssdk.StringValue(r.PhysicalResourceId))
+		}
+	}
+	if len(res) == 0 {
+		return nil, ErrFieldNotSet
+	}
+	return res, nil
+}
+
+// listStackResources lists the resources of a stack with the client of the service fetching it
+func listStackResources(srv cloud.Service, stack *cloudformation.Stack) ([]*cloudformation.StackResourceSummary, error) {
+	stackService, ok := srv.(*Stack)
+	if !ok {
+		return nil, fmt.Errorf("list stack resources: not fetched by stack, but by %T", srv)
+	}
+	var resources []*cloudformation.StackResourceSummary
+	err := stackService.ListStackResourcesPages(&cloudformation.ListStackResourcesInput{StackName: stack.StackId},
+		func(out *cloudformation.ListStackResourcesOutput, lastPage bool) (shouldContinue bool) {
+			resources = append(resources, out.StackResourceSummaries...)
+			return out.NextToken != nil
+		})
+	return resources, err
+}
@@ -353,10 +353,10 @@ func TestFetchFunctions(t *testing.T) {
 				{Permission: awssdk.String("Write"), Grantee: &s3.Grantee{ID: awssdk.String("usr_1"), Type: awssdk.String("my_type_2")}},
 			},
 		}
-		StorageService = &mockS3{bucketsACL: bucketsACL}
+		storage := &Storage{S3API: &mockS3{bucketsACL: bucketsACL}}
 
 		bucket1 := &s3.Bucket{Name: awssdk.String("bucket_1")}
-		i, err := fetchAndExtractGrantsFn(bucket1)
+		i, err := fetchAndExtractGrantsFn(storage, bucket1)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -376,7 +376,7 @@ func TestFetchFunctions(t *testing.T) {
 		}
 
 		bucket2 := &s3.Bucket{Name: awssdk.String("bucket_2")}
-		i, err = fetchAndExtractGrantsFn(bucket2)
+		i, err = fetchAndExtractGrantsFn(storage, bucket2)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -391,4 +391,27 @@ func TestFetchFunctions(t *testing.T) {
 			}
 		}
 	})
+	t.Run("fetchBucketPublic", func(t *testing.T) {
+		storage := &Storage{S3API: &mockS3{
+			bucketsACL: map[string][]*s3.Grant{
+				"acl_public": {{Permission: awssdk.String("READ"), Grantee: &s3.Grantee{URI: awssdk.String("http://acs.amazonaws.com/groups/global/AuthenticatedUsers"), Type: awssdk.String("Group")}}},
+			},
+			bucketsPolicy: map[string]string{
+				"policy_public":      `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject"}]}`,
+				"policy_list_public": `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","*"]},"Action":"s3:GetObject"}]}`,
+				"policy_private":     `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject"},{"Effect":"Deny","Principal":"*","Action":"s3:DeleteObject"}]}`,
+			},
+		}}
+
+		tcases := map[string]bool{"acl_public": true, "policy_public": true, "policy_list_public": true, "policy_private": false, "private": false}
+		for bucket, public := range tcases {
+			i, err := fetchBucketPublicFn(storage, &s3.Bucket{Name: awssdk.String(bucket)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := i, public; got != want {
+				t.Fatalf("%s: got %v, want %v", bucket, got, want)
+			}
+		}
+	})
 }
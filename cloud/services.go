@@ -29,6 +29,7 @@ var ErrFetchAccessDenied = errors.New("access denied to cloud resource")
 
 type Service interface {
 	Name() string
+	Region() string
 	Drivers() []driver.Driver
 	ResourceTypes() []string
 	FetchResources() (*graph.Graph, error)
@@ -38,6 +39,9 @@ type Service interface {
 
 var ServiceRegistry = make(map[string]Service)
 
+// GlobalServices are the names of the services whose resources are not bound to a region (ex: users, buckets)
+var GlobalServices = make(map[string]bool)
+
 func GetServiceForType(t string) (Service, error) {
 	for _, srv := range ServiceRegistry {
 		for _, typ := range srv.ResourceTypes() {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws"
+	"github.com/wallix/awless/aws/simulator"
 	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/database"
 	"github.com/wallix/awless/logger"
@@ -61,15 +62,39 @@ func initAwlessEnvHook(cmd *cobra.Command, args []string) error {
 			return err
 		}
 	}
+	if awsAccountGlobalFlag != "" {
+		if err := config.SetVolatile(config.AccountConfigKey, awsAccountGlobalFlag); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func initCloudServicesHook(cmd *cobra.Command, args []string) error {
+	if replayCassetteFlag != "" {
+		logger.Verbosef("replaying driver calls from '%s': no cloud services loaded", replayCassetteFlag)
+		return nil
+	}
+	if simulateGlobalFlag {
+		g, err := sync.LoadAllGraphs()
+		if err != nil {
+			return err
+		}
+		logger.Verbosef("simulating cloud actions in region '%s' on local resources", config.GetAWSRegion())
+		simulation := simulator.NewDriver(g, config.GetAWSRegion())
+		simulation.SetLogger(logger.DefaultLogger)
+		simulator.RegisterServices(simulation)
+		return nil
+	}
 	if localGlobalFlag {
 		return nil
 	}
 	awsConf := config.GetConfigWithPrefix("aws.")
-	logger.Verbosef("loading AWS session with profile '%s' and region '%s'", awsConf[config.ProfileConfigKey], awsConf[config.RegionConfigKey])
+	if account := config.GetAWSAccount(); account != "" {
+		logger.Verbosef("loading AWS session with account '%s' and region '%s'", account, awsConf[config.RegionConfigKey])
+	} else {
+		logger.Verbosef("loading AWS session with profile '%s' and region '%s'", awsConf[config.ProfileConfigKey], awsConf[config.RegionConfigKey])
+	}
 	if err := aws.InitServices(awsConf, logger.DefaultLogger); err != nil {
 		return err
 	}
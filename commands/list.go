@@ -19,12 +19,18 @@ package commands
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
+	gosync "sync"
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws"
+	awsconfig "github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
 )
 
@@ -33,6 +39,9 @@ var (
 	listingFiltersFlag []string
 	listOnlyIDs        bool
 	sortBy             []string
+	listingRegions     []string
+	listAllRegions     bool
+	listAllAccounts    bool
 )
 
 func init() {
@@ -50,6 +59,9 @@ func init() {
 	listCmd.PersistentFlags().StringSliceVar(&listingFiltersFlag, "filter", []string{}, "Filter resources given key/values fields. Ex: --filter type=t2.micro")
 	listCmd.PersistentFlags().BoolVar(&listOnlyIDs, "ids", false, "List only ids")
 	listCmd.PersistentFlags().StringSliceVar(&sortBy, "sort", []string{"Id"}, "Sort tables by column(s) name(s)")
+	listCmd.PersistentFlags().StringSliceVar(&listingRegions, "region", []string{}, "List resources of the given region(s), with a region column. Ex: --region eu-west-1,us-east-1")
+	listCmd.PersistentFlags().BoolVar(&listAllRegions, "all-regions", false, "List resources of all regions, with a region column")
+	listCmd.PersistentFlags().BoolVar(&listAllAccounts, "all-accounts", false, "List resources of all named account contexts, with an account column")
 }
 
 var listCmd = &cobra.Command{
@@ -66,8 +78,28 @@ var listSpecificResourceCmd = func(resType string) *cobra.Command {
 		Short: fmt.Sprintf("List AWS %s", cloud.PluralizeResource(resType)),
 
 		Run: func(cmd *cobra.Command, args []string) {
-			var g *graph.Graph
+			regions, err := regionsToList()
+			exitOn(err)
+			if cloud.GlobalServices[aws.ServicePerResourceType[resType]] {
+				regions = nil
+			}
+			if len(regions) > 0 || listAllAccounts {
+				var accounts []string
+				if listAllAccounts {
+					accounts, err = namedAccounts()
+					exitOn(err)
+				}
+				g, columns, err := loadResourcesInContexts(resType, accounts, regions)
+				if g == nil {
+					exitOn(err)
+				} else if err != nil {
+					logger.Error(err)
+				}
+				printResources(g, graph.ResourceType(resType), columns...)
+				return
+			}
 
+			var g *graph.Graph
 			if localGlobalFlag {
 				if srvName, ok := aws.ServicePerResourceType[resType]; ok {
 					g = sync.LoadCurrentLocalGraph(srvName)
@@ -102,10 +134,11 @@ var listAllResourceInServiceCmd = func(srvName string) *cobra.Command {
 	}
 }
 
-func printResources(g *graph.Graph, resType graph.ResourceType) {
+func printResources(g *graph.Graph, resType graph.ResourceType, extraColumns ...console.ColumnDefinition) {
+	columns := append(append([]console.ColumnDefinition{}, console.DefaultsColumnDefinitions[resType]...), extraColumns...)
 	displayer := console.BuildOptions(
 		console.WithRdfType(resType),
-		console.WithHeaders(console.DefaultsColumnDefinitions[resType]),
+		console.WithHeaders(columns),
 		console.WithFilters(listingFiltersFlag),
 		console.WithMaxWidth(console.GetTerminalWidth()),
 		console.WithFormat(listingFormat),
@@ -115,3 +148,130 @@ func printResources(g *graph.Graph, resType graph.ResourceType) {
 
 	exitOn(displayer.Print(os.Stdout))
 }
+
+func regionsToList() ([]string, error) {
+	if listAllRegions {
+		return awsconfig.PartitionRegions(), nil
+	}
+	var regions []string
+	for _, region := range listingRegions {
+		if region = strings.TrimSpace(region); region == "" {
+			continue
+		}
+		if !awsconfig.IsValidRegion(region) {
+			return nil, fmt.Errorf("'%s' is not a valid region", region)
+		}
+		regions = append(regions, region)
+	}
+	return regions, nil
+}
+
+// loadResourcesInContexts returns the resources of a type in several account contexts and regions, with their
+// account and region as properties, displayed in the returned extra columns. Without accounts or regions,
+// resources are the ones of the account context used or the session region. Resources are fetched in parallel,
+// or loaded from the local graphs of each account and region when working offline
+func loadResourcesInContexts(resType string, accounts, regions []string) (*graph.Graph, []console.ColumnDefinition, error) {
+	srvName, ok := aws.ServicePerResourceType[resType]
+	if !ok {
+		return nil, nil, fmt.Errorf("cannot find service for resource type %s", resType)
+	}
+
+	var columns []console.ColumnDefinition
+	withAccounts, withRegions := len(accounts) > 0, len(regions) > 0
+	if withAccounts {
+		columns = append(columns, console.StringColumnDefinition{Prop: "Account"})
+	} else {
+		accounts = []string{config.GetAWSAccount()}
+	}
+	if withRegions {
+		columns = append(columns, console.StringColumnDefinition{Prop: "Region"})
+	} else {
+		regions = []string{config.GetAWSRegion()}
+	}
+
+	var contexts []*listContext
+	for _, account := range accounts {
+		for _, region := range regions {
+			ctx := &listContext{account: account, region: region}
+			if !localGlobalFlag {
+				// services are loaded sequentially as the sessions of accounts can prompt for MFA token codes
+				srv, err := accountService(account, region, srvName)
+				if err != nil {
+					return nil, nil, err
+				}
+				ctx.srv = srv
+			}
+			contexts = append(contexts, ctx)
+		}
+	}
+
+	all := graph.NewGraph()
+	var mu gosync.Mutex
+	var wg gosync.WaitGroup
+	var errs []string
+
+	for _, ctx := range contexts {
+		wg.Add(1)
+		go func(ctx *listContext) {
+			defer wg.Done()
+			var g *graph.Graph
+			var err error
+			if localGlobalFlag {
+				g = sync.LoadAccountGraph(ctx.account, srvName, ctx.region)
+			} else {
+				g, err = ctx.srv.FetchByType(resType)
+			}
+			var resources []*graph.Resource
+			if err == nil {
+				resources, err = g.GetAllResources(graph.ResourceType(resType))
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %s", ctx, err))
+				return
+			}
+			for _, res := range resources {
+				if withAccounts {
+					res.Properties["Account"] = ctx.account
+				}
+				if withRegions {
+					res.Properties["Region"] = ctx.region
+				}
+				all.AddResource(res)
+			}
+		}(ctx)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return all, columns, fmt.Errorf("listing %s:\n%s", cloud.PluralizeResource(resType), strings.Join(errs, "\n"))
+	}
+	return all, columns, nil
+}
+
+type listContext struct {
+	account, region string
+	srv             cloud.Service
+}
+
+func (c *listContext) String() string {
+	if c.account == "" {
+		return c.region
+	}
+	return fmt.Sprintf("%s (%s)", c.account, c.region)
+}
+
+func accountService(account, region, srvName string) (cloud.Service, error) {
+	services, err := aws.AccountServices(account, region)
+	if err != nil {
+		return nil, err
+	}
+	for _, srv := range services {
+		if srv.Name() == srvName {
+			return srv, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot find service %s in %s", srvName, region)
+}
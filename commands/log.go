@@ -85,6 +85,12 @@ func formatForMachine(buff *bytes.Buffer, templ *template.TemplateExecution) {
 	} else {
 		buff.WriteString("<not revertible>")
 	}
+	if templ.Source != "" {
+		buff.WriteRune(sep)
+		buff.WriteString(templ.Source)
+		buff.WriteRune(sep)
+		buff.WriteString(templ.SHA256)
+	}
 	buff.WriteByte('\n')
 	for _, done := range templ.Executed {
 		if done.Err != "" {
@@ -114,6 +120,9 @@ func formatForHuman(buff *bytes.Buffer, templ *template.TemplateExecution) {
 	}
 
 	fmt.Printf("Date: %s\n", parseULIDDate(templ.ID))
+	if templ.Source != "" {
+		fmt.Printf("Source: %s (sha256 %s)\n", templ.Source, templ.SHA256)
+	}
 	if templ.IsRevertible() {
 		fmt.Printf("Revert id: %s\n", templ.ID)
 	} else {
@@ -59,7 +59,7 @@ var revertCmd = &cobra.Command{
 		env.Log = logger.DefaultLogger
 		env.DefLookupFunc = lookupTemplateDefinitionsFunc()
 
-		exitOn(runTemplate(reverted, env))
+		exitOn(runTemplate(reverted, env, nil))
 
 		return nil
 	},
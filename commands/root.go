@@ -25,17 +25,21 @@ var (
 	verboseGlobalFlag      bool
 	extraVerboseGlobalFlag bool
 	localGlobalFlag        bool
+	simulateGlobalFlag     bool
 	versionGlobalFlag      bool
 	awsRegionGlobalFlag    string
 	awsProfileGlobalFlag   string
+	awsAccountGlobalFlag   string
 )
 
 func init() {
 	RootCmd.PersistentFlags().BoolVarP(&verboseGlobalFlag, "verbose", "v", false, "Turn on verbose mode for all commands")
 	RootCmd.PersistentFlags().BoolVarP(&extraVerboseGlobalFlag, "extra-verbose", "e", false, "Turn on extra verbose mode (i.e: debug) for all commands")
 	RootCmd.PersistentFlags().BoolVar(&localGlobalFlag, "local", false, "Work offline only with synced/local resources")
+	RootCmd.PersistentFlags().BoolVar(&simulateGlobalFlag, "simulate", false, "Simulate all cloud actions on your local resources without calling the cloud")
 	RootCmd.PersistentFlags().StringVar(&awsRegionGlobalFlag, "aws-region", "", "Overwrite AWS region")
 	RootCmd.PersistentFlags().StringVar(&awsProfileGlobalFlag, "aws-profile", "", "Overwrite AWS profile")
+	RootCmd.PersistentFlags().StringVar(&awsAccountGlobalFlag, "account", "", "Use a named account context, defined with the aws.accounts.NAME.profile|role|externalid|mfa config keys")
 	RootCmd.Flags().BoolVar(&versionGlobalFlag, "version", false, "Print awless version")
 
 	cobra.AddTemplateFunc("IsCmdAnnotatedOneliner", IsCmdAnnotatedOneliner)
@@ -21,7 +21,6 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"strings"
 
@@ -42,8 +41,21 @@ import (
 var renderGreenFn = color.New(color.FgGreen).SprintFunc()
 var renderRedFn = color.New(color.FgRed).SprintFunc()
 
+var (
+	recordCassetteFlag string
+	replayCassetteFlag string
+	sha256Flag         string
+	runForceFlag       bool
+	allowStaleFlag     bool
+)
+
 func init() {
 	RootCmd.AddCommand(runCmd)
+	runCmd.Flags().StringVar(&recordCassetteFlag, "record", "", "Record all driver calls of the run into the given cassette file")
+	runCmd.Flags().StringVar(&replayCassetteFlag, "replay", "", "Replay driver calls from the given cassette file instead of calling the cloud")
+	runCmd.Flags().StringVar(&sha256Flag, "sha256", "", "Expected sha256 checksum of the template content")
+	runCmd.Flags().BoolVar(&runForceFlag, "force", false, "Run the template without confirmation (required for templates read from stdin without terminal)")
+	runCmd.Flags().BoolVar(&allowStaleFlag, "allow-stale", false, "Run the cached copy of a remote template that cannot be fetched, even when not pinned with --sha256 or a commit")
 	for action, entities := range aws.DriverSupportedActions() {
 		RootCmd.AddCommand(
 			createDriverCommands(action, entities),
@@ -52,20 +64,32 @@ func init() {
 }
 
 var runCmd = &cobra.Command{
-	Use:               "run FILEPATH",
-	Short:             "Run a template given a filepath",
-	Example:           "  awless run ~/templates/my-infra.txt",
+	Use:               "run FILEPATH|URL|@NAME[:VERSION]|-",
+	Short:             "Run a template given a filepath, an url, a template name from the registry or '-' for stdin",
+	Example:           "  awless run ~/templates/my-infra.txt\n  awless run @my-infra:2\n  cat my-infra.txt | awless run -\n  awless run https://raw.githubusercontent.com/wallix/awless-templates/master/infra.txt\n  awless run git::https://github.com/wallix/awless-templates.git//infra.txt@v1.0 --sha256 9f86d08...",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook),
 	PersistentPostRun: applyHooks(saveHistoryHook, verifyNewVersionHook),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) < 1 {
-			return errors.New("missing FILEPATH arg")
+			return errors.New("missing FILEPATH, URL, @NAME or - arg")
 		}
 
-		content, err := ioutil.ReadFile(args[0])
-		if err != nil {
-			return err
+		var content []byte
+		var source *templateSource
+		if isRegistryTemplateRef(args[0]) {
+			stored, err := loadRegistryTemplate(args[0])
+			exitOn(err)
+			logger.Verbosef("running template %s from registry", stored.Key())
+			content = []byte(stored.Content)
+			source = &templateSource{Location: args[0], SHA256: sha256Hex(content)}
+			if sha256Flag != "" && !strings.EqualFold(source.SHA256, sha256Flag) {
+				exitOn(fmt.Errorf("template %s: checksum mismatch: got sha256 %s, expected %s", args[0], source.SHA256, sha256Flag))
+			}
+		} else {
+			var err error
+			content, source, err = fetchTemplate(args[0], sha256Flag)
+			exitOn(err)
 		}
 
 		templ, err := template.Parse(string(content))
@@ -80,7 +104,7 @@ var runCmd = &cobra.Command{
 		env.MissingHolesFunc = missingHolesStdinFunc()
 		env.DefLookupFunc = lookupTemplateDefinitionsFunc()
 
-		exitOn(runTemplate(templ, env))
+		exitOn(runTemplate(templ, env, source))
 
 		return nil
 	},
@@ -95,7 +119,10 @@ func missingHolesStdinFunc() func(string) interface{} {
 		var resp interface{}
 		ask := func() error {
 			fmt.Printf("%s ? ", hole)
-			line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+			line, err := bufio.NewReader(promptInput).ReadString('\n')
+			if err == errNoTerminal {
+				exitOn(fmt.Errorf("cannot fill hole %s: %s", hole, err))
+			}
 			if err != nil {
 				return err
 			}
@@ -114,7 +141,7 @@ func missingHolesStdinFunc() func(string) interface{} {
 	}
 }
 
-func runTemplate(templ *template.Template, env *template.Env) error {
+func runTemplate(templ *template.Template, env *template.Env, source *templateSource) error {
 	if len(env.Fillers) > 0 {
 		logger.Verbosef("default/given holes fillers: %s", sprintProcessedParams(env.Fillers))
 	}
@@ -125,11 +152,26 @@ func runTemplate(templ *template.Template, env *template.Env) error {
 
 	validateTemplate(templ)
 
-	var drivers []driver.Driver
-	for _, s := range cloud.ServiceRegistry {
-		drivers = append(drivers, s.Drivers()...)
+	var awsDriver driver.Driver
+	var replayer *driver.Replayer
+	if replayCassetteFlag != "" {
+		cassette, err := driver.LoadCassette(replayCassetteFlag)
+		exitOn(err)
+		replayer = driver.NewReplayer(cassette)
+		awsDriver = replayer
+	} else {
+		var drivers []driver.Driver
+		for _, s := range cloud.ServiceRegistry {
+			drivers = append(drivers, s.Drivers()...)
+		}
+		awsDriver = driver.NewMultiDriver(drivers...)
+	}
+
+	var recorder *driver.Recorder
+	if recordCassetteFlag != "" {
+		recorder = driver.NewRecorder(awsDriver)
+		awsDriver = recorder
 	}
-	awsDriver := driver.NewMultiDriver(drivers...)
 
 	awsDriver.SetLogger(logger.DefaultLogger)
 
@@ -139,18 +181,40 @@ func runTemplate(templ *template.Template, env *template.Env) error {
 	fmt.Println()
 	fmt.Printf("%s\n", renderGreenFn(templ))
 	fmt.Println()
-	fmt.Print("Confirm? (y/n): ")
 	var yesorno string
-	_, err = fmt.Scanln(&yesorno)
+	if runForceFlag {
+		yesorno = "y"
+	} else {
+		fmt.Print("Confirm? (y/n): ")
+		_, err = fmt.Fscanln(promptInput, &yesorno)
+	}
 
 	if strings.TrimSpace(yesorno) == "y" {
 		newTempl, err := templ.Run(awsDriver)
 
 		executed := template.NewTemplateExecution(newTempl)
+		if source != nil {
+			executed.Source, executed.SHA256 = source.Location, source.SHA256
+		}
 
 		fmt.Println()
 		printReport(executed)
 
+		if recorder != nil {
+			exitOn(recorder.Cassette().Save(recordCassetteFlag))
+			logger.Infof("driver calls recorded in %s", recordCassetteFlag)
+		}
+		if replayer != nil {
+			if err := replayer.Done(); err != nil {
+				logger.Error(err)
+			}
+			return nil
+		}
+		if simulateGlobalFlag {
+			logger.Info("simulation only: nothing has been done on your cloud")
+			return nil
+		}
+
 		db, err, close := database.Current()
 		exitOn(err)
 		defer close()
@@ -196,7 +260,7 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 		}
 		run := func(def template.TemplateDefinition) func(cmd *cobra.Command, args []string) error {
 			return func(cmd *cobra.Command, args []string) error {
-				text := fmt.Sprintf("%s %s %s", def.Action, def.Entity, strings.Join(args, " "))
+				text := fmt.Sprintf("%s %s %s", def.Action, def.Entity, strings.Join(quoteSpacedParams(args), " "))
 
 				templ, err := template.Parse(text)
 				exitOn(err)
@@ -208,7 +272,7 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 				env.AliasFunc = resolveAliasFunc(def.Entity)
 				env.MissingHolesFunc = missingHolesStdinFunc()
 
-				exitOn(runTemplate(templ, env))
+				exitOn(runTemplate(templ, env, nil))
 				return nil
 			}
 		}
@@ -219,7 +283,7 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 				PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook),
 				PersistentPostRun: applyHooks(saveHistoryHook, verifyNewVersionHook),
 				Short:             fmt.Sprintf("%s a %s", strings.Title(action), templDef.Entity),
-				Long:              fmt.Sprintf("%s a %s\n\tRequired params: %s\n\tExtra params: %s", strings.Title(templDef.Action), templDef.Entity, strings.Join(templDef.Required(), ", "), strings.Join(templDef.Extra(), ", ")),
+				Long:              oneLinerLongDesc(templDef),
 				RunE:              run(templDef),
 			},
 		)
@@ -228,6 +292,19 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 	return actionCmd
 }
 
+// quoteSpacedParams quotes back the values the shell unquoted (ex: message="disk full" is received as message=disk full)
+func quoteSpacedParams(args []string) []string {
+	var quoted []string
+	for _, arg := range args {
+		splits := strings.SplitN(arg, "=", 2)
+		if len(splits) == 2 && strings.ContainsAny(splits[1], " \t") && !strings.HasPrefix(splits[1], "\"") {
+			arg = fmt.Sprintf("%s=\"%s\"", splits[0], splits[1])
+		}
+		quoted = append(quoted, arg)
+	}
+	return quoted
+}
+
 func lookupTemplateDefinitionsFunc() template.LookupTemplateDefFunc {
 	return func(key string) (t template.TemplateDefinition, ok bool) {
 		t, ok = aws.AWSTemplatesDefinitions[key]
@@ -292,7 +369,7 @@ func printReport(t *template.TemplateExecution) {
 		}
 	}
 
-	if t.IsRevertible() {
+	if t.IsRevertible() && !simulateGlobalFlag && replayCassetteFlag == "" {
 		logger.Infof("revert this template with `awless revert %s`", t.ID)
 	}
 }
@@ -325,6 +402,33 @@ func sprintProcessedParams(processed map[string]interface{}) string {
 	return strings.Join(str, ", ")
 }
 
+func oneLinerLongDesc(def template.TemplateDefinition) string {
+	var buff bytes.Buffer
+	buff.WriteString(fmt.Sprintf("%s a %s", strings.Title(def.Action), def.Entity))
+
+	writeParams := func(title string, params []string) {
+		if len(params) == 0 {
+			return
+		}
+		buff.WriteString(fmt.Sprintf("\n\n%s params:", title))
+		done := make(map[string]bool)
+		for _, p := range params {
+			if done[p] {
+				continue
+			}
+			done[p] = true
+			buff.WriteString("\n\t" + p)
+			if paramDef, ok := def.Param(p); ok && paramDef.String() != "" {
+				buff.WriteString(": " + paramDef.String())
+			}
+		}
+	}
+	writeParams("Required", def.Required())
+	writeParams("Extra", def.Extra())
+
+	return buff.String()
+}
+
 func oneLinerShortDesc(action string, entities []string) string {
 	if len(entities) > 5 {
 		return fmt.Sprintf("%s, \u2026 (see `awless %s -h` for more)", strings.Join(entities[0:5], ", "), action)
@@ -0,0 +1,169 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws"
+	"github.com/wallix/awless/aws/transfer"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+var (
+	s3DryRunFlag    bool
+	s3RecursiveFlag bool
+	s3ParallelFlag  int
+	s3PartSizeFlag  int64
+)
+
+func init() {
+	RootCmd.AddCommand(s3Cmd)
+	s3Cmd.AddCommand(s3CpCmd)
+	s3Cmd.AddCommand(s3SyncCmd)
+
+	s3Cmd.PersistentFlags().BoolVar(&s3DryRunFlag, "dry-run", false, "List the transfers that would be done without running them")
+	s3Cmd.PersistentFlags().IntVar(&s3ParallelFlag, "parallel", transfer.DefaultParallel, "Maximum number of concurrent transfers")
+	s3Cmd.PersistentFlags().Int64Var(&s3PartSizeFlag, "part-size", transfer.DefaultPartSize/(1024*1024), "Size in MB of the parts of multipart uploads (minimum 5)")
+	s3CpCmd.Flags().BoolVarP(&s3RecursiveFlag, "recursive", "r", false, "Copy the content of directories or prefixes")
+}
+
+var s3Cmd = &cobra.Command{
+	Use:               "s3",
+	Short:             "Copy and sync files between local directories and S3 buckets",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook),
+	PersistentPostRun: applyHooks(saveHistoryHook, verifyNewVersionHook),
+}
+
+var s3CpCmd = &cobra.Command{
+	Use:   "cp SOURCE DESTINATION",
+	Short: "Copy a local file or directory to a bucket, or objects of a bucket to a local file or directory",
+	Example: `  awless s3 cp ./report.pdf s3://mybucket/reports/
+  awless s3 cp -r ./site s3://mybucket/www --dry-run
+  awless s3 cp s3://mybucket/reports/report.pdf .`,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 2 {
+			return fmt.Errorf("source and destination required")
+		}
+		runS3Transfer(args[0], args[1], s3RecursiveFlag, false)
+		return nil
+	},
+}
+
+var s3SyncCmd = &cobra.Command{
+	Use:   "sync SOURCE DESTINATION",
+	Short: "Copy recursively the files or objects that are missing or changed (by size or ETag) in the destination",
+	Example: `  awless s3 sync ./site s3://mybucket/www
+  awless s3 sync s3://mybucket/backups ./backups --dry-run`,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 2 {
+			return fmt.Errorf("source and destination required")
+		}
+		runS3Transfer(args[0], args[1], true, true)
+		return nil
+	},
+}
+
+func runS3Transfer(srcArg, dstArg string, recursive, skipUnchanged bool) {
+	if s3PartSizeFlag*1024*1024 < transfer.MinPartSize {
+		exitOn(fmt.Errorf("part size must be at least %d MB", transfer.MinPartSize/(1024*1024)))
+	}
+	api, ok := aws.StorageService.(s3iface.S3API)
+	if !ok {
+		exitOn(fmt.Errorf("internal: storage service is not a S3 API"))
+	}
+	tr := transfer.New(api)
+	tr.Parallel = s3ParallelFlag
+	tr.PartSize = s3PartSizeFlag * 1024 * 1024
+	tr.Logger = logger.DefaultLogger
+
+	src, dst := transfer.ParseLocation(srcArg), transfer.ParseLocation(dstArg)
+
+	var actions []*transfer.Action
+	var skipped int
+	var err error
+	switch {
+	case !src.IsRemote() && dst.IsRemote():
+		var remote map[string]*transfer.Object
+		if skipUnchanged {
+			remote = syncedObjects(dst.Bucket)
+		}
+		actions, skipped, err = transfer.PlanUpload(src.Path, dst, remote, recursive, tr.PartSize)
+	case src.IsRemote() && !dst.IsRemote():
+		var objects []*transfer.Object
+		objects, err = tr.ListObjects(src.Bucket, src.Key)
+		exitOn(err)
+		actions, skipped, err = transfer.PlanDownload(src, objects, dst.Path, recursive, skipUnchanged)
+	default:
+		err = fmt.Errorf("either source or destination must be a bucket location (s3://BUCKET/KEY), not both")
+	}
+	exitOn(err)
+
+	if skipped > 0 {
+		logger.Verbosef("%d file(s) unchanged skipped", skipped)
+	}
+	if len(actions) == 0 {
+		logger.Info("nothing to transfer")
+		return
+	}
+
+	if s3DryRunFlag {
+		for _, a := range actions {
+			fmt.Println(a)
+		}
+		return
+	}
+
+	err = tr.Run(actions)
+	if dst.IsRemote() && config.GetAutosync() {
+		if srv, ok := cloud.ServiceRegistry[aws.StorageService.Name()]; ok {
+			if _, serr := sync.DefaultSyncer.Sync(srv); serr != nil {
+				logger.Error(serr.Error())
+			}
+		}
+	}
+	exitOn(err)
+	logger.Infof("%d file(s) transferred", len(actions))
+}
+
+// syncedObjects returns the objects of a bucket from the local storage graph (i.e. as of the last sync)
+func syncedObjects(bucket string) map[string]*transfer.Object {
+	objects := make(map[string]*transfer.Object)
+	resources, err := sync.LoadCurrentLocalGraph(aws.StorageService.Name()).GetAllResources(graph.Object)
+	if err != nil {
+		logger.Verbosef("cannot load synced objects of bucket %s: %s", bucket, err)
+		return objects
+	}
+	for _, res := range resources {
+		if name, _ := res.Properties["BucketName"].(string); name != bucket {
+			continue
+		}
+		key, _ := res.Properties["Key"].(string)
+		size, _ := res.Properties["Size"].(float64)
+		etag, _ := res.Properties["ETag"].(string)
+		objects[key] = &transfer.Object{Key: key, Size: int64(size), ETag: etag}
+	}
+	return objects
+}
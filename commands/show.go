@@ -33,8 +33,12 @@ import (
 	"github.com/wallix/awless/sync"
 )
 
+var showAllAccounts bool
+
 func init() {
 	RootCmd.AddCommand(showCmd)
+
+	showCmd.Flags().BoolVar(&showAllAccounts, "all-accounts", false, "Look up the reference in the local resources of all named account contexts")
 }
 
 var showCmd = &cobra.Command{
@@ -42,7 +46,8 @@ var showCmd = &cobra.Command{
 	Short: "Show a resource and its interrelations given a REFERENCE: id or name",
 	Example: `  awless show i-8d43b21b            # show an instance via its id
   awless show AIDAJ3Z24GOKHTZO4OIX6 # show a user via its id
-  awless show jsmith                # show a user via its name`,
+  awless show jsmith                # show a user via its name
+  awless show jsmith --all-accounts # show a user of any account`,
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook),
 	PersistentPostRun: applyHooks(saveHistoryHook, verifyNewVersionHook),
 
@@ -54,6 +59,18 @@ var showCmd = &cobra.Command{
 		ref := args[0]
 		notFound := fmt.Sprintf("resource with reference %s not found", deprefix(ref))
 
+		if showAllAccounts {
+			account, resource, gph, err := findResourceInAccountsGraphs(ref)
+			exitOn(err)
+			if resource == nil {
+				logger.Info(notFound)
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "Account: %s\n", account)
+			showResource(resource, gph)
+			return nil
+		}
+
 		var resource *graph.Resource
 		var gph *graph.Graph
 
@@ -164,7 +181,9 @@ func runFullSync() {
 }
 
 func findResourceInLocalGraphs(ref string) (*graph.Resource, *graph.Graph) {
-	resources := resolveResourceFromRef(ref)
+	g, err := sync.LoadAllGraphs()
+	exitOn(err)
+	resources := resolveResourceFromRef(g, ref)
 	switch len(resources) {
 	case 0:
 		return nil, nil
@@ -188,10 +207,43 @@ func findResourceInLocalGraphs(ref string) (*graph.Resource, *graph.Graph) {
 	return nil, nil
 }
 
-func resolveResourceFromRef(ref string) []*graph.Resource {
-	g, err := sync.LoadAllGraphs()
-	exitOn(err)
+// findResourceInAccountsGraphs looks up a reference in the local graphs of all named account contexts.
+// It returns an error listing the candidates when the reference is ambiguous
+func findResourceInAccountsGraphs(ref string) (string, *graph.Resource, *graph.Graph, error) {
+	type found struct {
+		account string
+		res     *graph.Resource
+	}
+	accounts, err := namedAccounts()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	var all []found
+	for _, account := range accounts {
+		g, err := sync.LoadAllAccountGraphs(account)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		for _, res := range resolveResourceFromRef(g, ref) {
+			all = append(all, found{account: account, res: res})
+		}
+	}
+	switch len(all) {
+	case 0:
+		return "", nil, nil, nil
+	case 1:
+		account, res := all[0].account, all[0].res
+		return account, res, sync.LoadAccountGraph(account, aws.ServicePerResourceType[res.Type().String()], config.GetAWSRegion()), nil
+	default:
+		candidates := []string{fmt.Sprintf("%d resources found with reference '%s'. Show them using the id and account:", len(all), deprefix(ref))}
+		for _, f := range all {
+			candidates = append(candidates, fmt.Sprintf("\t`awless show %s --account %s` for the %s", f.res.Id(), f.account, f.res.Type()))
+		}
+		return "", nil, nil, errors.New(strings.Join(candidates, "\n"))
+	}
+}
 
+func resolveResourceFromRef(g *graph.Graph, ref string) []*graph.Resource {
 	name := deprefix(ref)
 	byName := &graph.ByProperty{"Name", name}
 
@@ -0,0 +1,88 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
+)
+
+func TestFindResourceInAccountsGraphs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-show-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	repoDir := config.RepoDir
+	defer func() { config.RepoDir = repoDir }()
+	config.RepoDir = dir
+	config.Config = map[string]interface{}{
+		config.RegionConfigKey:      "eu-west-1",
+		"aws.accounts.dev.profile":  "dev",
+		"aws.accounts.prod.profile": "prod",
+	}
+
+	writeUsers := func(account string, ids ...string) {
+		g := graph.NewGraph()
+		for _, id := range ids {
+			user := graph.InitResource(id, graph.User)
+			user.Properties["Name"] = "jsmith"
+			g.AddResource(user)
+		}
+		path := filepath.Join(dir, "accounts", account, config.AccessFilename)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(g.MustMarshal()), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeUsers("dev", "usr_1")
+	writeUsers("prod", "usr_2")
+
+	account, res, _, err := findResourceInAccountsGraphs("usr_2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := account, "prod"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := res.Id(), "usr_2"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	if _, res, _, err = findResourceInAccountsGraphs("usr_3"); err != nil || res != nil {
+		t.Fatalf("got %v, %v, want no resource and no error", res, err)
+	}
+
+	_, res, _, err = findResourceInAccountsGraphs("@jsmith")
+	if err == nil {
+		t.Fatalf("expected error for ambiguous reference, got %v", res)
+	}
+	for _, candidate := range []string{"awless show usr_1 --account dev", "awless show usr_2 --account prod"} {
+		if !strings.Contains(err.Error(), candidate) {
+			t.Fatalf("expected '%s' in error: %s", candidate, err)
+		}
+	}
+}
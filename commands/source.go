@@ -0,0 +1,271 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
+)
+
+const (
+	stdinSource     = "-"
+	gitSourcePrefix = "git::"
+)
+
+// promptInput is where confirmation and missing holes are read from.
+// It is the terminal instead of stdin when the template itself is read from stdin.
+var promptInput io.Reader = os.Stdin
+
+var errNoTerminal = errors.New("no terminal")
+
+// noTerminal is the prompt input when the template is read from stdin without a terminal available
+type noTerminal struct{}
+
+func (noTerminal) Read([]byte) (int, error) {
+	return 0, errNoTerminal
+}
+
+var templatesCacheDir = filepath.Join(config.AwlessHome, "cache", "templates")
+
+// A templateSource is the origin of a template run
+type templateSource struct {
+	Location string
+	SHA256   string
+}
+
+// fetchTemplate returns the content of a template given a filepath, '-' for stdin,
+// an http(s) url or a git source 'git::REPOSITORY//PATH[@REF]'.
+// Remote templates are cached locally. When expectedSum is set,
+// the sha256 checksum of the content must match.
+func fetchTemplate(location, expectedSum string) ([]byte, *templateSource, error) {
+	var content []byte
+	var err error
+	switch {
+	case location == stdinSource:
+		content, err = readStdinTemplate()
+	case strings.HasPrefix(location, gitSourcePrefix):
+		content, err = fetchGitTemplate(location, expectedSum)
+	case isHTTPURL(location):
+		content, err = fetchHTTPTemplate(location, expectedSum)
+	default:
+		content, err = ioutil.ReadFile(location)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sum := sha256Hex(content)
+	if expectedSum != "" && !strings.EqualFold(sum, expectedSum) {
+		return nil, nil, fmt.Errorf("template %s: checksum mismatch: got sha256 %s, expected %s", location, sum, expectedSum)
+	}
+
+	return content, &templateSource{Location: location, SHA256: sum}, nil
+}
+
+// readStdinTemplate reads a template from stdin, prompting from the terminal.
+// Without terminal, the run cannot be confirmed unless forced.
+func readStdinTemplate() ([]byte, error) {
+	tty, err := os.Open("/dev/tty")
+	if err != nil {
+		if !runForceFlag {
+			return nil, fmt.Errorf("cannot confirm the template read from stdin: %s (%s). Use --force to run it without confirmation", errNoTerminal, err)
+		}
+		logger.Verbosef("no terminal available for prompts: %s", err)
+		promptInput = noTerminal{}
+	} else {
+		promptInput = tty
+	}
+	content, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		return nil, fmt.Errorf("reading template from stdin: %s", err)
+	}
+	return content, nil
+}
+
+func isHTTPURL(location string) bool {
+	return strings.HasPrefix(location, "https://") || strings.HasPrefix(location, "http://")
+}
+
+func fetchHTTPTemplate(url, expectedSum string) ([]byte, error) {
+	cached := filepath.Join(templatesCacheDir, "http", sha256Hex([]byte(url)))
+
+	if expectedSum != "" {
+		if content, err := ioutil.ReadFile(cached); err == nil && strings.EqualFold(sha256Hex(content), expectedSum) {
+			logger.Verbosef("using cached template for %s", url)
+			return content, nil
+		}
+	}
+
+	content, err := httpGet(url)
+	if err != nil {
+		content, cerr := ioutil.ReadFile(cached)
+		if cerr != nil {
+			return nil, err
+		}
+		if err = cachedTemplateFallback(url, expectedSum != "", err); err != nil {
+			return nil, err
+		}
+		return content, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cached), 0700); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(cached, content, 0600); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+func httpGet(url string) ([]byte, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("fetching template: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching template %s: %s", url, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// parseGitSource splits 'git::REPOSITORY//PATH[@REF]' into its parts
+func parseGitSource(location string) (repo, path, ref string, err error) {
+	source := strings.TrimPrefix(location, gitSourcePrefix)
+	i := strings.LastIndex(source, "//")
+	if i <= strings.Index(source, "://")+2 {
+		return "", "", "", fmt.Errorf("invalid git source '%s': expecting git::REPOSITORY//PATH[@REF]", location)
+	}
+	repo, path = source[:i], source[i+2:]
+	if j := strings.LastIndex(path, "@"); j > -1 {
+		path, ref = path[:j], path[j+1:]
+	}
+	if repo == "" || path == "" {
+		return "", "", "", fmt.Errorf("invalid git source '%s': expecting git::REPOSITORY//PATH[@REF]", location)
+	}
+	return
+}
+
+func fetchGitTemplate(location, expectedSum string) ([]byte, error) {
+	repo, path, ref, err := parseGitSource(location)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(templatesCacheDir, "git", sha256Hex([]byte(repo+"@"+ref)))
+	file := filepath.Join(dir, filepath.FromSlash(path))
+
+	if expectedSum != "" {
+		if content, err := ioutil.ReadFile(file); err == nil && strings.EqualFold(sha256Hex(content), expectedSum) {
+			logger.Verbosef("using cached template for %s", location)
+			return content, nil
+		}
+	}
+
+	if err := gitCheckout(repo, ref, dir); err != nil {
+		if _, serr := os.Stat(file); serr != nil {
+			return nil, err
+		}
+		if err = cachedTemplateFallback(location, expectedSum != "" || commitRefRegex.MatchString(ref), err); err != nil {
+			return nil, err
+		}
+	}
+
+	return ioutil.ReadFile(file)
+}
+
+// cachedTemplateFallback allows to run the cached copy of a template that cannot be fetched
+// when it is pinned by its checksum or by a commit. Otherwise, as the copy may be outdated,
+// the user has to opt in with --allow-stale.
+func cachedTemplateFallback(location string, pinned bool, err error) error {
+	if pinned {
+		logger.Infof("%s: using cached template", err)
+		return nil
+	}
+	if !allowStaleFlag {
+		return fmt.Errorf("%s: the cached template of %s may be stale. Pin it with --sha256, or use --allow-stale to run it anyway", err, location)
+	}
+	logger.Warningf("%s: running cached template of %s which may be stale", err, location)
+	return nil
+}
+
+var commitRefRegex = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+
+func gitCheckout(repo, ref, dir string) error {
+	if _, err := os.Stat(filepath.Join(dir, ".git")); os.IsNotExist(err) {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+		if err := git(dir, "init", "--quiet"); err != nil {
+			return err
+		}
+		if err := git(dir, "remote", "add", "origin", repo); err != nil {
+			return err
+		}
+	}
+	if ref == "" {
+		ref = "HEAD"
+	}
+	err := git(dir, "fetch", "--quiet", "--depth", "1", "origin", ref)
+	if err == nil {
+		return git(dir, "checkout", "--quiet", "--force", "FETCH_HEAD")
+	}
+	if !commitRefRegex.MatchString(ref) {
+		return err
+	}
+
+	// remotes only serve branches, tags or full commit ids:
+	// resolve an abbreviated commit id from the fetched history
+	args := []string{"fetch", "--quiet", "--tags", "origin"}
+	if _, err := os.Stat(filepath.Join(dir, ".git", "shallow")); err == nil {
+		args = append(args, "--unshallow")
+	}
+	if err := git(dir, args...); err != nil {
+		return err
+	}
+	return git(dir, "checkout", "--quiet", "--force", ref+"^{commit}")
+}
+
+func git(dir string, args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git %s: %s: %s", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
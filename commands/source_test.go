@@ -0,0 +1,177 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseGitSource(t *testing.T) {
+	tcases := []struct {
+		source          string
+		repo, path, ref string
+		expErr          bool
+	}{
+		{source: "git::https://github.com/wallix/templates.git//infra/vpc.txt@v1.0", repo: "https://github.com/wallix/templates.git", path: "infra/vpc.txt", ref: "v1.0"},
+		{source: "git::https://github.com/wallix/templates.git//vpc.txt", repo: "https://github.com/wallix/templates.git", path: "vpc.txt"},
+		{source: "git::git@github.com:wallix/templates.git//vpc.txt@3f11640", repo: "git@github.com:wallix/templates.git", path: "vpc.txt", ref: "3f11640"},
+		{source: "git::https://github.com/wallix/templates.git", expErr: true},
+		{source: "git::https://github.com/wallix/templates.git//@master", expErr: true},
+	}
+
+	for i, tcase := range tcases {
+		repo, path, ref, err := parseGitSource(tcase.source)
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%d: expected error, got none", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if repo != tcase.repo || path != tcase.path || ref != tcase.ref {
+			t.Fatalf("%d: got (%s, %s, %s), want (%s, %s, %s)", i+1, repo, path, ref, tcase.repo, tcase.path, tcase.ref)
+		}
+	}
+}
+
+func TestFetchHTTPTemplate(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "awless-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cacheDir)
+	defer func(dir string) { templatesCacheDir = dir }(templatesCacheDir)
+	templatesCacheDir = cacheDir
+
+	text := "create vpc cidr=10.0.0.0/16"
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, text)
+	}))
+	url := server.URL + "/vpc.txt"
+
+	content, source, err := fetchTemplate(url, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(content), text; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := source.Location, url; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := source.SHA256, sha256Hex([]byte(text)); got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	if _, _, err = fetchTemplate(url, strings.ToUpper(source.SHA256)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := requests, 1; got != want {
+		t.Fatalf("got %d requests, want %d: pinned template should be read from cache", got, want)
+	}
+
+	if _, _, err = fetchTemplate(url, sha256Hex([]byte("other"))); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("got %v, want checksum mismatch error", err)
+	}
+
+	server.Close()
+	if _, _, err = fetchTemplate(url, ""); err == nil || !strings.Contains(err.Error(), "may be stale") {
+		t.Fatalf("got %v, want stale template error", err)
+	}
+	for _, expectedSum := range []string{"", source.SHA256} {
+		func() {
+			defer func(allow bool) { allowStaleFlag = allow }(allowStaleFlag)
+			allowStaleFlag = expectedSum == ""
+			content, _, err = fetchTemplate(url, expectedSum)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := string(content), text; got != want {
+				t.Fatalf("got %s, want %s: unreachable template should be read from cache", got, want)
+			}
+		}()
+	}
+}
+
+func TestFetchGitTemplate(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	cacheDir, err := ioutil.TempDir("", "awless-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cacheDir)
+	defer func(dir string) { templatesCacheDir = dir }(templatesCacheDir)
+	templatesCacheDir = cacheDir
+
+	repo, err := ioutil.TempDir("", "awless-templates-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repo)
+
+	gitRepo := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=awless", "-c", "user.email=awless@example.com"}, args...)...)
+		cmd.Dir = repo
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %s: %s: %s", strings.Join(args, " "), err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	commit := func(text string) string {
+		if err := ioutil.WriteFile(filepath.Join(repo, "vpc.txt"), []byte(text), 0600); err != nil {
+			t.Fatal(err)
+		}
+		gitRepo("add", "vpc.txt")
+		gitRepo("commit", "--quiet", "-m", text)
+		return gitRepo("rev-parse", "HEAD")
+	}
+	gitRepo("init", "--quiet")
+	first := commit("create vpc cidr=10.0.0.0/16")
+	commit("create vpc cidr=10.1.0.0/16")
+
+	location := "git::file://" + filepath.ToSlash(repo) + "//vpc.txt"
+	content, _, err := fetchTemplate(location, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(content), "create vpc cidr=10.1.0.0/16"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	content, _, err = fetchTemplate(location+"@"+first[:7], "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(content), "create vpc cidr=10.0.0.0/16"; got != want {
+		t.Fatalf("got %s, want %s: abbreviated commit should be resolved", got, want)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
@@ -31,6 +32,7 @@ import (
 
 var (
 	servicesToSyncFlags map[string]*bool
+	syncAllAccounts     bool
 )
 
 func init() {
@@ -41,6 +43,7 @@ func init() {
 		servicesToSyncFlags[service] = new(bool)
 		syncCmd.Flags().BoolVar(servicesToSyncFlags[service], service, false, fmt.Sprintf("Sync '%s' service only", service))
 	}
+	syncCmd.Flags().BoolVar(&syncAllAccounts, "all-accounts", false, "Sync all named account contexts, each in its own local namespace")
 }
 
 var syncCmd = &cobra.Command{
@@ -52,32 +55,49 @@ var syncCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		logger.DefaultLogger.SetVerbose(logger.VerboseF)
 
-		var services []cloud.Service
 		displayAllServices := true
 		for _, srv := range cloud.ServiceRegistry {
 			if *servicesToSyncFlags[srv.Name()] {
 				displayAllServices = false
 			}
 		}
-		for _, srv := range cloud.ServiceRegistry {
-			if displayAllServices || *servicesToSyncFlags[srv.Name()] {
-				services = append(services, srv)
+		accounts := []string{config.GetAWSAccount()}
+		if syncAllAccounts {
+			var err error
+			if accounts, err = namedAccounts(); err != nil {
+				return err
 			}
 		}
-		localGraphs := make(map[string]*graph.Graph)
-		for _, service := range services {
-			localGraphs[service.Name()] = sync.LoadCurrentLocalGraph(service.Name())
-		}
-		logger.Info("running sync: fetching remote resources for local store")
+		regions := config.GetAWSRegions()
 		start := time.Now()
 
-		graphs, err := sync.DefaultSyncer.Sync(services...)
-		if err != nil {
-			logger.Verbose(err)
-		}
+		for _, account := range accounts {
+			var services []cloud.Service
+			for _, region := range regions {
+				regionServices, err := aws.AccountServices(account, region)
+				if err != nil {
+					return err
+				}
+				for _, srv := range regionServices {
+					if displayAllServices || *servicesToSyncFlags[srv.Name()] {
+						services = append(services, srv)
+					}
+				}
+			}
+			if account != "" {
+				logger.Infof("running sync of account %s: fetching remote resources for local store in %s", account, strings.Join(regions, ", "))
+			} else {
+				logger.Infof("running sync: fetching remote resources for local store in %s", strings.Join(regions, ", "))
+			}
 
-		for k, g := range graphs {
-			displaySyncStats(k, g)
+			graphs, err := sync.DefaultSyncer.SyncAccount(account, services...)
+			if err != nil {
+				logger.Verbose(err)
+			}
+
+			for k, g := range graphs {
+				displaySyncStats(k, g)
+			}
 		}
 		logger.Infof("sync took %s", time.Since(start))
 
@@ -103,3 +123,11 @@ func displaySyncStats(serviceName string, g *graph.Graph) {
 	}
 	logger.Infof("-> %s: %s", serviceName, strings.Join(strs, ", "))
 }
+
+func namedAccounts() ([]string, error) {
+	accounts := config.GetAWSAccounts()
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("no named account context. Define them with `awless config set %sNAME.role ...` or `awless config set %sNAME.profile ...`", config.AccountsConfigPrefix, config.AccountsConfigPrefix)
+	}
+	return accounts, nil
+}
@@ -0,0 +1,326 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/template"
+)
+
+var (
+	templateNameFlag        string
+	templateVersionFlag     string
+	templateDescriptionFlag string
+	templateForceFlag       bool
+)
+
+var templateFileExtensions = []string{".aws", ".awless", ".txt"}
+
+func init() {
+	RootCmd.AddCommand(templateCmd)
+	templateCmd.AddCommand(templateAddCmd)
+	templateCmd.AddCommand(templateListCmd)
+	templateCmd.AddCommand(templateShowCmd)
+	templateCmd.AddCommand(templateRmCmd)
+
+	templateAddCmd.Flags().StringVar(&templateNameFlag, "name", "", "Name of the template (default to the file name without extension)")
+	templateAddCmd.Flags().StringVar(&templateVersionFlag, "version", "", "Version of the template (default to the next integer version)")
+	templateAddCmd.Flags().StringVar(&templateDescriptionFlag, "description", "", "Description of the template (default to the template leading comments)")
+	templateAddCmd.Flags().BoolVar(&templateForceFlag, "force", false, "Overwrite the templates already added with the same version")
+}
+
+var templateCmd = &cobra.Command{
+	Use:               "template",
+	Short:             "Add, list, show or remove templates from your local templates registry",
+	Example:           "  awless template add ~/templates/my-infra.aws --version 1.0\n  awless template add https://github.com/my-team/templates.git\n  awless run @my-infra:1.0",
+	PersistentPreRun:  applyHooks(initAwlessEnvHook),
+	PersistentPostRun: applyHooks(saveHistoryHook),
+}
+
+var templateAddCmd = &cobra.Command{
+	Use:   "add FILEPATH|DIRECTORY|GITURL",
+	Short: "Add templates to the registry from a file, a directory or a git repository (optionally suffixed with //subdir)",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing FILEPATH, DIRECTORY or GITURL arg")
+		}
+
+		source := args[0]
+		path := source
+		if isGitURL(source) {
+			var subdir string
+			if i := strings.LastIndex(source, "//"); i > strings.Index(source, "://")+2 {
+				source, subdir = source[:i], source[i+2:]
+			}
+			dir, err := ioutil.TempDir("", "awless-templates")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(dir)
+
+			if err := gitClone(source, dir); err != nil {
+				return err
+			}
+			path = filepath.Join(dir, subdir)
+		}
+
+		files, err := listTemplateFiles(path)
+		if err != nil {
+			return err
+		}
+
+		if len(files) == 0 {
+			return fmt.Errorf("no template files (%s) found in %s", strings.Join(templateFileExtensions, ", "), args[0])
+		}
+		if len(files) > 1 && templateNameFlag != "" {
+			return errors.New("--name can only be used when adding a single template")
+		}
+
+		templates, err := storedTemplatesFromFiles(files, args[0])
+		if err != nil {
+			return err
+		}
+
+		db, err, dbclose := database.Current()
+		if err != nil {
+			return err
+		}
+		defer dbclose()
+
+		for _, stored := range templates {
+			if err := db.AddTemplate(stored, templateForceFlag); err != nil {
+				return err
+			}
+			fmt.Printf("template %s added\n", renderGreenFn(stored.Key()))
+		}
+
+		return nil
+	},
+}
+
+// storedTemplatesFromFiles parses and names all the template files before anything is written
+// to the registry, so that an invalid file or two files resolving to the same name add nothing
+func storedTemplatesFromFiles(files []string, source string) ([]*database.StoredTemplate, error) {
+	var templates []*database.StoredTemplate
+	fileByName := make(map[string]string)
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		templ, err := template.Parse(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", file, err)
+		}
+
+		stored := &database.StoredTemplate{
+			Name:        templateNameFlag,
+			Version:     templateVersionFlag,
+			Description: templateDescriptionFlag,
+			Holes:       templ.GetHoles(),
+			Source:      source,
+			Content:     string(content),
+		}
+		if stored.Name == "" {
+			stored.Name = strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		}
+		if stored.Description == "" {
+			stored.Description = leadingComments(string(content))
+		}
+
+		if err := database.ValidateTemplateName(stored.Name); err != nil {
+			return nil, fmt.Errorf("%s: %s", file, err)
+		}
+		if other, ok := fileByName[stored.Name]; ok {
+			return nil, fmt.Errorf("%s and %s both resolve to template name '%s'", other, file, stored.Name)
+		}
+		fileByName[stored.Name] = file
+		templates = append(templates, stored)
+	}
+	return templates, nil
+}
+
+var templateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all templates of the registry",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db, err, dbclose := database.Current()
+		exitOn(err)
+		all, err := db.ListTemplates()
+		dbclose()
+		exitOn(err)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "NAME\tVERSION\tDESCRIPTION\tHOLES\tSOURCE")
+		for _, t := range all {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Name, t.Version, t.Description, strings.Join(t.Holes, ", "), t.Source)
+		}
+		return w.Flush()
+	},
+}
+
+var templateShowCmd = &cobra.Command{
+	Use:   "show NAME[:VERSION]",
+	Short: "Show the metadata and content of a template (default to its latest version)",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing NAME arg")
+		}
+
+		db, err, dbclose := database.Current()
+		exitOn(err)
+		t, err := db.GetTemplate(parseTemplateRef(args[0]))
+		dbclose()
+		exitOn(err)
+
+		fmt.Printf("Name: %s\n", t.Name)
+		fmt.Printf("Version: %s\n", t.Version)
+		fmt.Printf("Description: %s\n", t.Description)
+		fmt.Printf("Holes: %s\n", strings.Join(t.Holes, ", "))
+		fmt.Printf("Source: %s\n", t.Source)
+		fmt.Printf("Added: %s\n", t.Created.Local().Format("Jan 2, 2006 15:04"))
+		fmt.Println()
+		fmt.Println(strings.TrimSpace(t.Content))
+
+		return nil
+	},
+}
+
+var templateRmCmd = &cobra.Command{
+	Use:   "rm NAME[:VERSION]",
+	Short: "Remove a version of a template from the registry (default to all its versions)",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing NAME arg")
+		}
+
+		db, err, dbclose := database.Current()
+		exitOn(err)
+		defer dbclose()
+
+		exitOn(db.DeleteTemplate(parseTemplateRef(args[0])))
+
+		return nil
+	},
+}
+
+func isRegistryTemplateRef(arg string) bool {
+	return strings.HasPrefix(arg, "@")
+}
+
+func parseTemplateRef(ref string) (name, version string) {
+	ref = strings.TrimPrefix(ref, "@")
+	if i := strings.LastIndex(ref, ":"); i > -1 {
+		return ref[:i], ref[i+1:]
+	}
+	return ref, ""
+}
+
+func loadRegistryTemplate(ref string) (*database.StoredTemplate, error) {
+	db, err, dbclose := database.Current()
+	if err != nil {
+		return nil, err
+	}
+	defer dbclose()
+
+	return db.GetTemplate(parseTemplateRef(ref))
+}
+
+func isGitURL(source string) bool {
+	if strings.HasPrefix(source, "git@") || strings.HasPrefix(source, "git://") || strings.HasPrefix(source, "ssh://") {
+		return true
+	}
+	if i := strings.LastIndex(source, "//"); i > strings.Index(source, "://")+2 {
+		source = source[:i]
+	}
+	return strings.HasSuffix(source, ".git")
+}
+
+func gitClone(url, dir string) error {
+	out, err := exec.Command("git", "clone", "--quiet", "--depth", "1", url, dir).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git clone %s: %s: %s", url, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func listTemplateFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if strings.HasPrefix(info.Name(), ".") && p != path {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		for _, ext := range templateFileExtensions {
+			if filepath.Ext(p) == ext {
+				files = append(files, p)
+			}
+		}
+		return nil
+	})
+
+	return files, err
+}
+
+func leadingComments(content string) string {
+	var comments []string
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(line, "#"):
+			comments = append(comments, strings.TrimSpace(strings.TrimPrefix(line, "#")))
+		case strings.HasPrefix(line, "//"):
+			comments = append(comments, strings.TrimSpace(strings.TrimPrefix(line, "//")))
+		default:
+			return strings.Join(comments, " ")
+		}
+	}
+	return strings.Join(comments, " ")
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStoredTemplatesFromFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-templates-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	web := write("a/web.aws", "# Create a web instance\ncreate instance name={name}")
+	vpc := write("a/vpc.aws", "create vpc cidr=10.0.0.0/16")
+	otherWeb := write("b/web.aws", "create instance name=other")
+	invalid := write("b/my web.aws", "create vpc cidr=10.0.0.0/16")
+
+	templates, err := storedTemplatesFromFiles([]string{web, vpc}, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(templates), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := templates[0].Name, "web"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := templates[0].Description, "Create a web instance"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := templates[1].Name, "vpc"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	if _, err := storedTemplatesFromFiles([]string{web, vpc, otherWeb}, dir); err == nil || !strings.Contains(err.Error(), "'web'") {
+		t.Fatalf("expected name collision error, got %v", err)
+	}
+	if _, err := storedTemplatesFromFiles([]string{web, invalid}, dir); err == nil {
+		t.Fatal("expected invalid name error")
+	}
+}
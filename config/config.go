@@ -25,7 +25,12 @@ const (
 	autosyncConfigKey              = "autosync"
 	checkUpgradeFrequencyConfigKey = "upgrade.checkfrequency"
 	RegionConfigKey                = "aws.region"
+	RegionsConfigKey               = "aws.regions"
 	ProfileConfigKey               = "aws.profile"
+	AccountConfigKey               = "aws.account"
+
+	// AccountsConfigPrefix prefixes the keys of named account contexts: aws.accounts.<name>.<field>
+	AccountsConfigPrefix = awsconfig.AccountsPrefix
 
 	//Config prefix
 	awsCloudPrefix = "aws."
@@ -39,13 +44,24 @@ const (
 var configDefinitions = map[string]*Definition{
 	autosyncConfigKey:                {help: "Automatically synchronize your cloud locally", defaultValue: "true", parseParamFn: parseBool},
 	RegionConfigKey:                  {help: "AWS region", defaultValue: "us-east-1", parseParamFn: awsconfig.ParseRegion, stdinParamProviderFn: awsconfig.StdinRegionSelector, onUpdateFn: awsconfig.WarningChangeRegion},
+	RegionsConfigKey:                 {help: "AWS regions synced with aws.region: comma separated list or 'all' (when empty: aws.region only)", parseParamFn: awsconfig.ParseRegions},
 	ProfileConfigKey:                 {help: "AWS profile", defaultValue: "default"},
+	AccountConfigKey:                 {help: "Named account context used, set with aws.accounts.<name>.profile|role|externalid|mfa (when empty: aws.profile)", parseParamFn: parseAccount},
 	"aws.infra.sync":                 {help: "Sync AWS EC2/ELBv2 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.access.sync":                {help: "Sync AWS IAM service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.storage.sync":               {help: "Sync AWS S3 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.storage.storageobject.sync": {help: "Sync AWS S3/storageobject (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
 	"aws.notification.sync":          {help: "Sync AWS SNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	"aws.queue.sync":                 {help: "Sync AWS SQS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.database.sync":              {help: "Sync AWS RDS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.lambda.sync":                {help: "Sync AWS Lambda service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.dns.sync":                   {help: "Sync AWS Route53 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.autoscaling.sync":           {help: "Sync AWS Auto Scaling service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.monitoring.sync":            {help: "Sync AWS CloudWatch service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.stack.sync":                 {help: "Sync AWS CloudFormation service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.container.sync":             {help: "Sync AWS ECS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.nosql.sync":                 {help: "Sync AWS DynamoDB service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.encryption.sync":            {help: "Sync AWS KMS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
 	checkUpgradeFrequencyConfigKey:   {help: "Upgrade check frequency (hours); a negative value disables check", defaultValue: "8", parseParamFn: parseInt},
 }
 
@@ -207,6 +223,19 @@ func defaultParser(value string) (interface{}, error) {
 	return value, nil
 }
 
+// parseAccount checks that the account context used is defined (an empty value uses aws.profile)
+func parseAccount(value string) (interface{}, error) {
+	if value == "" {
+		return value, nil
+	}
+	for _, account := range GetAWSAccounts() {
+		if value == account {
+			return value, nil
+		}
+	}
+	return value, fmt.Errorf("unknown account '%s'. Define it with `awless config set %s%s.profile` or `awless config set %s%s.role`", value, AccountsConfigPrefix, value, AccountsConfigPrefix, value)
+}
+
 func defaultStdinParamProvider() string {
 	var value string
 	for value == "" {
@@ -236,7 +265,12 @@ func setVolatile(key, value string) (interface{}, *Definition, bool, error) {
 	}
 	var v interface{}
 	var err error
-	if def != nil && def.parseParamFn != nil {
+	if strings.HasPrefix(key, AccountsConfigPrefix) {
+		if _, _, err = awsconfig.ParseAccountKey(key); err != nil {
+			return nil, def, isConf, err
+		}
+		v = value
+	} else if def != nil && def.parseParamFn != nil {
 		if v, err = def.parseParamFn(value); err != nil {
 			return nil, def, isConf, err
 		}
@@ -2,8 +2,11 @@ package config
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
+
+	awsconfig "github.com/wallix/awless/aws/config"
 )
 
 func GetAWSRegion() string {
@@ -16,6 +19,19 @@ func GetAWSRegion() string {
 	return ""
 }
 
+// GetAWSRegions returns the regions to sync: the session region first, then the ones of aws.regions
+func GetAWSRegions() []string {
+	regions := []string{GetAWSRegion()}
+	if value, ok := Config[RegionsConfigKey]; ok && value != "" {
+		for _, reg := range awsconfig.ExpandRegions(fmt.Sprint(value)) {
+			if reg != regions[0] {
+				regions = append(regions, reg)
+			}
+		}
+	}
+	return regions
+}
+
 func GetAWSProfile() string {
 	if profile, ok := Config[ProfileConfigKey]; ok && profile != "" {
 		return fmt.Sprint(profile)
@@ -26,6 +42,31 @@ func GetAWSProfile() string {
 	return ""
 }
 
+// GetAWSAccount returns the name of the account context used, empty when using aws.profile
+func GetAWSAccount() string {
+	if account, ok := Config[AccountConfigKey]; ok && account != "" {
+		return fmt.Sprint(account)
+	}
+	return ""
+}
+
+// GetAWSAccounts returns the sorted names of the account contexts defined with aws.accounts.<name>.<field>
+func GetAWSAccounts() []string {
+	var accounts []string
+	unique := make(map[string]bool)
+	for k := range Config {
+		if !strings.HasPrefix(k, AccountsConfigPrefix) {
+			continue
+		}
+		if name, _, err := awsconfig.ParseAccountKey(k); err == nil && !unique[name] {
+			unique[name] = true
+			accounts = append(accounts, name)
+		}
+	}
+	sort.Strings(accounts)
+	return accounts
+}
+
 func GetAutosync() bool {
 	if autoSync, ok := Config[autosyncConfigKey].(bool); ok {
 		return autoSync
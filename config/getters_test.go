@@ -43,3 +43,56 @@ func TestGetSyncEnabled(t *testing.T) {
 		}
 	})
 }
+
+func TestGetAWSRegions(t *testing.T) {
+	Config = map[string]interface{}{RegionConfigKey: "eu-west-1"}
+	if got, want := GetAWSRegions(), []string{"eu-west-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	Config[RegionsConfigKey] = "us-east-1,eu-west-1,eu-central-1"
+	if got, want := GetAWSRegions(), []string{"eu-west-1", "us-east-1", "eu-central-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	Config[RegionsConfigKey] = "all"
+	if got := GetAWSRegions(); got[0] != "eu-west-1" || len(got) < 10 {
+		t.Fatalf("got %v, expected all regions starting with eu-west-1", got)
+	}
+}
+
+func TestGetAWSAccounts(t *testing.T) {
+	Config = map[string]interface{}{}
+	configDefinitions = map[string]*Definition{AccountConfigKey: {parseParamFn: parseAccount}}
+	if got := GetAWSAccounts(); len(got) != 0 {
+		t.Fatalf("got %v, want none", got)
+	}
+	for k, v := range map[string]string{
+		"aws.accounts.prod.role":       "arn:aws:iam::123456789012:role/admin",
+		"aws.accounts.prod.externalid": "0042",
+		"aws.accounts.prod.mfa":        "arn:aws:iam::210987654321:mfa/jsmith",
+		"aws.accounts.dev.profile":     "dev",
+	} {
+		if err := SetVolatile(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := SetVolatile(AccountConfigKey, "test"); err == nil {
+		t.Fatal("expected error for unknown account")
+	}
+	if err := SetVolatile(AccountConfigKey, "prod"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := GetAWSAccounts(), []string{"dev", "prod"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := GetAWSAccount(), "prod"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := Config["aws.accounts.prod.externalid"], "0042"; got != want {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	for _, key := range []string{"aws.accounts.prod", "aws.accounts.prod.token", "aws.accounts..role"} {
+		if err := SetVolatile(key, "any"); err == nil {
+			t.Fatalf("%s: expected error", key)
+		}
+	}
+}
@@ -19,11 +19,14 @@ package config
 import (
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	awsconfig "github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/cloud"
 )
 
 var (
@@ -36,14 +39,19 @@ var (
 	AwlessFirstInstall, AwlessFirstSync bool
 )
 
+// AccountDir returns the slash separated directory of the graphs of an account context in the repository.
+// Graphs of the default context (no account) are at the root
+func AccountDir(account string) string {
+	if account == "" {
+		return ""
+	}
+	return path.Join("accounts", account)
+}
+
 func InitAwlessEnv() error {
 	os.Setenv("__AWLESS_HOME", AwlessHome)
 	os.Setenv("__AWLESS_KEYS_DIR", KeysDir)
 	_, err := os.Stat(AwlessHome)
-	_, ierr := os.Stat(filepath.Join(RepoDir, InfraFilename))
-	_, aerr := os.Stat(filepath.Join(RepoDir, AccessFilename))
-	AwlessFirstSync = os.IsNotExist(ierr) || os.IsNotExist(aerr)
-
 	AwlessFirstInstall = os.IsNotExist(err)
 
 	os.MkdirAll(RepoDir, 0700)
@@ -65,6 +73,41 @@ func InitAwlessEnv() error {
 		return err
 	}
 
+	if err = migrateLegacyGraphs(GetAWSRegion()); err != nil {
+		return fmt.Errorf("moving graphs into the directory of region %s: %s", GetAWSRegion(), err)
+	}
+
+	graphsDir := filepath.Join(RepoDir, filepath.FromSlash(AccountDir(GetAWSAccount())))
+	_, ierr := os.Stat(filepath.Join(graphsDir, GetAWSRegion(), InfraFilename))
+	_, aerr := os.Stat(filepath.Join(graphsDir, AccessFilename))
+	AwlessFirstSync = os.IsNotExist(ierr) || os.IsNotExist(aerr)
+
+	return nil
+}
+
+// migrateLegacyGraphs moves the graphs of regional services synced at the root of the repository,
+// before graphs were stored per region, into the directory of the given region
+func migrateLegacyGraphs(region string) error {
+	legacy, err := filepath.Glob(filepath.Join(RepoDir, "*.rdf"))
+	if err != nil {
+		return err
+	}
+	regionDir := filepath.Join(RepoDir, region)
+	for _, path := range legacy {
+		filename := filepath.Base(path)
+		if cloud.GlobalServices[strings.TrimSuffix(filename, ".rdf")] {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(regionDir, filename)); err == nil {
+			continue
+		}
+		if err := os.MkdirAll(regionDir, 0700); err != nil {
+			return err
+		}
+		if err := os.Rename(path, filepath.Join(regionDir, filename)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+)
+
+func TestMigrateLegacyGraphs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(repoDir string) { RepoDir = repoDir }(RepoDir)
+	RepoDir = dir
+	cloud.GlobalServices["access"] = true
+
+	for _, name := range []string{"infra.rdf", "access.rdf", "lambda.rdf"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte("legacy"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	os.MkdirAll(filepath.Join(dir, "eu-west-1"), 0700)
+	if err := ioutil.WriteFile(filepath.Join(dir, "eu-west-1", "lambda.rdf"), []byte("synced"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := migrateLegacyGraphs("eu-west-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := map[string]string{
+		"eu-west-1/infra.rdf":  "legacy",
+		"access.rdf":           "legacy",
+		"eu-west-1/lambda.rdf": "synced",
+	}
+	for path, content := range expect {
+		b, err := ioutil.ReadFile(filepath.Join(dir, path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := string(b), content; got != want {
+			t.Fatalf("%s: got %s, want %s", path, got, want)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "infra.rdf")); !os.IsNotExist(err) {
+		t.Fatalf("expected legacy infra graph to be moved, got %v", err)
+	}
+}
@@ -0,0 +1,387 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// DO NOT EDIT
+// This file was automatically generated with go generate
+package console
+
+import (
+	"github.com/fatih/color"
+	"github.com/wallix/awless/graph"
+)
+
+var DefaultsColumnDefinitions = map[graph.ResourceType][]ColumnDefinition{
+	// infra
+	"instance": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "SubnetId"},
+		StringColumnDefinition{Prop: "Name"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"running": color.FgGreen, "stopped": color.FgRed}},
+		StringColumnDefinition{Prop: "Type"},
+		StringColumnDefinition{Prop: "KeyName", Friendly: "Access Key"},
+		StringColumnDefinition{Prop: "PublicIp", Friendly: "Public IP"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "LaunchTime"}},
+	},
+	"subnet": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "CidrBlock"},
+		StringColumnDefinition{Prop: "AvailabilityZone", Friendly: "Zone"},
+		StringColumnDefinition{Prop: "VpcId"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "MapPublicIpOnLaunch", Friendly: "Public VMs"}, ColoredValues: map[string]color.Attribute{"true": color.FgYellow}},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"available": color.FgGreen}},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "DefaultForAz", Friendly: "ZoneDefault"}, ColoredValues: map[string]color.Attribute{"true": color.FgGreen}},
+	},
+	"vpc": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "IsDefault", Friendly: "Default"}, ColoredValues: map[string]color.Attribute{"true": color.FgGreen}},
+		StringColumnDefinition{Prop: "State"},
+		StringColumnDefinition{Prop: "CidrBlock"},
+	},
+	"keypair": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "KeyFingerprint", DisableTruncate: true},
+	},
+	"securitygroup": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "VpcId"},
+		FirewallRulesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "InboundRules", Friendly: "Inbound"}},
+		FirewallRulesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "OutboundRules", Friendly: "Outbound"}},
+		StringColumnDefinition{Prop: "Name", DisableTruncate: true},
+		StringColumnDefinition{Prop: "Description", DisableTruncate: true},
+	},
+	"volume": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name", DisableTruncate: true},
+		StringColumnDefinition{Prop: "VolumeType"},
+		StringColumnDefinition{Prop: "State"},
+		StringColumnDefinition{Prop: "Size", Friendly: "Size (Gb)"},
+		StringColumnDefinition{Prop: "Encrypted"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime"}},
+		StringColumnDefinition{Prop: "AvailabilityZone"},
+	},
+	"internetgateway": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name", DisableTruncate: true},
+		StringColumnDefinition{Prop: "Vpcs", DisableTruncate: true},
+	},
+	"routetable": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name", DisableTruncate: true},
+		StringColumnDefinition{Prop: "VpcId"},
+		StringColumnDefinition{Prop: "Main"},
+		RoutesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "Routes"}},
+	},
+	"natgateway": {
+		StringColumnDefinition{Prop: "Id"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"available": color.FgGreen, "failed": color.FgRed}},
+		StringColumnDefinition{Prop: "VpcId"},
+		StringColumnDefinition{Prop: "SubnetId"},
+		StringColumnDefinition{Prop: "PublicIPs"},
+		StringColumnDefinition{Prop: "PrivateIPs"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime", Friendly: "Created"}},
+	},
+	"elasticip": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "PublicIp"},
+		StringColumnDefinition{Prop: "PrivateIp"},
+		StringColumnDefinition{Prop: "Domain"},
+		StringColumnDefinition{Prop: "InstanceId"},
+		StringColumnDefinition{Prop: "AssociationId"},
+	},
+	"image": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name", DisableTruncate: true},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"available": color.FgGreen, "failed": color.FgRed}},
+		StringColumnDefinition{Prop: "Architecture"},
+		StringColumnDefinition{Prop: "Type"},
+		StringColumnDefinition{Prop: "RootDeviceType"},
+		StringColumnDefinition{Prop: "Public"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime", Friendly: "Created"}},
+	},
+	"snapshot": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name", DisableTruncate: true},
+		StringColumnDefinition{Prop: "VolumeId"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"completed": color.FgGreen, "error": color.FgRed}},
+		StringColumnDefinition{Prop: "Progress"},
+		StringColumnDefinition{Prop: "Size", Friendly: "Size (Gb)"},
+		StringColumnDefinition{Prop: "Encrypted"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime", Friendly: "Created"}},
+	},
+	"availabilityzone": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "State"},
+		StringColumnDefinition{Prop: "Region"},
+		StringColumnDefinition{Prop: "Messages"},
+	},
+	"loadbalancer": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "VpcId"},
+		StringColumnDefinition{Prop: "State"},
+		StringColumnDefinition{Prop: "DNSName"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime"}},
+		StringColumnDefinition{Prop: "Scheme"},
+	},
+	"targetgroup": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "VpcId"},
+		StringColumnDefinition{Prop: "Matcher"},
+		StringColumnDefinition{Prop: "Port"},
+		StringColumnDefinition{Prop: "Protocol"},
+		StringColumnDefinition{Prop: "HealthCheckIntervalSeconds", Friendly: "HCInterval"},
+		StringColumnDefinition{Prop: "HealthCheckPath", Friendly: "HCPath"},
+		StringColumnDefinition{Prop: "HealthCheckPort", Friendly: "HCPort"},
+		StringColumnDefinition{Prop: "HealthCheckProtocol", Friendly: "HCProtocol"},
+	},
+	"listener": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Actions"},
+		StringColumnDefinition{Prop: "LoadBalancer"},
+		StringColumnDefinition{Prop: "Port"},
+		StringColumnDefinition{Prop: "Protocol"},
+		StringColumnDefinition{Prop: "SslPolicy"},
+	},
+	"classicloadbalancer": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "VpcId"},
+		StringColumnDefinition{Prop: "DNSName"},
+		StringColumnDefinition{Prop: "Listeners"},
+		StringColumnDefinition{Prop: "Instances"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime"}},
+		StringColumnDefinition{Prop: "Scheme"},
+	},
+	// access
+	"user": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name", DisableTruncate: true},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "PasswordLastUsedDate", Friendly: "PasswordLastUsed"}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateDate"}},
+	},
+	"group": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name", DisableTruncate: true},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateDate"}},
+	},
+	"role": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name", DisableTruncate: true},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateDate"}},
+	},
+	"policy": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name", DisableTruncate: true},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateDate"}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "UpdateDate"}},
+	},
+	// storage
+	"bucket": {
+		StringColumnDefinition{Prop: "Name", DisableTruncate: true},
+		GrantsColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "Grants"}},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "Public"}, ColoredValues: map[string]color.Attribute{"true": color.FgRed}},
+		StringColumnDefinition{Prop: "Versioning"},
+		StringColumnDefinition{Prop: "Encryption"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateDate"}},
+	},
+	"storageobject": {
+		StringColumnDefinition{Prop: "Key", TruncateRight: true},
+		StringColumnDefinition{Prop: "BucketName"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "ModifiedDate"}},
+		StringColumnDefinition{Prop: "OwnerId", TruncateRight: true},
+		StringColumnDefinition{Prop: "Size"},
+		StringColumnDefinition{Prop: "Class"},
+	},
+	// notification
+	"subscription": {
+		StringColumnDefinition{Prop: "SubscriptionArn"},
+		StringColumnDefinition{Prop: "TopicArn"},
+		StringColumnDefinition{Prop: "Endpoint", DisableTruncate: true},
+		StringColumnDefinition{Prop: "Protocol"},
+		StringColumnDefinition{Prop: "Owner"},
+	},
+	"topic": {
+		StringColumnDefinition{Prop: "TopicArn", DisableTruncate: true},
+	},
+	// queue
+	"queue": {
+		StringColumnDefinition{Prop: "Id", Friendly: "URL", DisableTruncate: true},
+		StringColumnDefinition{Prop: "ApproximateNumberOfMessages", Friendly: "~NbMsg"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreatedTimestamp", Friendly: "Created"}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "LastModifiedTimestamp", Friendly: "LastModif"}},
+		StringColumnDefinition{Prop: "DelaySeconds", Friendly: "Delay(s)"},
+	},
+	// database
+	"database": {
+		StringColumnDefinition{Prop: "Id"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"available": color.FgGreen, "failed": color.FgRed, "stopped": color.FgRed}},
+		StringColumnDefinition{Prop: "Class"},
+		StringColumnDefinition{Prop: "Engine"},
+		StringColumnDefinition{Prop: "EngineVersion", Friendly: "Version"},
+		StringColumnDefinition{Prop: "Storage", Friendly: "Storage(GiB)"},
+		StringColumnDefinition{Prop: "Address", DisableTruncate: true},
+		StringColumnDefinition{Prop: "Port"},
+		StringColumnDefinition{Prop: "VpcId"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime", Friendly: "Created"}},
+	},
+	"dbsubnetgroup": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "VpcId"},
+		StringColumnDefinition{Prop: "State"},
+		StringColumnDefinition{Prop: "Subnets"},
+		StringColumnDefinition{Prop: "Description", TruncateRight: true},
+	},
+	"dbparametergroup": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "Family"},
+		StringColumnDefinition{Prop: "Description", TruncateRight: true},
+	},
+	// lambda
+	"function": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "Runtime"},
+		StringColumnDefinition{Prop: "Handler"},
+		StringColumnDefinition{Prop: "Memory", Friendly: "Memory(MB)"},
+		StringColumnDefinition{Prop: "Timeout", Friendly: "Timeout(s)"},
+		StringColumnDefinition{Prop: "Size", Friendly: "Size(B)"},
+		StringColumnDefinition{Prop: "VpcId"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "Modified"}},
+	},
+	// dns
+	"zone": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "Comment"},
+		StringColumnDefinition{Prop: "Private"},
+		StringColumnDefinition{Prop: "RecordCount", Friendly: "Records"},
+	},
+	"record": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "Type"},
+		StringColumnDefinition{Prop: "TTL"},
+		StringColumnDefinition{Prop: "Records", DisableTruncate: true},
+		StringColumnDefinition{Prop: "Alias"},
+		StringColumnDefinition{Prop: "Zone"},
+	},
+	// autoscaling
+	"launchconfiguration": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "Type"},
+		StringColumnDefinition{Prop: "ImageId"},
+		StringColumnDefinition{Prop: "KeyName"},
+		StringColumnDefinition{Prop: "SecurityGroups"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime", Friendly: "Created"}},
+	},
+	"scalinggroup": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "LaunchConfigurationName", Friendly: "LaunchConfig"},
+		StringColumnDefinition{Prop: "MinSize", Friendly: "Min"},
+		StringColumnDefinition{Prop: "DesiredCapacity", Friendly: "Desired"},
+		StringColumnDefinition{Prop: "MaxSize", Friendly: "Max"},
+		StringColumnDefinition{Prop: "Instances"},
+		StringColumnDefinition{Prop: "Subnets"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime", Friendly: "Created"}},
+	},
+	// monitoring
+	"alarm": {
+		StringColumnDefinition{Prop: "Name"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"ALARM": color.FgRed, "OK": color.FgGreen}},
+		StringColumnDefinition{Prop: "Namespace"},
+		StringColumnDefinition{Prop: "MetricName", Friendly: "Metric"},
+		StringColumnDefinition{Prop: "Operator"},
+		StringColumnDefinition{Prop: "Threshold"},
+		StringColumnDefinition{Prop: "Dimensions"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "StateUpdated", Friendly: "Updated"}},
+	},
+	// stack
+	"stack": {
+		StringColumnDefinition{Prop: "Name"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"CREATE_COMPLETE": color.FgGreen, "CREATE_FAILED": color.FgRed, "DELETE_FAILED": color.FgRed, "ROLLBACK_COMPLETE": color.FgRed, "ROLLBACK_FAILED": color.FgRed, "UPDATE_COMPLETE": color.FgGreen, "UPDATE_ROLLBACK_COMPLETE": color.FgRed, "UPDATE_ROLLBACK_FAILED": color.FgRed}},
+		StringColumnDefinition{Prop: "Parameters"},
+		StringColumnDefinition{Prop: "Outputs"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime", Friendly: "Created"}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "UpdateTime", Friendly: "Updated"}},
+	},
+	// container
+	"cluster": {
+		StringColumnDefinition{Prop: "Name"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"ACTIVE": color.FgGreen, "INACTIVE": color.FgRed}},
+		StringColumnDefinition{Prop: "ActiveServicesCount", Friendly: "Services"},
+		StringColumnDefinition{Prop: "RunningTasksCount", Friendly: "Running"},
+		StringColumnDefinition{Prop: "PendingTasksCount", Friendly: "Pending"},
+		StringColumnDefinition{Prop: "ContainerInstancesCount", Friendly: "Instances"},
+	},
+	"service": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "Cluster"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"ACTIVE": color.FgGreen, "DRAINING": color.FgYellow, "INACTIVE": color.FgRed}},
+		StringColumnDefinition{Prop: "TaskDefinition"},
+		StringColumnDefinition{Prop: "DesiredCount", Friendly: "Desired"},
+		StringColumnDefinition{Prop: "RunningCount", Friendly: "Running"},
+		StringColumnDefinition{Prop: "PendingCount", Friendly: "Pending"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime", Friendly: "Created"}},
+	},
+	"taskdefinition": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "Revision"},
+		StringColumnDefinition{Prop: "State"},
+		StringColumnDefinition{Prop: "Containers"},
+		StringColumnDefinition{Prop: "Images"},
+		StringColumnDefinition{Prop: "Role"},
+	},
+	"task": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Cluster"},
+		StringColumnDefinition{Prop: "TaskDefinition"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"PENDING": color.FgYellow, "RUNNING": color.FgGreen, "STOPPED": color.FgRed}},
+		StringColumnDefinition{Prop: "Group"},
+		StringColumnDefinition{Prop: "ContainerInstance"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "LaunchTime", Friendly: "Started"}},
+	},
+	"containerinstance": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Cluster"},
+		StringColumnDefinition{Prop: "InstanceId", Friendly: "Instance"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"ACTIVE": color.FgGreen, "DRAINING": color.FgYellow, "INACTIVE": color.FgRed}},
+		StringColumnDefinition{Prop: "AgentConnected", Friendly: "Agent"},
+		StringColumnDefinition{Prop: "RunningTasksCount", Friendly: "Running"},
+		StringColumnDefinition{Prop: "PendingTasksCount", Friendly: "Pending"},
+	},
+	// nosql
+	"table": {
+		StringColumnDefinition{Prop: "Name"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"ACTIVE": color.FgGreen, "CREATING": color.FgYellow, "DELETING": color.FgRed, "UPDATING": color.FgYellow}},
+		StringColumnDefinition{Prop: "KeySchema", Friendly: "Keys"},
+		StringColumnDefinition{Prop: "ReadCapacity", Friendly: "Read"},
+		StringColumnDefinition{Prop: "WriteCapacity", Friendly: "Write"},
+		StringColumnDefinition{Prop: "ItemCount", Friendly: "Items"},
+		StringColumnDefinition{Prop: "Size", Friendly: "Size(B)"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime", Friendly: "Created"}},
+	},
+	// encryption
+	"key": {
+		StringColumnDefinition{Prop: "Id"},
+		StringColumnDefinition{Prop: "Description"},
+		ColoredValueColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "State"}, ColoredValues: map[string]color.Attribute{"Disabled": color.FgYellow, "Enabled": color.FgGreen, "PendingDeletion": color.FgRed, "PendingImport": color.FgYellow}},
+		StringColumnDefinition{Prop: "Usage"},
+		StringColumnDefinition{Prop: "Origin"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "CreateTime", Friendly: "Created"}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: "DeletionTime", Friendly: "Deletion"}},
+	},
+	"keyalias": {
+		StringColumnDefinition{Prop: "Name"},
+		StringColumnDefinition{Prop: "Key"},
+	},
+}
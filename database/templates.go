@@ -0,0 +1,226 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+const TEMPLATES_BUCKET = "templates"
+
+// A StoredTemplate is a named and versioned template saved in the local registry
+type StoredTemplate struct {
+	Name, Version string
+	Description   string
+	Holes         []string
+	Source        string
+	Content       string
+	Created       time.Time
+}
+
+func (t *StoredTemplate) Key() string {
+	return templateKey(t.Name, t.Version)
+}
+
+func templateKey(name, version string) string {
+	return fmt.Sprintf("%s:%s", name, version)
+}
+
+// AddTemplate stores a template in the registry. When no version is given,
+// the next integer version for this template name is attributed.
+// Adding an existing version of a template fails unless overwrite is set.
+func (db *DB) AddTemplate(templ *StoredTemplate, overwrite bool) error {
+	if err := ValidateTemplateName(templ.Name); err != nil {
+		return err
+	}
+
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(TEMPLATES_BUCKET))
+		if err != nil {
+			return fmt.Errorf("create bucket %s: %s", TEMPLATES_BUCKET, err)
+		}
+
+		if templ.Version == "" {
+			templ.Version = strconv.Itoa(nextTemplateVersion(bucket, templ.Name))
+		} else if !overwrite && bucket.Get([]byte(templ.Key())) != nil {
+			return fmt.Errorf("template '%s' already exists", templ.Key())
+		}
+		if templ.Created.IsZero() {
+			templ.Created = time.Now().UTC()
+		}
+
+		b, err := json.Marshal(templ)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(templ.Key()), b)
+	})
+}
+
+// ValidateTemplateName checks a name can be used to store and reference a template
+func ValidateTemplateName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("template name is empty")
+	}
+	if strings.ContainsAny(name, ":@ ") {
+		return fmt.Errorf("invalid template name '%s': must not contain ':', '@' or spaces", name)
+	}
+	return nil
+}
+
+// GetTemplate returns the template stored with the given name and version.
+// An empty version returns the latest added version of the template.
+func (db *DB) GetTemplate(name, version string) (*StoredTemplate, error) {
+	if version != "" {
+		templ := &StoredTemplate{}
+		err := db.bolt.View(func(tx *bolt.Tx) error {
+			b := tx.Bucket([]byte(TEMPLATES_BUCKET))
+			if b == nil {
+				return errors.New("no templates stored yet")
+			}
+			content := b.Get([]byte(templateKey(name, version)))
+			if content == nil {
+				return fmt.Errorf("no template '%s' with version '%s'", name, version)
+			}
+			return json.Unmarshal(content, templ)
+		})
+		return templ, err
+	}
+
+	versions, err := db.ListTemplateVersions(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("no template '%s'", name)
+	}
+
+	return versions[len(versions)-1], nil
+}
+
+// ListTemplates returns all stored templates sorted by name then creation date
+func (db *DB) ListTemplates() ([]*StoredTemplate, error) {
+	var result []*StoredTemplate
+
+	err := db.bolt.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(TEMPLATES_BUCKET))
+		if b == nil {
+			return nil
+		}
+
+		c := b.Cursor()
+
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			t := &StoredTemplate{}
+			if err := json.Unmarshal(v, t); err != nil {
+				return err
+			}
+			result = append(result, t)
+		}
+
+		return nil
+	})
+
+	sort.Sort(byNameAndCreation(result))
+
+	return result, err
+}
+
+// ListTemplateVersions returns all stored versions of a template, oldest first
+func (db *DB) ListTemplateVersions(name string) ([]*StoredTemplate, error) {
+	all, err := db.ListTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*StoredTemplate
+	for _, t := range all {
+		if t.Name == name {
+			result = append(result, t)
+		}
+	}
+
+	return result, nil
+}
+
+// DeleteTemplate removes a version of a template from the registry.
+// An empty version removes all versions of the template.
+func (db *DB) DeleteTemplate(name, version string) error {
+	var keys []string
+	if version != "" {
+		keys = append(keys, templateKey(name, version))
+	} else {
+		versions, err := db.ListTemplateVersions(name)
+		if err != nil {
+			return err
+		}
+		for _, t := range versions {
+			keys = append(keys, t.Key())
+		}
+	}
+
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(TEMPLATES_BUCKET))
+		if b == nil {
+			return errors.New("no templates stored yet")
+		}
+		if len(keys) == 0 {
+			return fmt.Errorf("no template '%s'", name)
+		}
+		for _, k := range keys {
+			if b.Get([]byte(k)) == nil {
+				return fmt.Errorf("no template '%s'", k)
+			}
+			if err := b.Delete([]byte(k)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func nextTemplateVersion(bucket *bolt.Bucket, name string) int {
+	var max int
+	prefix := []byte(name + ":")
+	c := bucket.Cursor()
+	for k, _ := c.Seek(prefix); k != nil && strings.HasPrefix(string(k), string(prefix)); k, _ = c.Next() {
+		if v, err := strconv.Atoi(strings.TrimPrefix(string(k), string(prefix))); err == nil && v > max {
+			max = v
+		}
+	}
+	return max + 1
+}
+
+type byNameAndCreation []*StoredTemplate
+
+func (b byNameAndCreation) Len() int      { return len(b) }
+func (b byNameAndCreation) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byNameAndCreation) Less(i, j int) bool {
+	if b[i].Name != b[j].Name {
+		return b[i].Name < b[j].Name
+	}
+	return b[i].Created.Before(b[j].Created)
+}
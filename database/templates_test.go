@@ -0,0 +1,134 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"testing"
+)
+
+func TestTemplateRegistry(t *testing.T) {
+	db, close := newTestDb()
+	defer close()
+
+	if all, err := db.ListTemplates(); err != nil {
+		t.Fatal(err)
+	} else if got, want := len(all), 0; got != want {
+		t.Fatalf("got %d; want %d", got, want)
+	}
+
+	if err := db.AddTemplate(&StoredTemplate{Name: "infra", Content: "create vpc cidr={vpc.cidr}", Holes: []string{"vpc.cidr"}}, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddTemplate(&StoredTemplate{Name: "infra", Content: "create vpc cidr=10.0.0.0/16"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddTemplate(&StoredTemplate{Name: "app", Version: "beta", Content: "create instance"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddTemplate(&StoredTemplate{Name: "in:valid"}, false); err == nil {
+		t.Fatal("expected error got none")
+	}
+
+	all, err := db.ListTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(all), 3; got != want {
+		t.Fatalf("got %d; want %d", got, want)
+	}
+	if got, want := all[0].Key(), "app:beta"; got != want {
+		t.Fatalf("got %s; want %s", got, want)
+	}
+	if got, want := all[1].Key(), "infra:1"; got != want {
+		t.Fatalf("got %s; want %s", got, want)
+	}
+	if got, want := all[2].Key(), "infra:2"; got != want {
+		t.Fatalf("got %s; want %s", got, want)
+	}
+
+	templ, err := db.GetTemplate("infra", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := templ.Content, "create vpc cidr=10.0.0.0/16"; got != want {
+		t.Fatalf("got %s; want %s", got, want)
+	}
+	templ, err = db.GetTemplate("infra", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := templ.Holes, []string{"vpc.cidr"}; len(got) != 1 || got[0] != want[0] {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+	if _, err = db.GetTemplate("infra", "3"); err == nil {
+		t.Fatal("expected error got none")
+	}
+
+	if err := db.DeleteTemplate("infra", "2"); err != nil {
+		t.Fatal(err)
+	}
+	if templ, err = db.GetTemplate("infra", ""); err != nil {
+		t.Fatal(err)
+	} else if got, want := templ.Version, "1"; got != want {
+		t.Fatalf("got %s; want %s", got, want)
+	}
+
+	if err := db.DeleteTemplate("infra", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.GetTemplate("infra", ""); err == nil {
+		t.Fatal("expected error got none")
+	}
+	if all, err = db.ListTemplates(); err != nil {
+		t.Fatal(err)
+	} else if got, want := len(all), 1; got != want {
+		t.Fatalf("got %d; want %d", got, want)
+	}
+}
+
+func TestAddExistingTemplateVersion(t *testing.T) {
+	db, close := newTestDb()
+	defer close()
+
+	if err := db.AddTemplate(&StoredTemplate{Name: "infra", Version: "1.0", Content: "create vpc cidr=10.0.0.0/16"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddTemplate(&StoredTemplate{Name: "infra", Version: "1.0", Content: "create vpc cidr=10.1.0.0/16"}, false); err == nil {
+		t.Fatal("expected error got none")
+	}
+	templ, err := db.GetTemplate("infra", "1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := templ.Content, "create vpc cidr=10.0.0.0/16"; got != want {
+		t.Fatalf("got %s; want %s", got, want)
+	}
+
+	if err := db.AddTemplate(&StoredTemplate{Name: "infra", Version: "1.0", Content: "create vpc cidr=10.1.0.0/16"}, true); err != nil {
+		t.Fatal(err)
+	}
+	if templ, err = db.GetTemplate("infra", "1.0"); err != nil {
+		t.Fatal(err)
+	} else if got, want := templ.Content, "create vpc cidr=10.1.0.0/16"; got != want {
+		t.Fatalf("got %s; want %s", got, want)
+	}
+	if all, err := db.ListTemplates(); err != nil {
+		t.Fatal(err)
+	} else if got, want := len(all), 1; got != want {
+		t.Fatalf("got %d; want %d", got, want)
+	}
+}
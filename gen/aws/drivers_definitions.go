@@ -16,16 +16,47 @@ limitations under the License.
 
 package aws
 
-import "github.com/wallix/awless/graph"
+import (
+	"strings"
+
+	"github.com/wallix/awless/graph"
+)
 
 type param struct {
 	AwsField, AwsType string
 	TemplateName      string
+
+	// Type is the type of the template value (awsstr, awsint, awsbool, cidr, enum).
+	// When empty, it is deduced from the AwsType
+	Type          string
+	AllowedValues []string
+	Regex         string
+	Description   string
+}
+
+func (p param) TemplateType() string {
+	switch {
+	case p.Type != "":
+		return p.Type
+	case len(p.AllowedValues) > 0:
+		return "enum"
+	case strings.HasPrefix(p.AwsType, "awsint"):
+		return "awsint"
+	case strings.HasPrefix(p.AwsType, "awsbool"):
+		return "awsbool"
+	default:
+		return "awsstr"
+	}
 }
 
+// driver defines the template driver of an action on an entity. A driver only naming its ApiMethod (without Input)
+// is completed from the API model of the SDK when generating (see ResolveDriversFromModels): its input and output
+// types, the AwsType of its params, their split between required and extra params and its output extractor
 type driver struct {
-	RequiredParams                            []param
-	ExtraParams                               []param
+	RequiredParams []param
+	ExtraParams    []param
+	// Params are split between RequiredParams and ExtraParams as required by the API
+	Params                                    []param
 	TagsMapping                               map[string]string
 	Action, Entity                            string
 	Input, Output, ApiMethod, OutputExtractor string
@@ -33,53 +64,74 @@ type driver struct {
 	ManualFuncDefinition                      bool
 }
 
+// ParamsDefinitions returns the required and extra params, each template name only once
+func (d driver) ParamsDefinitions() (params []param) {
+	done := make(map[string]bool)
+	for _, p := range append(d.RequiredParams, d.ExtraParams...) {
+		if !done[p.TemplateName] {
+			done[p.TemplateName] = true
+			params = append(params, p)
+		}
+	}
+	return
+}
+
 type driversDef struct {
 	Api     string
 	Drivers []driver
 }
 
+// HasGeneratedDrivers reports whether at least one driver of the API is generated,
+// i.e. is not a ManualFuncDefinition
+func (d driversDef) HasGeneratedDrivers() bool {
+	for _, drv := range d.Drivers {
+		if !drv.ManualFuncDefinition {
+			return true
+		}
+	}
+	return false
+}
+
 var DriversDefs = []driversDef{
 	{
 		Api: "ec2",
 		Drivers: []driver{
 			// VPC
 			{
-				Action: "create", Entity: graph.Vpc.String(), Input: "CreateVpcInput", Output: "CreateVpcOutput", ApiMethod: "CreateVpc", OutputExtractor: "aws.StringValue(output.Vpc.VpcId)",
-				RequiredParams: []param{
-					{AwsField: "CidrBlock", TemplateName: "cidr", AwsType: "awsstr"},
+				Action: "create", Entity: graph.Vpc.String(), ApiMethod: "CreateVpc",
+				Params: []param{
+					{AwsField: "CidrBlock", TemplateName: "cidr", Type: "cidr", Description: "IPv4 network range of the VPC (from /16 to /28)"},
 				},
 			},
 			{
-				Action: "delete", Entity: graph.Vpc.String(), Input: "DeleteVpcInput", Output: "DeleteVpcOutput", ApiMethod: "DeleteVpc",
-				RequiredParams: []param{
-					{AwsField: "VpcId", TemplateName: "id", AwsType: "awsstr"},
+				Action: "delete", Entity: graph.Vpc.String(), ApiMethod: "DeleteVpc",
+				Params: []param{
+					{AwsField: "VpcId", TemplateName: "id", Regex: "^vpc-", Description: "id of the VPC"},
 				},
 			},
 
 			// SUBNET
 			{
-				Action: "create", Entity: graph.Subnet.String(), Input: "CreateSubnetInput", Output: "CreateSubnetOutput", ApiMethod: "CreateSubnet", OutputExtractor: "aws.StringValue(output.Subnet.SubnetId)",
-				RequiredParams: []param{
-					{AwsField: "CidrBlock", TemplateName: "cidr", AwsType: "awsstr"},
-					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr"},
-				},
-				ExtraParams: []param{
-					{AwsField: "AvailabilityZone", TemplateName: "zone", AwsType: "awsstr"},
+				Action: "create", Entity: graph.Subnet.String(), ApiMethod: "CreateSubnet",
+				Params: []param{
+					{AwsField: "CidrBlock", TemplateName: "cidr", Type: "cidr", Description: "IPv4 network range of the subnet, within the VPC range"},
+					{AwsField: "VpcId", TemplateName: "vpc", Description: "id of the VPC"},
+					{AwsField: "AvailabilityZone", TemplateName: "zone", Regex: `^[a-z]{2}(-[a-z]+)+-\d[a-z]$`, Description: "availability zone (ex: us-west-2a)"},
 				},
 			},
 			{
 				Action: "update", Entity: graph.Subnet.String(), Input: "ModifySubnetAttributeInput", Output: "ModifySubnetAttributeOutput", ApiMethod: "ModifySubnetAttribute", DryRunUnsupported: true,
 				RequiredParams: []param{
-					{AwsField: "SubnetId", TemplateName: "id", AwsType: "awsstr"},
+					{AwsField: "SubnetId", TemplateName: "id", AwsType: "awsstr", Description: "id of the subnet"},
 				},
 				ExtraParams: []param{
-					{AwsField: "MapPublicIpOnLaunch", TemplateName: "public", AwsType: "awsbool"},
+					{AwsField: "MapPublicIpOnLaunch", TemplateName: "public", AwsType: "awsboolattribute", Description: "auto-assign a public IP to instances launched in the subnet"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.Subnet.String(), Input: "DeleteSubnetInput", Output: "DeleteSubnetOutput", ApiMethod: "DeleteSubnet",
 				RequiredParams: []param{
-					{AwsField: "SubnetId", TemplateName: "id", AwsType: "awsstr"},
+					{AwsField: "SubnetId", TemplateName: "id", AwsType: "awsstr", Description: "id of the subnet"},
 				},
 			},
 
@@ -87,18 +139,18 @@ var DriversDefs = []driversDef{
 			{
 				Action: "create", Entity: graph.Instance.String(), Input: "RunInstancesInput", Output: "Reservation", ApiMethod: "RunInstances", OutputExtractor: "aws.StringValue(output.Instances[0].InstanceId)",
 				RequiredParams: []param{
-					{AwsField: "ImageId", TemplateName: "image", AwsType: "awsstr"},
-					{AwsField: "MaxCount", TemplateName: "count", AwsType: "awsint64"},
-					{AwsField: "MinCount", TemplateName: "count", AwsType: "awsint64"},
-					{AwsField: "InstanceType", TemplateName: "type", AwsType: "awsstr"},
-					{AwsField: "SubnetId", TemplateName: "subnet", AwsType: "awsstr"},
+					{AwsField: "ImageId", TemplateName: "image", AwsType: "awsstr", Description: "id of the AMI"},
+					{AwsField: "MaxCount", TemplateName: "count", AwsType: "awsint64", Description: "number of instances to launch"},
+					{AwsField: "MinCount", TemplateName: "count", AwsType: "awsint64", Description: "number of instances to launch"},
+					{AwsField: "InstanceType", TemplateName: "type", AwsType: "awsstr", Regex: `^[a-z][a-z0-9-]*\.[a-z0-9]+$`, Description: "instance type (ex: t2.micro)"},
+					{AwsField: "SubnetId", TemplateName: "subnet", AwsType: "awsstr", Description: "id of the subnet to launch the instances in"},
 				},
 				ExtraParams: []param{
-					{AwsField: "KeyName", TemplateName: "key", AwsType: "awsstr"},
-					{AwsField: "PrivateIpAddress", TemplateName: "ip", AwsType: "awsstr"},
-					{AwsField: "UserData", TemplateName: "userdata", AwsType: "awsstr"},
-					{AwsField: "SecurityGroupIds", TemplateName: "group", AwsType: "awsstringslice"},
-					{AwsField: "DisableApiTermination", TemplateName: "lock", AwsType: "awsboolattribute"},
+					{AwsField: "KeyName", TemplateName: "key", AwsType: "awsstr", Description: "name of the keypair"},
+					{AwsField: "PrivateIpAddress", TemplateName: "ip", AwsType: "awsstr", Regex: `^(\d{1,3}\.){3}\d{1,3}$`, Description: "private IPv4 address within the subnet range"},
+					{AwsField: "UserData", TemplateName: "userdata", AwsType: "awsstr", Description: "user data file path or url"},
+					{AwsField: "SecurityGroupIds", TemplateName: "group", AwsType: "awsstringslice", Description: "ids of the security groups"},
+					{AwsField: "DisableApiTermination", TemplateName: "lock", AwsType: "awsbool", Description: "prevent the instance termination"},
 				},
 				TagsMapping: map[string]string{
 					"Name": "name",
@@ -107,38 +159,38 @@ var DriversDefs = []driversDef{
 			{
 				Action: "update", Entity: graph.Instance.String(), Input: "ModifyInstanceAttributeInput", Output: "ModifyInstanceAttributeOutput", ApiMethod: "ModifyInstanceAttribute",
 				RequiredParams: []param{
-					{AwsField: "InstanceId", TemplateName: "id", AwsType: "awsstr"},
+					{AwsField: "InstanceId", TemplateName: "id", AwsType: "awsstr", Description: "id of the instance"},
 				},
 				ExtraParams: []param{
-					{AwsField: "InstanceType", TemplateName: "type", AwsType: "awsstr"},
-					{AwsField: "Groups", TemplateName: "group", AwsType: "awsstringslice"},
-					{AwsField: "DisableApiTermination", TemplateName: "lock", AwsType: "awsboolattribute"},
+					{AwsField: "InstanceType", TemplateName: "type", AwsType: "awsstringattribute", Regex: `^[a-z][a-z0-9-]*\.[a-z0-9]+$`, Description: "instance type (ex: t2.micro)"},
+					{AwsField: "Groups", TemplateName: "group", AwsType: "awsstringslice", Description: "ids of the security groups"},
+					{AwsField: "DisableApiTermination", TemplateName: "lock", AwsType: "awsboolattribute", Description: "prevent the instance termination"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.Instance.String(), Input: "TerminateInstancesInput", Output: "TerminateInstancesOutput", ApiMethod: "TerminateInstances",
 				RequiredParams: []param{
-					{AwsField: "InstanceIds", TemplateName: "id", AwsType: "awsstringslice"},
+					{AwsField: "InstanceIds", TemplateName: "id", AwsType: "awsstringslice", Description: "ids of the instances"},
 				},
 			},
 			{
 				Action: "start", Entity: graph.Instance.String(), Input: "StartInstancesInput", Output: "StartInstancesOutput", ApiMethod: "StartInstances", OutputExtractor: "aws.StringValue(output.StartingInstances[0].InstanceId)",
 				RequiredParams: []param{
-					{AwsField: "InstanceIds", TemplateName: "id", AwsType: "awsstringslice"},
+					{AwsField: "InstanceIds", TemplateName: "id", AwsType: "awsstringslice", Description: "ids of the instances"},
 				},
 			},
 			{
 				Action: "stop", Entity: graph.Instance.String(), Input: "StopInstancesInput", Output: "StopInstancesOutput", ApiMethod: "StopInstances", OutputExtractor: "aws.StringValue(output.StoppingInstances[0].InstanceId)",
 				RequiredParams: []param{
-					{AwsField: "InstanceIds", TemplateName: "id", AwsType: "awsstringslice"},
+					{AwsField: "InstanceIds", TemplateName: "id", AwsType: "awsstringslice", Description: "ids of the instances"},
 				},
 			},
 			{
 				Action: "check", Entity: graph.Instance.String(), ManualFuncDefinition: true,
 				RequiredParams: []param{
-					{TemplateName: "id"},
-					{TemplateName: "state"},
-					{TemplateName: "timeout"},
+					{TemplateName: "id", Description: "id of the instance"},
+					{TemplateName: "state", AllowedValues: []string{"pending", "running", "shutting-down", "terminated", "stopping", "stopped"}, Description: "expected state of the instance"},
+					{TemplateName: "timeout", Type: "awsint", Description: "timeout in seconds"},
 				},
 			},
 
@@ -146,28 +198,28 @@ var DriversDefs = []driversDef{
 			{
 				Action: "create", Entity: graph.SecurityGroup.String(), Input: "CreateSecurityGroupInput", Output: "CreateSecurityGroupOutput", ApiMethod: "CreateSecurityGroup", OutputExtractor: "aws.StringValue(output.GroupId)",
 				RequiredParams: []param{
-					{AwsField: "GroupName", TemplateName: "name", AwsType: "awsstr"},
-					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr"},
-					{AwsField: "Description", TemplateName: "description", AwsType: "awsstr"},
+					{AwsField: "GroupName", TemplateName: "name", AwsType: "awsstr", Description: "name of the security group"},
+					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr", Description: "id of the VPC"},
+					{AwsField: "Description", TemplateName: "description", AwsType: "awsstr", Description: "description of the security group"},
 				},
 			},
 			{
 				Action: "update", Entity: graph.SecurityGroup.String(), ManualFuncDefinition: true,
 				RequiredParams: []param{
-					{TemplateName: "id"},
-					{TemplateName: "cidr"},
-					{TemplateName: "protocol"},
+					{TemplateName: "id", Description: "id of the security group"},
+					{TemplateName: "cidr", Type: "cidr", Description: "IPv4 network range the rule applies to"},
+					{TemplateName: "protocol", Regex: `^(tcp|udp|icmp|any|-1|\d{1,3})$`, Description: "protocol of the rule: tcp, udp, icmp, any (or -1) or an IP protocol number"},
 				},
 				ExtraParams: []param{
-					{TemplateName: "inbound"}, // either inbound or outbound = either authorize or revoke
-					{TemplateName: "outbound"},
-					{TemplateName: "portrange"},
+					{TemplateName: "inbound", AllowedValues: []string{"authorize", "revoke"}, Description: "authorize or revoke an inbound rule"}, // either inbound or outbound = either authorize or revoke
+					{TemplateName: "outbound", AllowedValues: []string{"authorize", "revoke"}, Description: "authorize or revoke an outbound rule"},
+					{TemplateName: "portrange", Regex: `^(any|\d+(-\d+)?)$`, Description: "port or port range (ex: 22, 8080-8090, any)"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.SecurityGroup.String(), Input: "DeleteSecurityGroupInput", Output: "DeleteSecurityGroupOutput", ApiMethod: "DeleteSecurityGroup",
 				RequiredParams: []param{
-					{AwsField: "GroupId", TemplateName: "id", AwsType: "awsstr"},
+					{AwsField: "GroupId", TemplateName: "id", AwsType: "awsstr", Description: "id of the security group"},
 				},
 			},
 
@@ -175,22 +227,68 @@ var DriversDefs = []driversDef{
 			{
 				Action: "create", Entity: graph.Volume.String(), Input: "CreateVolumeInput", Output: "Volume", ApiMethod: "CreateVolume", OutputExtractor: "aws.StringValue(output.VolumeId)",
 				RequiredParams: []param{
-					{AwsField: "AvailabilityZone", TemplateName: "zone", AwsType: "awsstr"},
-					{AwsField: "Size", TemplateName: "size", AwsType: "awsint64"},
+					{AwsField: "AvailabilityZone", TemplateName: "zone", AwsType: "awsstr", Regex: `^[a-z]{2}(-[a-z]+)+-\d[a-z]$`, Description: "availability zone (ex: us-west-2a)"},
+					{AwsField: "Size", TemplateName: "size", AwsType: "awsint64", Description: "size of the volume in GiB"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.Volume.String(), Input: "DeleteVolumeInput", Output: "DeleteVolumeOutput", ApiMethod: "DeleteVolume",
 				RequiredParams: []param{
-					{AwsField: "VolumeId", TemplateName: "id", AwsType: "awsstr"},
+					{AwsField: "VolumeId", TemplateName: "id", AwsType: "awsstr", Description: "id of the volume"},
 				},
 			},
 			{
 				Action: "attach", Entity: graph.Volume.String(), Input: "AttachVolumeInput", Output: "VolumeAttachment", ApiMethod: "AttachVolume", OutputExtractor: "aws.StringValue(output.VolumeId)",
 				RequiredParams: []param{
-					{AwsField: "Device", TemplateName: "device", AwsType: "awsstr"},
-					{AwsField: "VolumeId", TemplateName: "id", AwsType: "awsstr"},
-					{AwsField: "InstanceId", TemplateName: "instance", AwsType: "awsstr"},
+					{AwsField: "Device", TemplateName: "device", AwsType: "awsstr", Description: "device name exposed to the instance (ex: /dev/sdh)"},
+					{AwsField: "VolumeId", TemplateName: "id", AwsType: "awsstr", Description: "id of the volume"},
+					{AwsField: "InstanceId", TemplateName: "instance", AwsType: "awsstr", Description: "id of the instance"},
+				},
+			},
+			// SNAPSHOTS
+			{
+				Action: "create", Entity: graph.Snapshot.String(), Input: "CreateSnapshotInput", Output: "Snapshot", ApiMethod: "CreateSnapshot", OutputExtractor: "aws.StringValue(output.SnapshotId)",
+				RequiredParams: []param{
+					{AwsField: "VolumeId", TemplateName: "volume", AwsType: "awsstr", Description: "id of the volume to snapshot"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Description", TemplateName: "description", AwsType: "awsstr", Description: "description of the snapshot"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.Snapshot.String(), Input: "DeleteSnapshotInput", Output: "DeleteSnapshotOutput", ApiMethod: "DeleteSnapshot",
+				RequiredParams: []param{
+					{AwsField: "SnapshotId", TemplateName: "id", AwsType: "awsstr", Description: "id of the snapshot"},
+				},
+			},
+			// IMAGES
+			{
+				Action: "create", Entity: graph.Image.String(), Input: "CreateImageInput", Output: "CreateImageOutput", ApiMethod: "CreateImage", OutputExtractor: "aws.StringValue(output.ImageId)",
+				RequiredParams: []param{
+					{AwsField: "InstanceId", TemplateName: "instance", AwsType: "awsstr", Description: "id of the instance to create the image from"},
+					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr", Description: "name of the image"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Description", TemplateName: "description", AwsType: "awsstr", Description: "description of the image"},
+					{AwsField: "NoReboot", TemplateName: "noreboot", AwsType: "awsbool", Description: "do not shut down the instance before creating the image"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.Image.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id", Description: "id of the image to deregister (its EBS snapshots are deleted too)"},
+				},
+			},
+			{
+				Action: "copy", Entity: graph.Image.String(), Input: "CopyImageInput", Output: "CopyImageOutput", ApiMethod: "CopyImage", OutputExtractor: "aws.StringValue(output.ImageId)",
+				RequiredParams: []param{
+					{AwsField: "SourceImageId", TemplateName: "id", AwsType: "awsstr", Description: "id of the image to copy"},
+					{AwsField: "SourceRegion", TemplateName: "sourceregion", AwsType: "awsstr", Description: "region of the image to copy (the copy is created in the current region)"},
+					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr", Description: "name of the new image"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Description", TemplateName: "description", AwsType: "awsstr", Description: "description of the new image"},
+					{AwsField: "Encrypted", TemplateName: "encrypted", AwsType: "awsbool", Description: "encrypt the EBS snapshots of the new image"},
 				},
 			},
 			// INTERNET GATEWAYS
@@ -200,71 +298,130 @@ var DriversDefs = []driversDef{
 			{
 				Action: "delete", Entity: graph.InternetGateway.String(), Input: "DeleteInternetGatewayInput", Output: "DeleteInternetGatewayOutput", ApiMethod: "DeleteInternetGateway",
 				RequiredParams: []param{
-					{AwsField: "InternetGatewayId", TemplateName: "id", AwsType: "awsstr"},
+					{AwsField: "InternetGatewayId", TemplateName: "id", AwsType: "awsstr", Description: "id of the internet gateway"},
 				},
 			},
 			{
 				Action: "attach", Entity: graph.InternetGateway.String(), Input: "AttachInternetGatewayInput", Output: "AttachInternetGatewayOutput", ApiMethod: "AttachInternetGateway",
 				RequiredParams: []param{
-					{AwsField: "InternetGatewayId", TemplateName: "id", AwsType: "awsstr"},
-					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr"},
+					{AwsField: "InternetGatewayId", TemplateName: "id", AwsType: "awsstr", Description: "id of the internet gateway"},
+					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr", Description: "id of the VPC"},
 				},
 			},
 			{
 				Action: "detach", Entity: graph.InternetGateway.String(), Input: "DetachInternetGatewayInput", Output: "DetachInternetGatewayOutput", ApiMethod: "DetachInternetGateway",
 				RequiredParams: []param{
-					{AwsField: "InternetGatewayId", TemplateName: "id", AwsType: "awsstr"},
-					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr"},
+					{AwsField: "InternetGatewayId", TemplateName: "id", AwsType: "awsstr", Description: "id of the internet gateway"},
+					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr", Description: "id of the VPC"},
 				},
 			},
 			// ROUTE TABLES
 			{
 				Action: "create", Entity: graph.RouteTable.String(), Input: "CreateRouteTableInput", Output: "CreateRouteTableOutput", ApiMethod: "CreateRouteTable", OutputExtractor: "aws.StringValue(output.RouteTable.RouteTableId)",
 				RequiredParams: []param{
-					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr"}},
+					{AwsField: "VpcId", TemplateName: "vpc", AwsType: "awsstr", Description: "id of the VPC"},
+				},
 			},
 			{
 				Action: "delete", Entity: graph.RouteTable.String(), Input: "DeleteRouteTableInput", Output: "DeleteRouteTableOutput", ApiMethod: "DeleteRouteTable",
 				RequiredParams: []param{
-					{AwsField: "RouteTableId", TemplateName: "id", AwsType: "awsstr"},
+					{AwsField: "RouteTableId", TemplateName: "id", AwsType: "awsstr", Description: "id of the route table"},
 				},
 			},
 			{
 				Action: "attach", Entity: graph.RouteTable.String(), Input: "AssociateRouteTableInput", Output: "AssociateRouteTableOutput", ApiMethod: "AssociateRouteTable", OutputExtractor: "aws.StringValue(output.AssociationId)",
 				RequiredParams: []param{
-					{AwsField: "RouteTableId", TemplateName: "id", AwsType: "awsstr"},
-					{AwsField: "SubnetId", TemplateName: "subnet", AwsType: "awsstr"},
+					{AwsField: "RouteTableId", TemplateName: "id", AwsType: "awsstr", Description: "id of the route table"},
+					{AwsField: "SubnetId", TemplateName: "subnet", AwsType: "awsstr", Description: "id of the subnet"},
 				},
 			},
 			{
 				Action: "detach", Entity: graph.RouteTable.String(), Input: "DisassociateRouteTableInput", Output: "DisassociateRouteTableOutput", ApiMethod: "DisassociateRouteTable",
 				RequiredParams: []param{
-					{AwsField: "AssociationId", TemplateName: "association", AwsType: "awsstr"},
+					{AwsField: "AssociationId", TemplateName: "association", AwsType: "awsstr", Description: "id of the association between the route table and the subnet"},
 				},
 			},
 			// ROUTES
 			{
 				Action: "create", Entity: "route", Input: "CreateRouteInput", Output: "CreateRouteOutput", ApiMethod: "CreateRoute",
 				RequiredParams: []param{
-					{AwsField: "RouteTableId", TemplateName: "table", AwsType: "awsstr"},
-					{AwsField: "DestinationCidrBlock", TemplateName: "cidr", AwsType: "awsstr"},
-					{AwsField: "GatewayId", TemplateName: "gateway", AwsType: "awsstr"},
+					{AwsField: "RouteTableId", TemplateName: "table", AwsType: "awsstr", Description: "id of the route table"},
+					{AwsField: "DestinationCidrBlock", TemplateName: "cidr", AwsType: "awsstr", Type: "cidr", Description: "destination IPv4 network range"},
+				},
+				ExtraParams: []param{
+					{AwsField: "GatewayId", TemplateName: "gateway", AwsType: "awsstr", Description: "id of the internet gateway"},
+					{AwsField: "NatGatewayId", TemplateName: "natgateway", AwsType: "awsstr", Regex: "^nat-", Description: "id of the NAT gateway"},
+					{AwsField: "InstanceId", TemplateName: "instance", AwsType: "awsstr", Regex: "^i-", Description: "id of the NAT instance"},
 				},
 			},
 			{
 				Action: "delete", Entity: "route", Input: "DeleteRouteInput", Output: "DeleteRouteOutput", ApiMethod: "DeleteRoute",
 				RequiredParams: []param{
-					{AwsField: "RouteTableId", TemplateName: "table", AwsType: "awsstr"},
-					{AwsField: "DestinationCidrBlock", TemplateName: "cidr", AwsType: "awsstr"},
+					{AwsField: "RouteTableId", TemplateName: "table", AwsType: "awsstr", Description: "id of the route table"},
+					{AwsField: "DestinationCidrBlock", TemplateName: "cidr", AwsType: "awsstr", Type: "cidr", Description: "destination IPv4 network range"},
+				},
+			},
+			// NAT GATEWAYS
+			{
+				Action: "create", Entity: graph.NatGateway.String(), DryRunUnsupported: true, Input: "CreateNatGatewayInput", Output: "CreateNatGatewayOutput", ApiMethod: "CreateNatGateway", OutputExtractor: "aws.StringValue(output.NatGateway.NatGatewayId)",
+				RequiredParams: []param{
+					{AwsField: "AllocationId", TemplateName: "elasticip", AwsType: "awsstr", Regex: "^eipalloc-", Description: "allocation id of the elastic IP of the NAT gateway"},
+					{AwsField: "SubnetId", TemplateName: "subnet", AwsType: "awsstr", Description: "id of the public subnet of the NAT gateway"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.NatGateway.String(), DryRunUnsupported: true, Input: "DeleteNatGatewayInput", Output: "DeleteNatGatewayOutput", ApiMethod: "DeleteNatGateway",
+				RequiredParams: []param{
+					{AwsField: "NatGatewayId", TemplateName: "id", AwsType: "awsstr", Description: "id of the NAT gateway"},
+				},
+			},
+			{
+				Action: "check", Entity: graph.NatGateway.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id", Description: "id of the NAT gateway"},
+					{TemplateName: "state", AllowedValues: []string{"pending", "failed", "available", "deleting", "deleted"}, Description: "expected state of the NAT gateway"},
+					{TemplateName: "timeout", Type: "awsint", Description: "timeout in seconds"},
+				},
+			},
+			// ELASTIC IPS
+			{
+				Action: "create", Entity: graph.ElasticIP.String(), Input: "AllocateAddressInput", Output: "AllocateAddressOutput", ApiMethod: "AllocateAddress", OutputExtractor: "aws.StringValue(output.AllocationId)",
+				RequiredParams: []param{
+					{AwsField: "Domain", TemplateName: "domain", AwsType: "awsstr", AllowedValues: []string{"vpc", "standard"}, Description: "use of the elastic IP: in a VPC or with EC2-Classic"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.ElasticIP.String(), Input: "ReleaseAddressInput", Output: "ReleaseAddressOutput", ApiMethod: "ReleaseAddress",
+				ExtraParams: []param{
+					{AwsField: "AllocationId", TemplateName: "id", AwsType: "awsstr", Description: "allocation id of the elastic IP (VPC)"},
+					{AwsField: "PublicIp", TemplateName: "ip", AwsType: "awsstr", Description: "elastic IP (EC2-Classic)"},
+				},
+			},
+			{
+				Action: "attach", Entity: graph.ElasticIP.String(), Input: "AssociateAddressInput", Output: "AssociateAddressOutput", ApiMethod: "AssociateAddress", OutputExtractor: "aws.StringValue(output.AssociationId)",
+				RequiredParams: []param{
+					{AwsField: "AllocationId", TemplateName: "id", AwsType: "awsstr", Description: "allocation id of the elastic IP"},
+				},
+				ExtraParams: []param{
+					{AwsField: "InstanceId", TemplateName: "instance", AwsType: "awsstr", Description: "id of the instance"},
+					{AwsField: "NetworkInterfaceId", TemplateName: "networkinterface", AwsType: "awsstr", Description: "id of the network interface"},
+					{AwsField: "PrivateIpAddress", TemplateName: "privateip", AwsType: "awsstr", Description: "private IP of the instance or network interface the elastic IP is associated with"},
+					{AwsField: "AllowReassociation", TemplateName: "allowreassociation", AwsType: "awsbool", Description: "move the elastic IP when it is already associated"},
+				},
+			},
+			{
+				Action: "detach", Entity: graph.ElasticIP.String(), Input: "DisassociateAddressInput", Output: "DisassociateAddressOutput", ApiMethod: "DisassociateAddress",
+				RequiredParams: []param{
+					{AwsField: "AssociationId", TemplateName: "association", AwsType: "awsstr", Description: "id of the association between the elastic IP and the instance"},
 				},
 			},
 			// TAG
 			{
 				Action: "create", Entity: "tag", ManualFuncDefinition: true,
 				RequiredParams: []param{
-					{TemplateName: "resource"},
-					{TemplateName: "key"},
-					{TemplateName: "value"},
+					{TemplateName: "resource", Description: "id of the resource to tag"},
+					{TemplateName: "key", Description: "key of the tag"},
+					{TemplateName: "value", Description: "value of the tag"},
 				},
 			},
 
@@ -272,13 +429,13 @@ var DriversDefs = []driversDef{
 			{
 				Action: "create", Entity: graph.Keypair.String(), ManualFuncDefinition: true,
 				RequiredParams: []param{
-					{TemplateName: "name"},
+					{TemplateName: "name", Description: "name of the keypair"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.Keypair.String(), Input: "DeleteKeyPairInput", Output: "DeleteKeyPairOutput", ApiMethod: "DeleteKeyPair",
 				RequiredParams: []param{
-					{AwsField: "KeyName", TemplateName: "id", AwsType: "awsstr"},
+					{AwsField: "KeyName", TemplateName: "id", AwsType: "awsstr", Description: "name of the keypair"},
 				},
 			},
 		},
@@ -289,19 +446,38 @@ var DriversDefs = []driversDef{
 			{
 				Action: "create", Entity: graph.LoadBalancer.String(), Input: "CreateLoadBalancerInput", Output: "CreateLoadBalancerOutput", ApiMethod: "CreateLoadBalancer", DryRunUnsupported: true, OutputExtractor: "aws.StringValue(output.LoadBalancers[0].LoadBalancerArn)",
 				RequiredParams: []param{
-					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr"},
-					{AwsField: "Subnets", TemplateName: "subnets", AwsType: "awsstringslice"},
+					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr", Regex: "^[a-zA-Z0-9-]{1,32}$", Description: "name of the load balancer"},
+					{AwsField: "Subnets", TemplateName: "subnets", AwsType: "awsstringslice", Description: "ids of the subnets, in at least two availability zones"},
 				},
 				ExtraParams: []param{
-					{AwsField: "IpAddressType", TemplateName: "iptype", AwsType: "awsstr"},
-					{AwsField: "Scheme", TemplateName: "scheme", AwsType: "awsstr"},
-					{AwsField: "SecurityGroups", TemplateName: "groups", AwsType: "awsstringslice"},
+					{AwsField: "IpAddressType", TemplateName: "iptype", AwsType: "awsstr", AllowedValues: []string{"ipv4", "dualstack"}, Description: "type of IP addresses used by the subnets"},
+					{AwsField: "Scheme", TemplateName: "scheme", AwsType: "awsstr", AllowedValues: []string{"internet-facing", "internal"}, Description: "scheme of the load balancer"},
+					{AwsField: "SecurityGroups", TemplateName: "groups", AwsType: "awsstringslice", Description: "ids of the security groups"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.LoadBalancer.String(), Input: "DeleteLoadBalancerInput", Output: "DeleteLoadBalancerOutput", ApiMethod: "DeleteLoadBalancer", DryRunUnsupported: true,
 				RequiredParams: []param{
-					{AwsField: "LoadBalancerArn", TemplateName: "arn", AwsType: "awsstr"},
+					{AwsField: "LoadBalancerArn", TemplateName: "arn", AwsType: "awsstr", Regex: "^arn:aws:elasticloadbalancing:", Description: "ARN of the load balancer"},
+				},
+			},
+		},
+	},
+	{
+		Api: "elb",
+		Drivers: []driver{
+			{
+				Action: "attach", Entity: graph.ClassicLoadBalancer.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "name", Description: "name of the classic load balancer"},
+					{TemplateName: "instances", Description: "ids of the instances to register"},
+				},
+			},
+			{
+				Action: "detach", Entity: graph.ClassicLoadBalancer.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "name", Description: "name of the classic load balancer"},
+					{TemplateName: "instances", Description: "ids of the instances to deregister"},
 				},
 			},
 		},
@@ -313,27 +489,27 @@ var DriversDefs = []driversDef{
 			{
 				Action: "create", Entity: graph.User.String(), DryRunUnsupported: true, Input: "CreateUserInput", Output: "CreateUserOutput", ApiMethod: "CreateUser", OutputExtractor: "aws.StringValue(output.User.UserId)",
 				RequiredParams: []param{
-					{AwsField: "UserName", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "UserName", TemplateName: "name", AwsType: "awsstr", Description: "name of the user"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.User.String(), DryRunUnsupported: true, Input: "DeleteUserInput", Output: "DeleteUserOutput", ApiMethod: "DeleteUser",
 				RequiredParams: []param{
-					{AwsField: "UserName", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "UserName", TemplateName: "name", AwsType: "awsstr", Description: "name of the user"},
 				},
 			},
 			{
 				Action: "attach", Entity: graph.User.String(), DryRunUnsupported: true, Input: "AddUserToGroupInput", Output: "AddUserToGroupOutput", ApiMethod: "AddUserToGroup",
 				RequiredParams: []param{
-					{AwsField: "GroupName", TemplateName: "group", AwsType: "awsstr"},
-					{AwsField: "UserName", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "GroupName", TemplateName: "group", AwsType: "awsstr", Description: "name of the group"},
+					{AwsField: "UserName", TemplateName: "name", AwsType: "awsstr", Description: "name of the user"},
 				},
 			},
 			{
 				Action: "detach", Entity: graph.User.String(), DryRunUnsupported: true, Input: "RemoveUserFromGroupInput", Output: "RemoveUserFromGroupOutput", ApiMethod: "RemoveUserFromGroup",
 				RequiredParams: []param{
-					{AwsField: "GroupName", TemplateName: "group", AwsType: "awsstr"},
-					{AwsField: "UserName", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "GroupName", TemplateName: "group", AwsType: "awsstr", Description: "name of the group"},
+					{AwsField: "UserName", TemplateName: "name", AwsType: "awsstr", Description: "name of the user"},
 				},
 			},
 
@@ -341,35 +517,121 @@ var DriversDefs = []driversDef{
 			{
 				Action: "create", Entity: graph.Group.String(), DryRunUnsupported: true, Input: "CreateGroupInput", Output: "CreateGroupOutput", ApiMethod: "CreateGroup", OutputExtractor: "aws.StringValue(output.Group.GroupId)",
 				RequiredParams: []param{
-					{AwsField: "GroupName", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "GroupName", TemplateName: "name", AwsType: "awsstr", Description: "name of the group"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.Group.String(), DryRunUnsupported: true, Input: "DeleteGroupInput", Output: "DeleteGroupOutput", ApiMethod: "DeleteGroup",
 				RequiredParams: []param{
-					{AwsField: "GroupName", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "GroupName", TemplateName: "name", AwsType: "awsstr", Description: "name of the group"},
+				},
+			},
+
+			// ROLE
+			{
+				Action: "create", Entity: graph.Role.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "name", Description: "name of the role"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "principal", Description: "service (ex: ec2.amazonaws.com), account id or ARN allowed to assume the role"},
+					{TemplateName: "trustpolicy", Description: "local path of the JSON trust policy of the role (instead of a principal)"},
+					{TemplateName: "path", Description: "path of the role (default: /)"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.Role.String(), DryRunUnsupported: true, Input: "DeleteRoleInput", Output: "DeleteRoleOutput", ApiMethod: "DeleteRole",
+				RequiredParams: []param{
+					{AwsField: "RoleName", TemplateName: "name", AwsType: "awsstr", Description: "name of the role"},
+				},
+			},
+			{
+				Action: "attach", Entity: graph.Role.String(), DryRunUnsupported: true, Input: "AddRoleToInstanceProfileInput", Output: "AddRoleToInstanceProfileOutput", ApiMethod: "AddRoleToInstanceProfile",
+				RequiredParams: []param{
+					{AwsField: "RoleName", TemplateName: "name", AwsType: "awsstr", Description: "name of the role"},
+					{AwsField: "InstanceProfileName", TemplateName: "instanceprofile", AwsType: "awsstr", Description: "name of the instance profile"},
+				},
+			},
+			{
+				Action: "detach", Entity: graph.Role.String(), DryRunUnsupported: true, Input: "RemoveRoleFromInstanceProfileInput", Output: "RemoveRoleFromInstanceProfileOutput", ApiMethod: "RemoveRoleFromInstanceProfile",
+				RequiredParams: []param{
+					{AwsField: "RoleName", TemplateName: "name", AwsType: "awsstr", Description: "name of the role"},
+					{AwsField: "InstanceProfileName", TemplateName: "instanceprofile", AwsType: "awsstr", Description: "name of the instance profile"},
+				},
+			},
+
+			// INSTANCE PROFILE
+			{
+				Action: "create", Entity: graph.InstanceProfile.String(), DryRunUnsupported: true, Input: "CreateInstanceProfileInput", Output: "CreateInstanceProfileOutput", ApiMethod: "CreateInstanceProfile", OutputExtractor: "aws.StringValue(output.InstanceProfile.InstanceProfileName)",
+				RequiredParams: []param{
+					{AwsField: "InstanceProfileName", TemplateName: "name", AwsType: "awsstr", Description: "name of the instance profile"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Path", TemplateName: "path", AwsType: "awsstr", Description: "path of the instance profile (default: /)"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.InstanceProfile.String(), DryRunUnsupported: true, Input: "DeleteInstanceProfileInput", Output: "DeleteInstanceProfileOutput", ApiMethod: "DeleteInstanceProfile",
+				RequiredParams: []param{
+					{AwsField: "InstanceProfileName", TemplateName: "name", AwsType: "awsstr", Description: "name of the instance profile"},
+				},
+			},
+
+			// ACCESS KEY
+			{
+				Action: "create", Entity: graph.AccessKey.String(), ManualFuncDefinition: true,
+				ExtraParams: []param{
+					{TemplateName: "user", Description: "name of the user owning the key (default: current user)"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.AccessKey.String(), DryRunUnsupported: true, Input: "DeleteAccessKeyInput", Output: "DeleteAccessKeyOutput", ApiMethod: "DeleteAccessKey",
+				RequiredParams: []param{
+					{AwsField: "AccessKeyId", TemplateName: "id", AwsType: "awsstr", Description: "id of the access key"},
+				},
+				ExtraParams: []param{
+					{AwsField: "UserName", TemplateName: "user", AwsType: "awsstr", Description: "name of the user owning the key (default: current user)"},
 				},
 			},
 
 			// POLICY
+			{
+				Action: "create", Entity: graph.Policy.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "name", Description: "name of the policy"},
+					{TemplateName: "document", Description: "local path of the JSON document of the policy"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "description", Description: "description of the policy"},
+					{TemplateName: "path", Description: "path of the policy (default: /)"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.Policy.String(), DryRunUnsupported: true, Input: "DeletePolicyInput", Output: "DeletePolicyOutput", ApiMethod: "DeletePolicy",
+				RequiredParams: []param{
+					{AwsField: "PolicyArn", TemplateName: "arn", AwsType: "awsstr", Regex: "^arn:aws:iam::", Description: "ARN of the policy"},
+				},
+			},
 			{
 				Action: "attach", Entity: graph.Policy.String(), ManualFuncDefinition: true,
 				RequiredParams: []param{
-					{TemplateName: "arn"},
+					{TemplateName: "arn", Regex: "^arn:aws:iam::", Description: "ARN of the policy"},
 				},
 				ExtraParams: []param{
-					{TemplateName: "user"},
-					{TemplateName: "group"},
+					{TemplateName: "user", Description: "name of the user"},
+					{TemplateName: "group", Description: "name of the group"},
+					{TemplateName: "role", Description: "name of the role"},
 				},
 			},
 			{
 				Action: "detach", Entity: graph.Policy.String(), ManualFuncDefinition: true,
 				RequiredParams: []param{
-					{TemplateName: "arn"},
+					{TemplateName: "arn", Regex: "^arn:aws:iam::", Description: "ARN of the policy"},
 				},
 				ExtraParams: []param{
-					{TemplateName: "user"},
-					{TemplateName: "group"},
+					{TemplateName: "user", Description: "name of the user"},
+					{TemplateName: "group", Description: "name of the group"},
+					{TemplateName: "role", Description: "name of the role"},
 				},
 			},
 		},
@@ -381,13 +643,28 @@ var DriversDefs = []driversDef{
 			{
 				Action: "create", Entity: graph.Bucket.String(), DryRunUnsupported: true, Input: "CreateBucketInput", Output: "CreateBucketOutput", ApiMethod: "CreateBucket", OutputExtractor: "params[\"name\"]",
 				RequiredParams: []param{
-					{AwsField: "Bucket", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "Bucket", TemplateName: "name", AwsType: "awsstr", Regex: "^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$", Description: "name of the bucket"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.Bucket.String(), DryRunUnsupported: true, Input: "DeleteBucketInput", Output: "DeleteBucketOutput", ApiMethod: "DeleteBucket",
 				RequiredParams: []param{
-					{AwsField: "Bucket", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "Bucket", TemplateName: "name", AwsType: "awsstr", Description: "name of the bucket"},
+				},
+			},
+			{
+				Action: "update", Entity: graph.Bucket.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "name", Description: "name of the bucket"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "acl", AllowedValues: []string{"private", "public-read", "public-read-write", "authenticated-read"}, Description: "canned ACL of the bucket (private removes public access)"},
+					{TemplateName: "versioning", AllowedValues: []string{"enabled", "suspended"}, Description: "versioning of the objects of the bucket"},
+					{TemplateName: "encryption", AllowedValues: []string{"aes256", "kms", "none"}, Description: "default server side encryption of the objects of the bucket ('none' deletes it)"},
+					{TemplateName: "encryptionkey", Description: "KMS key (id or ARN) of the default encryption (with encryption=kms, default to the AWS managed key)"},
+					{TemplateName: "publicaccessblock", AllowedValues: []string{"true", "false"}, Description: "block all public access to the bucket (false deletes the public access block)"},
+					{TemplateName: "policy", Description: "local path of the JSON policy of the bucket ('none' deletes the policy)"},
+					{TemplateName: "lifecycle", Description: "local path of the JSON lifecycle configuration of the bucket, holding its Rules ('none' deletes it)"},
 				},
 			},
 
@@ -395,18 +672,18 @@ var DriversDefs = []driversDef{
 			{
 				Action: "create", Entity: graph.Object.String(), ManualFuncDefinition: true,
 				RequiredParams: []param{
-					{AwsField: "Bucket", TemplateName: "bucket", AwsType: "awsstr"},
-					{AwsField: "Body", TemplateName: "file", AwsType: "awsstr"},
+					{AwsField: "Bucket", TemplateName: "bucket", AwsType: "awsstr", Description: "name of the bucket"},
+					{AwsField: "Body", TemplateName: "file", AwsType: "awsstr", Description: "path of the file to upload"},
 				},
 				ExtraParams: []param{
-					{AwsField: "Key", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "Key", TemplateName: "name", AwsType: "awsstr", Description: "key of the object (default to the file name)"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.Object.String(), DryRunUnsupported: true, Input: "DeleteObjectInput", Output: "DeleteObjectOutput", ApiMethod: "DeleteObject",
 				RequiredParams: []param{
-					{AwsField: "Bucket", TemplateName: "bucket", AwsType: "awsstr"},
-					{AwsField: "Key", TemplateName: "key", AwsType: "awsstr"},
+					{AwsField: "Bucket", TemplateName: "bucket", AwsType: "awsstr", Description: "name of the bucket"},
+					{AwsField: "Key", TemplateName: "key", AwsType: "awsstr", Description: "key of the object"},
 				},
 			},
 		},
@@ -418,28 +695,38 @@ var DriversDefs = []driversDef{
 			{
 				Action: "create", Entity: graph.Topic.String(), DryRunUnsupported: true, Input: "CreateTopicInput", Output: "CreateTopicOutput", ApiMethod: "CreateTopic", OutputExtractor: "aws.StringValue(output.TopicArn)",
 				RequiredParams: []param{
-					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "Name", TemplateName: "name", AwsType: "awsstr", Description: "name of the topic"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.Topic.String(), DryRunUnsupported: true, Input: "DeleteTopicInput", Output: "DeleteTopicOutput", ApiMethod: "DeleteTopic",
 				RequiredParams: []param{
-					{AwsField: "TopicArn", TemplateName: "arn", AwsType: "awsstr"},
+					{AwsField: "TopicArn", TemplateName: "arn", AwsType: "awsstr", Regex: "^arn:aws:sns:", Description: "ARN of the topic"},
+				},
+			},
+			{
+				Action: "publish", Entity: graph.Topic.String(), DryRunUnsupported: true, Input: "PublishInput", Output: "PublishOutput", ApiMethod: "Publish", OutputExtractor: "aws.StringValue(output.MessageId)",
+				RequiredParams: []param{
+					{AwsField: "TopicArn", TemplateName: "arn", AwsType: "awsstr", Regex: "^arn:aws:sns:", Description: "ARN of the topic"},
+					{AwsField: "Message", TemplateName: "message", AwsType: "awsstr", Description: "message to publish (between double quotes when it contains spaces)"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Subject", TemplateName: "subject", AwsType: "awsstr", Description: "subject of the message (used by email endpoints)"},
 				},
 			},
 			//Subscription
 			{
 				Action: "create", Entity: graph.Subscription.String(), DryRunUnsupported: true, Input: "SubscribeInput", Output: "SubscribeOutput", ApiMethod: "Subscribe", OutputExtractor: "aws.StringValue(output.SubscriptionArn)",
 				RequiredParams: []param{
-					{AwsField: "TopicArn", TemplateName: "topic", AwsType: "awsstr"},
-					{AwsField: "Endpoint", TemplateName: "endpoint", AwsType: "awsstr"},
-					{AwsField: "Protocol", TemplateName: "protocol", AwsType: "awsstr"},
+					{AwsField: "TopicArn", TemplateName: "topic", AwsType: "awsstr", Regex: "^arn:aws:sns:", Description: "ARN of the topic"},
+					{AwsField: "Endpoint", TemplateName: "endpoint", AwsType: "awsstr", Description: "endpoint receiving the notifications (url, email, ARN, ...)"},
+					{AwsField: "Protocol", TemplateName: "protocol", AwsType: "awsstr", AllowedValues: []string{"http", "https", "email", "email-json", "sms", "sqs", "application", "lambda"}, Description: "protocol of the endpoint"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.Subscription.String(), DryRunUnsupported: true, Input: "UnsubscribeInput", Output: "UnsubscribeOutput", ApiMethod: "Unsubscribe",
 				RequiredParams: []param{
-					{AwsField: "SubscriptionArn", TemplateName: "arn", AwsType: "awsstr"},
+					{AwsField: "SubscriptionArn", TemplateName: "arn", AwsType: "awsstr", Regex: "^arn:aws:sns:", Description: "ARN of the subscription"},
 				},
 			},
 		},
@@ -451,24 +738,559 @@ var DriversDefs = []driversDef{
 			{
 				Action: "create", Entity: graph.Queue.String(), DryRunUnsupported: true, Input: "CreateQueueInput", Output: "CreateQueueOutput", ApiMethod: "CreateQueue", OutputExtractor: "aws.StringValue(output.QueueUrl)",
 				RequiredParams: []param{
-					{AwsField: "QueueName", TemplateName: "name", AwsType: "awsstr"},
+					{AwsField: "QueueName", TemplateName: "name", AwsType: "awsstr", Description: "name of the queue"},
 				},
 				ExtraParams: []param{
-					{AwsField: "Attributes[DelaySeconds]", TemplateName: "delay", AwsType: "awsstringpointermap"},
-					{AwsField: "Attributes[MaximumMessageSize]", TemplateName: "maxMsgSize", AwsType: "awsstringpointermap"},
-					{AwsField: "Attributes[MessageRetentionPeriod]", TemplateName: "retentionPeriod", AwsType: "awsstringpointermap"},
-					{AwsField: "Attributes[Policy]", TemplateName: "policy", AwsType: "awsstringpointermap"},
-					{AwsField: "Attributes[ReceiveMessageWaitTimeSeconds]", TemplateName: "msgWait", AwsType: "awsstringpointermap"},
-					{AwsField: "Attributes[RedrivePolicy]", TemplateName: "redrivePolicy", AwsType: "awsstringpointermap"},
-					{AwsField: "Attributes[VisibilityTimeout]", TemplateName: "visibilityTimeout", AwsType: "awsstringpointermap"},
+					{AwsField: "Attributes[DelaySeconds]", TemplateName: "delay", AwsType: "awsstringpointermap", Type: "awsint", Description: "delivery delay of the messages in seconds"},
+					{AwsField: "Attributes[MaximumMessageSize]", TemplateName: "maxMsgSize", AwsType: "awsstringpointermap", Type: "awsint", Description: "maximum message size in bytes"},
+					{AwsField: "Attributes[MessageRetentionPeriod]", TemplateName: "retentionPeriod", AwsType: "awsstringpointermap", Type: "awsint", Description: "retention period of the messages in seconds"},
+					{AwsField: "Attributes[Policy]", TemplateName: "policy", AwsType: "awsstringpointermap", Description: "access policy of the queue (JSON)"},
+					{AwsField: "Attributes[ReceiveMessageWaitTimeSeconds]", TemplateName: "msgWait", AwsType: "awsstringpointermap", Type: "awsint", Description: "long polling wait time of receive calls in seconds"},
+					{AwsField: "Attributes[RedrivePolicy]", TemplateName: "redrivePolicy", AwsType: "awsstringpointermap", Description: "dead letter queue policy (JSON)"},
+					{AwsField: "Attributes[VisibilityTimeout]", TemplateName: "visibilityTimeout", AwsType: "awsstringpointermap", Type: "awsint", Description: "visibility timeout of the messages in seconds"},
 				},
 			},
 			{
 				Action: "delete", Entity: graph.Queue.String(), DryRunUnsupported: true, Input: "DeleteQueueInput", Output: "DeleteQueueOutput", ApiMethod: "DeleteQueue",
 				RequiredParams: []param{
-					{AwsField: "QueueUrl", TemplateName: "url", AwsType: "awsstr"},
+					{AwsField: "QueueUrl", TemplateName: "url", AwsType: "awsstr", Description: "url of the queue"},
+				},
+			},
+			{
+				Action: "update", Entity: graph.Queue.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "url", Description: "url of the queue"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "delay", Type: "awsint", Description: "delivery delay of the messages in seconds"},
+					{TemplateName: "maxMsgSize", Type: "awsint", Description: "maximum message size in bytes"},
+					{TemplateName: "retentionPeriod", Type: "awsint", Description: "retention period of the messages in seconds"},
+					{TemplateName: "msgWait", Type: "awsint", Description: "long polling wait time of receive calls in seconds"},
+					{TemplateName: "visibilityTimeout", Type: "awsint", Description: "visibility timeout of the messages in seconds"},
+					{TemplateName: "deadletterqueue", Description: "ARN of the dead letter queue receiving the messages received too many times ('none' to remove it)"},
+					{TemplateName: "maxreceive", Type: "awsint", Description: "number of receives before a message goes to the dead letter queue (default: 5)"},
+				},
+			},
+			{
+				Action: "send", Entity: graph.Queue.String(), DryRunUnsupported: true, Input: "SendMessageInput", Output: "SendMessageOutput", ApiMethod: "SendMessage", OutputExtractor: "aws.StringValue(output.MessageId)",
+				RequiredParams: []param{
+					{AwsField: "QueueUrl", TemplateName: "url", AwsType: "awsstr", Description: "url of the queue"},
+					{AwsField: "MessageBody", TemplateName: "message", AwsType: "awsstr", Description: "body of the message (between double quotes when it contains spaces)"},
+				},
+				ExtraParams: []param{
+					{AwsField: "DelaySeconds", TemplateName: "delay", AwsType: "awsint64", Description: "delivery delay of the message in seconds"},
+				},
+			},
+			{
+				Action: "receive", Entity: graph.Queue.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "url", Description: "url of the queue"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "max", Type: "awsint", Description: "maximum number of messages to receive, from 1 to 10 (default: 1)"},
+					{TemplateName: "wait", Type: "awsint", Description: "seconds to wait for messages to arrive (long polling)"},
+					{TemplateName: "visibility", Type: "awsint", Description: "seconds the received messages are hidden from other receivers"},
+					{TemplateName: "delete", Type: "awsbool", Description: "delete the messages once received"},
+				},
+			},
+			{
+				Action: "purge", Entity: graph.Queue.String(), DryRunUnsupported: true, Input: "PurgeQueueInput", Output: "PurgeQueueOutput", ApiMethod: "PurgeQueue",
+				RequiredParams: []param{
+					{AwsField: "QueueUrl", TemplateName: "url", AwsType: "awsstr", Description: "url of the queue"},
+				},
+			},
+		},
+	},
+	{
+		Api: "rds",
+		Drivers: []driver{
+			// DATABASE
+			{
+				Action: "create", Entity: graph.Database.String(), DryRunUnsupported: true, Input: "CreateDBInstanceInput", Output: "CreateDBInstanceOutput", ApiMethod: "CreateDBInstance", OutputExtractor: "aws.StringValue(output.DBInstance.DBInstanceIdentifier)",
+				RequiredParams: []param{
+					{AwsField: "DBInstanceClass", TemplateName: "type", AwsType: "awsstr", Regex: "^db\\.", Description: "instance class of the database (e.g. db.t2.micro)"},
+					{AwsField: "DBInstanceIdentifier", TemplateName: "id", AwsType: "awsstr", Regex: "^[a-zA-Z][a-zA-Z0-9-]{0,62}$", Description: "identifier of the database"},
+					{AwsField: "Engine", TemplateName: "engine", AwsType: "awsstr", AllowedValues: []string{"mysql", "mariadb", "postgres", "aurora", "oracle-se1", "oracle-se2", "oracle-se", "oracle-ee", "sqlserver-ee", "sqlserver-se", "sqlserver-ex", "sqlserver-web"}, Description: "database engine"},
+					{AwsField: "MasterUserPassword", TemplateName: "password", AwsType: "awsstr", Description: "password of the master user"},
+					{AwsField: "MasterUsername", TemplateName: "username", AwsType: "awsstr", Description: "name of the master user"},
+					{AwsField: "AllocatedStorage", TemplateName: "size", AwsType: "awsint64", Description: "storage to allocate in GiB"},
+				},
+				ExtraParams: []param{
+					{AwsField: "AutoMinorVersionUpgrade", TemplateName: "autoupgrade", AwsType: "awsbool", Description: "apply minor engine upgrades automatically"},
+					{AwsField: "AvailabilityZone", TemplateName: "zone", AwsType: "awsstr", Description: "availability zone of the database"},
+					{AwsField: "BackupRetentionPeriod", TemplateName: "backupretention", AwsType: "awsint64", Description: "number of days automated backups are kept"},
+					{AwsField: "PreferredBackupWindow", TemplateName: "backupwindow", AwsType: "awsstr", Description: "daily time range of automated backups (hh24:mi-hh24:mi)"},
+					{AwsField: "DBName", TemplateName: "dbname", AwsType: "awsstr", Description: "name of the database created with the instance"},
+					{AwsField: "EngineVersion", TemplateName: "version", AwsType: "awsstr", Description: "version of the database engine"},
+					{AwsField: "Iops", TemplateName: "iops", AwsType: "awsint64", Description: "provisioned IOPS of the storage"},
+					{AwsField: "LicenseModel", TemplateName: "license", AwsType: "awsstr", AllowedValues: []string{"license-included", "bring-your-own-license", "general-public-license"}, Description: "license model of the database"},
+					{AwsField: "PreferredMaintenanceWindow", TemplateName: "maintenancewindow", AwsType: "awsstr", Description: "weekly time range of maintenance (ddd:hh24:mi-ddd:hh24:mi)"},
+					{AwsField: "MultiAZ", TemplateName: "multiaz", AwsType: "awsbool", Description: "deploy a standby replica in another availability zone"},
+					{AwsField: "OptionGroupName", TemplateName: "optiongroup", AwsType: "awsstr", Description: "name of the option group"},
+					{AwsField: "DBParameterGroupName", TemplateName: "parametergroup", AwsType: "awsstr", Description: "name of the parameter group"},
+					{AwsField: "Port", TemplateName: "port", AwsType: "awsint64", Description: "port the database listens on"},
+					{AwsField: "PubliclyAccessible", TemplateName: "public", AwsType: "awsbool", Description: "give the database a public address"},
+					{AwsField: "StorageEncrypted", TemplateName: "encrypted", AwsType: "awsbool", Description: "encrypt the storage"},
+					{AwsField: "StorageType", TemplateName: "storagetype", AwsType: "awsstr", AllowedValues: []string{"standard", "gp2", "io1"}, Description: "type of the storage"},
+					{AwsField: "DBSubnetGroupName", TemplateName: "subnetgroup", AwsType: "awsstr", Description: "name of the subnet group"},
+					{AwsField: "VpcSecurityGroupIds", TemplateName: "groups", AwsType: "awsstringslice", Regex: "^sg-", Description: "ids of the security groups"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.Database.String(), DryRunUnsupported: true, Input: "DeleteDBInstanceInput", Output: "DeleteDBInstanceOutput", ApiMethod: "DeleteDBInstance",
+				RequiredParams: []param{
+					{AwsField: "DBInstanceIdentifier", TemplateName: "id", AwsType: "awsstr", Description: "identifier of the database"},
+				},
+				ExtraParams: []param{
+					{AwsField: "SkipFinalSnapshot", TemplateName: "skipsnapshot", AwsType: "awsbool", Description: "delete without taking a final snapshot"},
+					{AwsField: "FinalDBSnapshotIdentifier", TemplateName: "snapshot", AwsType: "awsstr", Description: "identifier of the final snapshot"},
+				},
+			},
+			{
+				Action: "start", Entity: graph.Database.String(), DryRunUnsupported: true, Input: "StartDBInstanceInput", Output: "StartDBInstanceOutput", ApiMethod: "StartDBInstance", OutputExtractor: "aws.StringValue(output.DBInstance.DBInstanceIdentifier)",
+				RequiredParams: []param{
+					{AwsField: "DBInstanceIdentifier", TemplateName: "id", AwsType: "awsstr", Description: "identifier of the database"},
+				},
+			},
+			{
+				Action: "stop", Entity: graph.Database.String(), DryRunUnsupported: true, Input: "StopDBInstanceInput", Output: "StopDBInstanceOutput", ApiMethod: "StopDBInstance", OutputExtractor: "aws.StringValue(output.DBInstance.DBInstanceIdentifier)",
+				RequiredParams: []param{
+					{AwsField: "DBInstanceIdentifier", TemplateName: "id", AwsType: "awsstr", Description: "identifier of the database"},
+				},
+				ExtraParams: []param{
+					{AwsField: "DBSnapshotIdentifier", TemplateName: "snapshot", AwsType: "awsstr", Description: "identifier of the snapshot taken before stopping the database"},
+				},
+			},
+		},
+	},
+	{
+		Api: "lambda",
+		Drivers: []driver{
+			// FUNCTION
+			{
+				Action: "create", Entity: graph.Function.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "name", Description: "name of the function"},
+					{TemplateName: "handler", Description: "entrypoint of the function in its code (e.g. index.handler)"},
+					{TemplateName: "role", Regex: "^arn:aws:iam::", Description: "ARN of the execution role of the function"},
+					{TemplateName: "runtime", Description: "runtime of the function (e.g. nodejs4.3, python2.7, java8)"},
+					{TemplateName: "zipfile", Description: "local path of the zip file or directory of the function code"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "description", Description: "description of the function"},
+					{TemplateName: "memory", Type: "awsint", Description: "memory of the function in MB"},
+					{TemplateName: "timeout", Type: "awsint", Description: "execution timeout of the function in seconds"},
+					{TemplateName: "publish", Type: "awsbool", Description: "publish a version of the function"},
+					{TemplateName: "subnets", Regex: "^subnet-", Description: "ids of the subnets the function runs in"},
+					{TemplateName: "groups", Regex: "^sg-", Description: "ids of the security groups of the function"},
+				},
+			},
+			{
+				Action: "update", Entity: graph.Function.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id", Description: "name or ARN of the function"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "zipfile", Description: "local path of the zip file or directory of the new function code"},
+					{TemplateName: "publish", Type: "awsbool", Description: "publish a version of the function"},
+					{TemplateName: "handler", Description: "entrypoint of the function in its code (e.g. index.handler)"},
+					{TemplateName: "role", Regex: "^arn:aws:iam::", Description: "ARN of the execution role of the function"},
+					{TemplateName: "runtime", Description: "runtime of the function (e.g. nodejs4.3, python2.7, java8)"},
+					{TemplateName: "description", Description: "description of the function"},
+					{TemplateName: "memory", Type: "awsint", Description: "memory of the function in MB"},
+					{TemplateName: "timeout", Type: "awsint", Description: "execution timeout of the function in seconds"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.Function.String(), DryRunUnsupported: true, Input: "DeleteFunctionInput", Output: "DeleteFunctionOutput", ApiMethod: "DeleteFunction",
+				RequiredParams: []param{
+					{AwsField: "FunctionName", TemplateName: "id", AwsType: "awsstr", Description: "name or ARN of the function"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Qualifier", TemplateName: "version", AwsType: "awsstr", Description: "version of the function to delete (all versions when empty)"},
+				},
+			},
+			// EVENT SOURCE MAPPING
+			{
+				Action: "create", Entity: "eventsource", ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "function", Description: "name or ARN of the function"},
+					{TemplateName: "queue", Description: "url or ARN of the SQS queue triggering the function"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "batchsize", Type: "awsint", Description: "maximum number of messages sent to the function at once"},
+					{TemplateName: "enabled", Type: "awsbool", Description: "enable the mapping (default true)"},
+				},
+			},
+			{
+				Action: "delete", Entity: "eventsource", DryRunUnsupported: true, Input: "DeleteEventSourceMappingInput", Output: "EventSourceMappingConfiguration", ApiMethod: "DeleteEventSourceMapping",
+				RequiredParams: []param{
+					{AwsField: "UUID", TemplateName: "id", AwsType: "awsstr", Description: "UUID of the event source mapping"},
 				},
 			},
 		},
 	},
+	{
+		Api: "route53",
+		Drivers: []driver{
+			// RECORD
+			{
+				Action: "create", Entity: graph.Record.String(), ManualFuncDefinition: true,
+				RequiredParams: recordParams,
+				ExtraParams: []param{
+					{TemplateName: "comment", Description: "comment of the change"},
+				},
+			},
+			{
+				Action: "update", Entity: graph.Record.String(), ManualFuncDefinition: true,
+				RequiredParams: recordParams,
+				ExtraParams: []param{
+					{TemplateName: "comment", Description: "comment of the change"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.Record.String(), ManualFuncDefinition: true,
+				RequiredParams: recordParams,
+				ExtraParams: []param{
+					{TemplateName: "comment", Description: "comment of the change"},
+				},
+			},
+			{
+				Action: "check", Entity: graph.Record.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id", Description: "id of the change returned when creating, updating or deleting a record"},
+					{TemplateName: "timeout", Type: "awsint", Description: "timeout in seconds"},
+				},
+			},
+		},
+	},
+	{
+		Api: "autoscaling",
+		Drivers: []driver{
+			// LAUNCH CONFIGURATION
+			{
+				Action: "create", Entity: graph.LaunchConfiguration.String(), DryRunUnsupported: true, Input: "CreateLaunchConfigurationInput", Output: "CreateLaunchConfigurationOutput", ApiMethod: "CreateLaunchConfiguration", OutputExtractor: "params[\"name\"]",
+				RequiredParams: []param{
+					{AwsField: "ImageId", TemplateName: "image", AwsType: "awsstr", Description: "id of the AMI of the instances"},
+					{AwsField: "InstanceType", TemplateName: "type", AwsType: "awsstr", Description: "type of the instances (e.g. t2.micro)"},
+					{AwsField: "LaunchConfigurationName", TemplateName: "name", AwsType: "awsstr", Description: "name of the launch configuration"},
+				},
+				ExtraParams: []param{
+					{AwsField: "AssociatePublicIpAddress", TemplateName: "public", AwsType: "awsbool", Description: "assign a public IP to the instances"},
+					{AwsField: "KeyName", TemplateName: "keypair", AwsType: "awsstr", Description: "name of the keypair of the instances"},
+					{AwsField: "SecurityGroups", TemplateName: "groups", AwsType: "awsstringslice", Regex: "^sg-", Description: "ids of the security groups of the instances"},
+					{AwsField: "IamInstanceProfile", TemplateName: "role", AwsType: "awsstr", Description: "name or ARN of the instance profile of the instances"},
+					{AwsField: "SpotPrice", TemplateName: "spotprice", AwsType: "awsstr", Description: "maximum hourly price for spot instances"},
+					{AwsField: "InstanceMonitoring.Enabled", TemplateName: "monitoring", AwsType: "awsbool", Description: "enable detailed monitoring of the instances"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.LaunchConfiguration.String(), DryRunUnsupported: true, Input: "DeleteLaunchConfigurationInput", Output: "DeleteLaunchConfigurationOutput", ApiMethod: "DeleteLaunchConfiguration",
+				RequiredParams: []param{
+					{AwsField: "LaunchConfigurationName", TemplateName: "id", AwsType: "awsstr", Description: "name of the launch configuration"},
+				},
+			},
+			// SCALING GROUP
+			{
+				Action: "create", Entity: graph.ScalingGroup.String(), DryRunUnsupported: true, Input: "CreateAutoScalingGroupInput", Output: "CreateAutoScalingGroupOutput", ApiMethod: "CreateAutoScalingGroup", OutputExtractor: "params[\"name\"]",
+				RequiredParams: []param{
+					{AwsField: "AutoScalingGroupName", TemplateName: "name", AwsType: "awsstr", Description: "name of the scaling group"},
+					{AwsField: "LaunchConfigurationName", TemplateName: "launchconfiguration", AwsType: "awsstr", Description: "name of the launch configuration of the instances"},
+					{AwsField: "MaxSize", TemplateName: "maxsize", AwsType: "awsint64", Description: "maximum number of instances"},
+					{AwsField: "MinSize", TemplateName: "minsize", AwsType: "awsint64", Description: "minimum number of instances"},
+					{AwsField: "VPCZoneIdentifier", TemplateName: "subnets", AwsType: "awscsvstr", Regex: "^subnet-", Description: "ids of the subnets the instances are launched in"},
+				},
+				ExtraParams: []param{
+					{AwsField: "DefaultCooldown", TemplateName: "cooldown", AwsType: "awsint64", Description: "seconds between two scaling activities"},
+					{AwsField: "DesiredCapacity", TemplateName: "desiredcapacity", AwsType: "awsint64", Description: "number of instances to run (between minsize and maxsize)"},
+					{AwsField: "HealthCheckGracePeriod", TemplateName: "healthcheckgraceperiod", AwsType: "awsint64", Description: "seconds before checking the health of a new instance"},
+					{AwsField: "HealthCheckType", TemplateName: "healthchecktype", AwsType: "awsstr", AllowedValues: []string{"EC2", "ELB"}, Description: "type of health check of the instances"},
+					{AwsField: "NewInstancesProtectedFromScaleIn", TemplateName: "newinstancesprotected", AwsType: "awsbool", Description: "protect new instances from scale in"},
+					{AwsField: "TargetGroupARNs", TemplateName: "targetgroups", AwsType: "awsstringslice", Description: "ARNs of the target groups the instances are registered in"},
+				},
+			},
+			{
+				Action: "update", Entity: graph.ScalingGroup.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "name", Description: "name of the scaling group"},
+				},
+				ExtraParams: scalingGroupUpdatableParams,
+			},
+			{
+				Action: "delete", Entity: graph.ScalingGroup.String(), DryRunUnsupported: true, Input: "DeleteAutoScalingGroupInput", Output: "DeleteAutoScalingGroupOutput", ApiMethod: "DeleteAutoScalingGroup",
+				RequiredParams: []param{
+					{AwsField: "AutoScalingGroupName", TemplateName: "id", AwsType: "awsstr", Description: "name of the scaling group"},
+				},
+				ExtraParams: []param{
+					{AwsField: "ForceDelete", TemplateName: "force", AwsType: "awsbool", Description: "delete the group and terminate its instances without waiting for them"},
+				},
+			},
+			{
+				Action: "check", Entity: graph.ScalingGroup.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id", Description: "name of the scaling group"},
+					{TemplateName: "count", Type: "awsint", Description: "expected number of instances in service (0 also waits for a deleted group to be gone)"},
+					{TemplateName: "timeout", Type: "awsint", Description: "timeout in seconds"},
+				},
+			},
+		},
+	},
+	{
+		Api: "cloudwatch",
+		Drivers: []driver{
+			// ALARM
+			{
+				Action: "create", Entity: graph.Alarm.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "name", Description: "name of the alarm"},
+					{TemplateName: "operator", AllowedValues: []string{"GreaterThanOrEqualToThreshold", "GreaterThanThreshold", "LessThanThreshold", "LessThanOrEqualToThreshold"}, Description: "comparison of the statistic with the threshold"},
+					{TemplateName: "metric", Description: "name of the metric (e.g. CPUUtilization)"},
+					{TemplateName: "namespace", Description: "namespace of the metric (e.g. AWS/EC2)"},
+					{TemplateName: "evaluationperiods", Type: "awsint", Description: "number of periods over which the statistic is compared to the threshold"},
+					{TemplateName: "period", Type: "awsint", Description: "length in seconds of a period (multiple of 60)"},
+					{TemplateName: "statistic", AllowedValues: []string{"SampleCount", "Average", "Sum", "Minimum", "Maximum"}, Description: "statistic applied to the metric"},
+					{TemplateName: "threshold", Regex: `^-?[0-9]+(\.[0-9]+)?$`, Description: "value compared to the statistic"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "enabled", Type: "awsbool", Description: "execute the actions of the alarm when its state changes"},
+					{TemplateName: "alarmactions", Description: "ARNs of the actions (e.g. SNS topics) executed when entering the ALARM state"},
+					{TemplateName: "insufficientdataactions", Description: "ARNs of the actions executed when entering the INSUFFICIENT_DATA state"},
+					{TemplateName: "okactions", Description: "ARNs of the actions executed when entering the OK state"},
+					{TemplateName: "description", Description: "description of the alarm"},
+					{TemplateName: "dimensions", Description: "dimensions of the metric as Name:Value (e.g. InstanceId:i-12345678)"},
+					{TemplateName: "unit", Description: "unit of the metric (e.g. Percent)"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.Alarm.String(), DryRunUnsupported: true, Input: "DeleteAlarmsInput", Output: "DeleteAlarmsOutput", ApiMethod: "DeleteAlarms",
+				RequiredParams: []param{
+					{AwsField: "AlarmNames", TemplateName: "id", AwsType: "awsstringslice", Description: "name of the alarm"},
+				},
+			},
+		},
+	},
+	{
+		Api: "cloudformation",
+		Drivers: []driver{
+			// STACK
+			{
+				Action: "create", Entity: graph.Stack.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "name", Description: "name of the stack"},
+					{TemplateName: "templatefile", Description: "path to the local JSON or YAML CloudFormation template"},
+				},
+				ExtraParams: append(stackUpdatableParams,
+					param{TemplateName: "disablerollback", Type: "awsbool", Description: "keep the resources of a stack whose creation failed"},
+					param{TemplateName: "timeout", Type: "awsint", Description: "minutes before a stack not yet created fails"},
+				),
+			},
+			{
+				Action: "update", Entity: graph.Stack.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id", Description: "name or id of the stack"},
+				},
+				ExtraParams: append([]param{
+					{TemplateName: "templatefile", Description: "path to the local JSON or YAML CloudFormation template (default to the current template)"},
+				}, stackUpdatableParams...),
+			},
+			{
+				Action: "delete", Entity: graph.Stack.String(), DryRunUnsupported: true, Input: "DeleteStackInput", Output: "DeleteStackOutput", ApiMethod: "DeleteStack",
+				RequiredParams: []param{
+					{AwsField: "StackName", TemplateName: "id", AwsType: "awsstr", Description: "name or id of the stack"},
+				},
+				ExtraParams: []param{
+					{AwsField: "RetainResources", TemplateName: "retain", AwsType: "awsstringslice", Description: "logical ids of the resources to keep (stacks in DELETE_FAILED state only)"},
+				},
+			},
+			{
+				Action: "check", Entity: graph.Stack.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id", Description: "name or id of the stack"},
+					{TemplateName: "state", AllowedValues: stackTerminalStates, Description: "expected state, failing when the stack reaches another terminal state"},
+					{TemplateName: "timeout", Type: "awsint", Description: "timeout in seconds"},
+				},
+			},
+		},
+	},
+	{
+		Api: "ecs",
+		Drivers: []driver{
+			// SERVICE
+			{
+				Action: "create", Entity: graph.ContainerService.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "cluster", Description: "name or ARN of the cluster running the service"},
+					{TemplateName: "name", Description: "name of the service"},
+					{TemplateName: "taskdefinition", Description: "family:revision or ARN of the task definition to run"},
+					{TemplateName: "desiredcount", Type: "awsint", Description: "number of tasks to keep running"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "role", Description: "name or ARN of the IAM role allowing ECS to register the tasks in the target group"},
+					{TemplateName: "targetgroup", Regex: "^arn:", Description: "ARN of the target group the tasks are registered in"},
+					{TemplateName: "containername", Description: "name of the container registered in the target group"},
+					{TemplateName: "containerport", Type: "awsint", Description: "port of the container registered in the target group"},
+					{TemplateName: "deploymentmaxpercent", Type: "awsint", Description: "upper limit of running tasks during a deployment, in percent of the desired count"},
+					{TemplateName: "deploymentminpercent", Type: "awsint", Description: "lower limit of healthy tasks during a deployment, in percent of the desired count"},
+				},
+			},
+			{
+				Action: "update", Entity: graph.ContainerService.String(), DryRunUnsupported: true, Input: "UpdateServiceInput", Output: "UpdateServiceOutput", ApiMethod: "UpdateService",
+				RequiredParams: []param{
+					{AwsField: "Cluster", TemplateName: "cluster", AwsType: "awsstr", Description: "name or ARN of the cluster running the service"},
+					{AwsField: "Service", TemplateName: "id", AwsType: "awsstr", Description: "name or ARN of the service"},
+				},
+				ExtraParams: []param{
+					{AwsField: "DesiredCount", TemplateName: "desiredcount", AwsType: "awsint64", Description: "number of tasks to keep running"},
+					{AwsField: "TaskDefinition", TemplateName: "taskdefinition", AwsType: "awsstr", Description: "family:revision or ARN of the task definition to deploy"},
+					{AwsField: "DeploymentConfiguration.MaximumPercent", TemplateName: "deploymentmaxpercent", AwsType: "awsint64", Description: "upper limit of running tasks during a deployment, in percent of the desired count"},
+					{AwsField: "DeploymentConfiguration.MinimumHealthyPercent", TemplateName: "deploymentminpercent", AwsType: "awsint64", Description: "lower limit of healthy tasks during a deployment, in percent of the desired count"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.ContainerService.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "cluster", Description: "name or ARN of the cluster running the service"},
+					{TemplateName: "id", Description: "name or ARN of the service (scaled down to 0 tasks before deletion)"},
+				},
+			},
+			// TASK
+			{
+				Action: "start", Entity: graph.Task.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "cluster", Description: "name or ARN of the cluster to run the task on"},
+					{TemplateName: "taskdefinition", Description: "family:revision or ARN of the task definition to run"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "group", Description: "name of the task group of the task"},
+					{TemplateName: "startedby", Description: "tag identifying who started the task"},
+				},
+			},
+			{
+				Action: "stop", Entity: graph.Task.String(), DryRunUnsupported: true, Input: "StopTaskInput", Output: "StopTaskOutput", ApiMethod: "StopTask", OutputExtractor: "aws.StringValue(output.Task.TaskArn)",
+				RequiredParams: []param{
+					{AwsField: "Cluster", TemplateName: "cluster", AwsType: "awsstr", Description: "name or ARN of the cluster running the task"},
+					{AwsField: "Task", TemplateName: "id", AwsType: "awsstr", Description: "id or ARN of the task"},
+				},
+				ExtraParams: []param{
+					{AwsField: "Reason", TemplateName: "reason", AwsType: "awsstr", Description: "reason shown in the stopped reason of the task"},
+				},
+			},
+		},
+	},
+	{
+		Api: "dynamodb",
+		Drivers: []driver{
+			// TABLE
+			{
+				Action: "create", Entity: graph.Table.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "name", Description: "name of the table"},
+					{TemplateName: "hashkey", Regex: "^[^:]+:[SNB]$", Description: "partition key of the table, as name:type (type S, N or B; ex: id:S)"},
+					{TemplateName: "readcapacity", Type: "awsint", Description: "provisioned reads per second"},
+					{TemplateName: "writecapacity", Type: "awsint", Description: "provisioned writes per second"},
+				},
+				ExtraParams: []param{
+					{TemplateName: "rangekey", Regex: "^[^:]+:[SNB]$", Description: "sort key of the table, as name:type (type S, N or B; ex: timestamp:N)"},
+					{TemplateName: "streamview", AllowedValues: []string{"KEYS_ONLY", "NEW_IMAGE", "OLD_IMAGE", "NEW_AND_OLD_IMAGES"}, Description: "enable the stream of the table, with the given item information"},
+				},
+			},
+			{
+				Action: "update", Entity: graph.Table.String(), DryRunUnsupported: true, Input: "UpdateTableInput", Output: "UpdateTableOutput", ApiMethod: "UpdateTable",
+				RequiredParams: []param{
+					{AwsField: "TableName", TemplateName: "id", AwsType: "awsstr", Description: "name of the table"},
+					{AwsField: "ProvisionedThroughput.ReadCapacityUnits", TemplateName: "readcapacity", AwsType: "awsint64", Description: "provisioned reads per second"},
+					{AwsField: "ProvisionedThroughput.WriteCapacityUnits", TemplateName: "writecapacity", AwsType: "awsint64", Description: "provisioned writes per second"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.Table.String(), DryRunUnsupported: true, Input: "DeleteTableInput", Output: "DeleteTableOutput", ApiMethod: "DeleteTable",
+				RequiredParams: []param{
+					{AwsField: "TableName", TemplateName: "id", AwsType: "awsstr", Description: "name of the table"},
+				},
+			},
+			{
+				Action: "check", Entity: graph.Table.String(), ManualFuncDefinition: true,
+				RequiredParams: []param{
+					{TemplateName: "id", Description: "name of the table"},
+					{TemplateName: "state", AllowedValues: []string{"CREATING", "UPDATING", "DELETING", "ACTIVE", "not-found"}, Description: "expected state of the table (not-found once deleted)"},
+					{TemplateName: "timeout", Type: "awsint", Description: "timeout in seconds"},
+				},
+			},
+		},
+	},
+	{
+		Api: "kms",
+		Drivers: []driver{
+			// KEY
+			{
+				Action: "create", Entity: graph.Key.String(), DryRunUnsupported: true, Input: "CreateKeyInput", Output: "CreateKeyOutput", ApiMethod: "CreateKey", OutputExtractor: "aws.StringValue(output.KeyMetadata.KeyId)",
+				ExtraParams: []param{
+					{AwsField: "Description", TemplateName: "description", AwsType: "awsstr", Description: "description of the key"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.Key.String(), DryRunUnsupported: true, Input: "ScheduleKeyDeletionInput", Output: "ScheduleKeyDeletionOutput", ApiMethod: "ScheduleKeyDeletion",
+				RequiredParams: []param{
+					{AwsField: "KeyId", TemplateName: "id", AwsType: "awsstr", Description: "id or ARN of the key"},
+				},
+				ExtraParams: []param{
+					{AwsField: "PendingWindowInDays", TemplateName: "pendingdays", AwsType: "awsint64", Description: "days before the key is deleted, between 7 and 30 (default: 30)"},
+				},
+			},
+
+			// KEY ALIAS
+			{
+				Action: "create", Entity: graph.KeyAlias.String(), DryRunUnsupported: true, Input: "CreateAliasInput", Output: "CreateAliasOutput", ApiMethod: "CreateAlias", OutputExtractor: "aws.StringValue(input.AliasName)",
+				RequiredParams: []param{
+					{AwsField: "AliasName", TemplateName: "name", AwsType: "awsstr", Regex: "^alias/[a-zA-Z0-9:/_-]+$", Description: "name of the alias, prefixed with alias/ (ex: alias/my-key)"},
+					{AwsField: "TargetKeyId", TemplateName: "key", AwsType: "awsstr", Description: "id or ARN of the key"},
+				},
+			},
+			{
+				Action: "delete", Entity: graph.KeyAlias.String(), DryRunUnsupported: true, Input: "DeleteAliasInput", Output: "DeleteAliasOutput", ApiMethod: "DeleteAlias",
+				RequiredParams: []param{
+					{AwsField: "AliasName", TemplateName: "name", AwsType: "awsstr", Regex: "^alias/", Description: "name of the alias, prefixed with alias/"},
+				},
+			},
+		},
+	},
+}
+
+// recordParams identify a record set: deleting one requires all its current values
+var recordParams = []param{
+	{TemplateName: "zone", Description: "id of the hosted zone"},
+	{TemplateName: "name", Description: "fully qualified domain name of the record"},
+	{TemplateName: "type", AllowedValues: []string{"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"}, Description: "type of the record"},
+	{TemplateName: "value", Description: "values of the record"},
+	{TemplateName: "ttl", Type: "awsint", Description: "time to live of the record in seconds"},
+}
+
+// scalingGroupUpdatableParams are the params of an update of a scaling group,
+// whose previous values are returned to revert it
+var scalingGroupUpdatableParams = []param{
+	{TemplateName: "launchconfiguration", Description: "name of the launch configuration of the instances"},
+	{TemplateName: "maxsize", Type: "awsint", Description: "maximum number of instances"},
+	{TemplateName: "minsize", Type: "awsint", Description: "minimum number of instances"},
+	{TemplateName: "desiredcapacity", Type: "awsint", Description: "number of instances to run (between minsize and maxsize)"},
+	{TemplateName: "cooldown", Type: "awsint", Description: "seconds between two scaling activities"},
+	{TemplateName: "healthcheckgraceperiod", Type: "awsint", Description: "seconds before checking the health of a new instance"},
+	{TemplateName: "healthchecktype", AllowedValues: []string{"EC2", "ELB"}, Description: "type of health check of the instances"},
+	{TemplateName: "newinstancesprotected", Type: "awsbool", Description: "protect new instances from scale in"},
+	{TemplateName: "subnets", Regex: "^subnet-", Description: "ids of the subnets the instances are launched in"},
+}
+
+// stackUpdatableParams are the params common to the creation and the update of a stack
+var stackUpdatableParams = []param{
+	{TemplateName: "parameters", Description: "parameters of the template as Key:Value"},
+	{TemplateName: "capabilities", AllowedValues: []string{"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"}, Description: "acknowledge the IAM resources created by the template"},
+	{TemplateName: "notifications", Description: "ARNs of the SNS topics notified of the stack events"},
+	{TemplateName: "role", Description: "ARN of the IAM role CloudFormation assumes to manage the stack"},
+}
+
+// stackTerminalStates are the states in which a stack stays until the next operation on it
+var stackTerminalStates = []string{
+	"CREATE_COMPLETE", "CREATE_FAILED",
+	"UPDATE_COMPLETE", "UPDATE_ROLLBACK_COMPLETE", "UPDATE_ROLLBACK_FAILED",
+	"ROLLBACK_COMPLETE", "ROLLBACK_FAILED",
+	"DELETE_COMPLETE", "DELETE_FAILED",
 }
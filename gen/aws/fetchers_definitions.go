@@ -19,8 +19,10 @@ package aws
 import "github.com/wallix/awless/graph"
 
 type fetchersDef struct {
-	Name     string
-	Api      []string
+	Name string
+	Api  []string
+	// Global is set for services whose resources are not bound to a region: they are synced once, from the session region
+	Global   bool
 	Fetchers []fetcher
 }
 
@@ -38,7 +40,7 @@ type fetcher struct {
 var FetchersDefs = []fetchersDef{
 	{
 		Name: "infra",
-		Api:  []string{"ec2", "elbv2"},
+		Api:  []string{"ec2", "elbv2", "elb"},
 		Fetchers: []fetcher{
 			{Api: "ec2", ResourceType: graph.Instance.String(), AWSType: "ec2.Instance", ApiMethod: "DescribeInstancesPages", Input: "ec2.DescribeInstancesInput{}", Output: "ec2.DescribeInstancesOutput", OutputsExtractor: "Instances", OutputsContainers: "Reservations", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ec2", ResourceType: graph.Subnet.String(), AWSType: "ec2.Subnet", ApiMethod: "DescribeSubnets", Input: "ec2.DescribeSubnetsInput{}", Output: "ec2.DescribeSubnetsOutput", OutputsExtractor: "Subnets"},
@@ -48,15 +50,21 @@ var FetchersDefs = []fetchersDef{
 			{Api: "ec2", ResourceType: graph.Volume.String(), AWSType: "ec2.Volume", ApiMethod: "DescribeVolumesPages", Input: "ec2.DescribeVolumesInput{}", Output: "ec2.DescribeVolumesOutput", OutputsExtractor: "Volumes", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ec2", ResourceType: graph.InternetGateway.String(), AWSType: "ec2.InternetGateway", ApiMethod: "DescribeInternetGateways", Input: "ec2.DescribeInternetGatewaysInput{}", Output: "ec2.DescribeInternetGatewaysOutput", OutputsExtractor: "InternetGateways"},
 			{Api: "ec2", ResourceType: graph.RouteTable.String(), AWSType: "ec2.RouteTable", ApiMethod: "DescribeRouteTables", Input: "ec2.DescribeRouteTablesInput{}", Output: "ec2.DescribeRouteTablesOutput", OutputsExtractor: "RouteTables"},
+			{Api: "ec2", ResourceType: graph.NatGateway.String(), AWSType: "ec2.NatGateway", ApiMethod: "DescribeNatGateways", Input: "ec2.DescribeNatGatewaysInput{}", Output: "ec2.DescribeNatGatewaysOutput", OutputsExtractor: "NatGateways"},
+			{Api: "ec2", ResourceType: graph.ElasticIP.String(), AWSType: "ec2.Address", ApiMethod: "DescribeAddresses", Input: "ec2.DescribeAddressesInput{}", Output: "ec2.DescribeAddressesOutput", OutputsExtractor: "Addresses"},
+			{Api: "ec2", ResourceType: graph.Image.String(), AWSType: "ec2.Image", ApiMethod: "DescribeImages", Input: "ec2.DescribeImagesInput{Owners: []*string{awssdk.String(\"self\")}}", Output: "ec2.DescribeImagesOutput", OutputsExtractor: "Images"},
+			{Api: "ec2", ResourceType: graph.Snapshot.String(), AWSType: "ec2.Snapshot", ApiMethod: "DescribeSnapshotsPages", Input: "ec2.DescribeSnapshotsInput{OwnerIds: []*string{awssdk.String(\"self\")}}", Output: "ec2.DescribeSnapshotsOutput", OutputsExtractor: "Snapshots", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ec2", ResourceType: graph.AvailabilityZone.String(), AWSType: "ec2.AvailabilityZone", ApiMethod: "DescribeAvailabilityZones", Input: "ec2.DescribeAvailabilityZonesInput{}", Output: "ec2.DescribeAvailabilityZonesOutput", OutputsExtractor: "AvailabilityZones"},
 			{Api: "elbv2", ResourceType: graph.LoadBalancer.String(), AWSType: "elbv2.LoadBalancer", ApiMethod: "DescribeLoadBalancersPages", Input: "elbv2.DescribeLoadBalancersInput{}", Output: "elbv2.DescribeLoadBalancersOutput", OutputsExtractor: "LoadBalancers", Multipage: true, NextPageMarker: "NextMarker"},
 			{Api: "elbv2", ResourceType: graph.TargetGroup.String(), AWSType: "elbv2.TargetGroup", ApiMethod: "DescribeTargetGroups", Input: "elbv2.DescribeTargetGroupsInput{}", Output: "elbv2.DescribeTargetGroupsOutput", OutputsExtractor: "TargetGroups"},
 			{Api: "elbv2", ResourceType: graph.Listener.String(), AWSType: "elbv2.Listener", ManualFetcher: true},
+			{Api: "elb", ResourceType: graph.ClassicLoadBalancer.String(), AWSType: "elb.LoadBalancerDescription", ApiMethod: "DescribeLoadBalancersPages", Input: "elb.DescribeLoadBalancersInput{}", Output: "elb.DescribeLoadBalancersOutput", OutputsExtractor: "LoadBalancerDescriptions", Multipage: true, NextPageMarker: "NextMarker"},
 		},
 	},
 	{
-		Name: "access",
-		Api:  []string{"iam"},
+		Name:   "access",
+		Api:    []string{"iam"},
+		Global: true,
 		Fetchers: []fetcher{
 			{Api: "iam", ResourceType: graph.User.String(), AWSType: "iam.UserDetail", ManualFetcher: true},
 			{Api: "iam", ResourceType: graph.Group.String(), AWSType: "iam.GroupDetail", ApiMethod: "GetAccountAuthorizationDetailsPages", Input: "iam.GetAccountAuthorizationDetailsInput{Filter: []*string{awssdk.String(iam.EntityTypeGroup)}}", Output: "iam.GetAccountAuthorizationDetailsOutput", OutputsExtractor: "GroupDetailList", Multipage: true, NextPageMarker: "Marker"},
@@ -65,8 +73,9 @@ var FetchersDefs = []fetchersDef{
 		},
 	},
 	{
-		Name: "storage",
-		Api:  []string{"s3"},
+		Name:   "storage",
+		Api:    []string{"s3"},
+		Global: true,
 		Fetchers: []fetcher{
 			{Api: "s3", ResourceType: graph.Bucket.String(), AWSType: "s3.Bucket", ManualFetcher: true},
 			{Api: "s3", ResourceType: graph.Object.String(), AWSType: "s3.Object", ManualFetcher: true},
@@ -87,4 +96,77 @@ var FetchersDefs = []fetchersDef{
 			{Api: "sqs", ResourceType: graph.Queue.String(), AWSType: "string", ManualFetcher: true},
 		},
 	},
+	{
+		Name: "database",
+		Api:  []string{"rds"},
+		Fetchers: []fetcher{
+			{Api: "rds", ResourceType: graph.Database.String(), AWSType: "rds.DBInstance", ApiMethod: "DescribeDBInstancesPages", Input: "rds.DescribeDBInstancesInput{}", Output: "rds.DescribeDBInstancesOutput", OutputsExtractor: "DBInstances", Multipage: true, NextPageMarker: "Marker"},
+			{Api: "rds", ResourceType: graph.DbSubnetGroup.String(), AWSType: "rds.DBSubnetGroup", ApiMethod: "DescribeDBSubnetGroupsPages", Input: "rds.DescribeDBSubnetGroupsInput{}", Output: "rds.DescribeDBSubnetGroupsOutput", OutputsExtractor: "DBSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
+			{Api: "rds", ResourceType: graph.DbParameterGroup.String(), AWSType: "rds.DBParameterGroup", ApiMethod: "DescribeDBParameterGroupsPages", Input: "rds.DescribeDBParameterGroupsInput{}", Output: "rds.DescribeDBParameterGroupsOutput", OutputsExtractor: "DBParameterGroups", Multipage: true, NextPageMarker: "Marker"},
+		},
+	},
+	{
+		Name: "lambda",
+		Api:  []string{"lambda"},
+		Fetchers: []fetcher{
+			{Api: "lambda", ResourceType: graph.Function.String(), AWSType: "lambda.FunctionConfiguration", ApiMethod: "ListFunctionsPages", Input: "lambda.ListFunctionsInput{}", Output: "lambda.ListFunctionsOutput", OutputsExtractor: "Functions", Multipage: true, NextPageMarker: "NextMarker"},
+		},
+	},
+	{
+		Name:   "dns",
+		Api:    []string{"route53"},
+		Global: true,
+		Fetchers: []fetcher{
+			{Api: "route53", ResourceType: graph.Zone.String(), AWSType: "route53.HostedZone", ApiMethod: "ListHostedZonesPages", Input: "route53.ListHostedZonesInput{}", Output: "route53.ListHostedZonesOutput", OutputsExtractor: "HostedZones", Multipage: true, NextPageMarker: "NextMarker"},
+			{Api: "route53", ResourceType: graph.Record.String(), AWSType: "route53.ResourceRecordSet", ManualFetcher: true},
+		},
+	},
+	{
+		Name: "autoscaling",
+		Api:  []string{"autoscaling"},
+		Fetchers: []fetcher{
+			{Api: "autoscaling", ResourceType: graph.LaunchConfiguration.String(), AWSType: "autoscaling.LaunchConfiguration", ApiMethod: "DescribeLaunchConfigurationsPages", Input: "autoscaling.DescribeLaunchConfigurationsInput{}", Output: "autoscaling.DescribeLaunchConfigurationsOutput", OutputsExtractor: "LaunchConfigurations", Multipage: true, NextPageMarker: "NextToken"},
+			{Api: "autoscaling", ResourceType: graph.ScalingGroup.String(), AWSType: "autoscaling.Group", ApiMethod: "DescribeAutoScalingGroupsPages", Input: "autoscaling.DescribeAutoScalingGroupsInput{}", Output: "autoscaling.DescribeAutoScalingGroupsOutput", OutputsExtractor: "AutoScalingGroups", Multipage: true, NextPageMarker: "NextToken"},
+		},
+	},
+	{
+		Name: "monitoring",
+		Api:  []string{"cloudwatch"},
+		Fetchers: []fetcher{
+			{Api: "cloudwatch", ResourceType: graph.Alarm.String(), AWSType: "cloudwatch.MetricAlarm", ApiMethod: "DescribeAlarmsPages", Input: "cloudwatch.DescribeAlarmsInput{}", Output: "cloudwatch.DescribeAlarmsOutput", OutputsExtractor: "MetricAlarms", Multipage: true, NextPageMarker: "NextToken"},
+		},
+	},
+	{
+		Name: "stack",
+		Api:  []string{"cloudformation"},
+		Fetchers: []fetcher{
+			{Api: "cloudformation", ResourceType: graph.Stack.String(), AWSType: "cloudformation.Stack", ApiMethod: "DescribeStacksPages", Input: "cloudformation.DescribeStacksInput{}", Output: "cloudformation.DescribeStacksOutput", OutputsExtractor: "Stacks", Multipage: true, NextPageMarker: "NextToken"},
+		},
+	},
+	{
+		Name: "container",
+		Api:  []string{"ecs"},
+		Fetchers: []fetcher{
+			{Api: "ecs", ResourceType: graph.Cluster.String(), AWSType: "ecs.Cluster", ManualFetcher: true},
+			{Api: "ecs", ResourceType: graph.ContainerService.String(), AWSType: "ecs.Service", ManualFetcher: true},
+			{Api: "ecs", ResourceType: graph.TaskDefinition.String(), AWSType: "ecs.TaskDefinition", ManualFetcher: true},
+			{Api: "ecs", ResourceType: graph.Task.String(), AWSType: "ecs.Task", ManualFetcher: true},
+			{Api: "ecs", ResourceType: graph.ContainerInstance.String(), AWSType: "ecs.ContainerInstance", ManualFetcher: true},
+		},
+	},
+	{
+		Name: "nosql",
+		Api:  []string{"dynamodb"},
+		Fetchers: []fetcher{
+			{Api: "dynamodb", ResourceType: graph.Table.String(), AWSType: "dynamodb.TableDescription", ManualFetcher: true},
+		},
+	},
+	{
+		Name: "encryption",
+		Api:  []string{"kms"},
+		Fetchers: []fetcher{
+			{Api: "kms", ResourceType: graph.Key.String(), AWSType: "kms.KeyMetadata", ManualFetcher: true},
+			{Api: "kms", ResourceType: graph.KeyAlias.String(), AWSType: "kms.AliasListEntry", ApiMethod: "ListAliasesPages", Input: "kms.ListAliasesInput{}", Output: "kms.ListAliasesOutput", OutputsExtractor: "Aliases", Multipage: true, NextPageMarker: "NextMarker"},
+		},
+	},
 }
@@ -64,8 +64,8 @@ func generateDriverFuncs() {
 
 func generateDriverTypes() {
 	templ, err := template.New("types").Funcs(template.FuncMap{
-		"Title":   strings.Title,
-		"ToUpper": strings.ToUpper,
+		"Title":          strings.Title,
+		"ApiToInterface": ApiToInterface,
 	}).Parse(typesTempl)
 	if err != nil {
 		panic(err)
@@ -115,6 +115,11 @@ var AWSTemplatesDefinitions = map[string]template.TemplateDefinition{
 			RequiredParams: []string{ {{- range $awsField, $field := $def.RequiredParams }}"{{ $field.TemplateName }}", {{- end}} },
 			ExtraParams: []string{ {{- range $awsField, $field := $def.ExtraParams }}"{{ $field.TemplateName }}", {{- end}} },
 			TagsMapping: []string{ {{- range $awsField, $field := $def.TagsMapping }}"{{ $field }}", {{- end}} },
+			Params: map[string]template.ParamDefinition{
+			{{- range $field := $def.ParamsDefinitions }}
+				"{{ $field.TemplateName }}": {Type: "{{ $field.TemplateType }}", {{- if $field.AllowedValues }}AllowedValues: []string{ {{- range $v := $field.AllowedValues }}"{{ $v }}", {{- end }} }, {{- end }}{{- if $field.Regex }}Regex: {{ printf "%q" $field.Regex }}, {{- end }}{{- if $field.Description }}Description: {{ printf "%q" $field.Description }}, {{- end }} },
+			{{- end }}
+			},
 		},
 {{- end }}
 {{- end }}
@@ -158,8 +163,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	{{- range $index, $service := . }}
+	{{- if $service.HasGeneratedDrivers }}
 	"github.com/aws/aws-sdk-go/service/{{ $service.Api }}"
 	{{- end }}
+	{{- end }}
 )
 
 const (
@@ -322,24 +329,24 @@ package aws
 
 import (
 	"strings"
-	"github.com/wallix/awless/template/driver"
-	"github.com/wallix/awless/logger"
-	{{- range $index, $service := . }}
-  "github.com/aws/aws-sdk-go/service/{{ $service.Api }}/{{ $service.Api }}iface"
+{{ range $index, $service := . }}
+	"github.com/aws/aws-sdk-go/service/{{ $service.Api }}/{{ $service.Api }}iface"
 	{{- end }}
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/driver"
 )
 
 {{ range $, $service := . }}
 type {{ Title $service.Api }}Driver struct {
 	dryRun bool
 	logger *logger.Logger
-	{{ $service.Api }}iface.{{ ToUpper $service.Api }}API
+	{{ $service.Api }}iface.{{ ApiToInterface $service.Api }}
 }
 
 func (d *{{ Title $service.Api }}Driver) SetDryRun(dry bool)         { d.dryRun = dry }
 func (d *{{ Title $service.Api }}Driver) SetLogger(l *logger.Logger) { d.logger = l }
 
-func New{{ Title $service.Api }}Driver(api {{ $service.Api }}iface.{{ ToUpper $service.Api }}API) driver.Driver{
+func New{{ Title $service.Api }}Driver(api {{ $service.Api }}iface.{{ ApiToInterface $service.Api }}) driver.Driver{
 	return &{{ Title $service.Api }}Driver{false, logger.DiscardLogger, api}
 }
 
@@ -28,9 +28,9 @@ import (
 
 func generateFetcherFuncs() {
 	templ, err := template.New("funcs").Funcs(template.FuncMap{
-		"Title":   strings.Title,
-		"ToUpper": strings.ToUpper,
-		"Join":    strings.Join,
+		"Title":          strings.Title,
+		"ApiToInterface": ApiToInterface,
+		"Join":           strings.Join,
 	}).Parse(fetchersTempl)
 
 	if err != nil {
@@ -84,7 +84,6 @@ import (
   {{- end }}
   {{- end }}
 	"github.com/wallix/awless/cloud"
-	"github.com/wallix/awless/config"
   "github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/template/driver"
@@ -96,6 +95,9 @@ const accessDenied = "Access Denied"
 func init() {
   {{- range $index, $service := . }}
   ServiceNames = append(ServiceNames, "{{ $service.Name }}")
+  {{- if $service.Global }}
+  cloud.GlobalServices["{{ $service.Name }}"] = true
+  {{- end }}
   {{- end }}
 }
 
@@ -129,10 +131,11 @@ var ServicePerResourceType = map[string]string {
 type {{ Title $service.Name }} struct {
 	once oncer
   region string
+	sess *session.Session
 	config config
 	log *logger.Logger
 	{{- range $, $api := $service.Api }}
-  {{ $api }}iface.{{ ToUpper $api }}API
+  {{ $api }}iface.{{ ApiToInterface $api }}
 	{{- end }}
 }
 
@@ -140,10 +143,11 @@ func New{{ Title $service.Name }}(sess *session.Session, awsconf config, log *lo
   region := awssdk.StringValue(sess.Config.Region)
 	return &{{ Title $service.Name }}{ 
 	{{- range $, $api := $service.Api }}
-		{{ ToUpper $api }}API: {{ $api }}.New(sess),
+		{{ ApiToInterface $api }}: {{ $api }}.New(sess),
 	{{- end }}
 		config: awsconf,
 		region: region,
+		sess: sess,
 		log: log,
   }
 }
@@ -152,10 +156,14 @@ func (s *{{ Title $service.Name }}) Name() string {
   return "{{ $service.Name }}"
 }
 
+func (s *{{ Title $service.Name }}) Region() string {
+  return s.region
+}
+
 func (s *{{ Title $service.Name }}) Drivers() []driver.Driver {
   return []driver.Driver{ 
 		{{- range $, $api := $service.Api }}
-		awsdriver.New{{ Title $api }}Driver(s.{{ ToUpper $api }}API),
+		awsdriver.New{{ Title $api }}Driver(s.{{ ApiToInterface $api }}),
 		{{- end }}
 	}
 }
@@ -231,7 +239,7 @@ func (s *{{ Title $service.Name }}) FetchResources() (*graph.Graph, error) {
 			defer wg.Done()
 			for _, r := range {{ $fetcher.ResourceType }}List {
 				for _, fn := range addParentsFns["{{ $fetcher.ResourceType }}"] {
-					err := fn(g, r)
+					err := fn(g, s, r)
 					if err != nil {
 						errc <- err
 						return
@@ -275,14 +283,14 @@ func (s *{{ Title $service.Name }}) fetch_all_{{ $fetcher.ResourceType }}_graph(
 	var cloudResources []*{{ $fetcher.AWSType }}
 	{{- if $fetcher.Multipage }}
 	var badResErr error
-	err := s.{{ $fetcher.ApiMethod }}(&{{ $fetcher.Input }},
+	err := s.{{ ApiToInterface $fetcher.Api }}.{{ $fetcher.ApiMethod }}(&{{ $fetcher.Input }},
 		func(out *{{ $fetcher.Output }}, lastPage bool) (shouldContinue bool) {
 			{{- if ne $fetcher.OutputsContainers "" }}
 			for _, all := range out.{{ $fetcher.OutputsContainers }} {
 	      for _, output := range all.{{ $fetcher.OutputsExtractor }} {
 					cloudResources = append(cloudResources, output)
 					var res *graph.Resource
-					res, badResErr = newResource(output)
+					res, badResErr = newResource(s, output)
 					if badResErr != nil {
 						return false
 					}
@@ -293,7 +301,7 @@ func (s *{{ Title $service.Name }}) fetch_all_{{ $fetcher.ResourceType }}_graph(
 			for _, output := range out.{{ $fetcher.OutputsExtractor }} {
 				cloudResources = append(cloudResources, output)
 				var res *graph.Resource
-				res, badResErr = newResource(output)
+				res, badResErr = newResource(s, output)
 				if badResErr != nil {
 					return false
 				}
@@ -308,7 +316,7 @@ func (s *{{ Title $service.Name }}) fetch_all_{{ $fetcher.ResourceType }}_graph(
 
 	return g, cloudResources, badResErr
 	{{- else }}
-  out, err := s.{{ $fetcher.ApiMethod }}(&{{ $fetcher.Input }})
+  out, err := s.{{ ApiToInterface $fetcher.Api }}.{{ $fetcher.ApiMethod }}(&{{ $fetcher.Input }})
   if err != nil {
     return nil, cloudResources, err
   }
@@ -316,7 +324,7 @@ func (s *{{ Title $service.Name }}) fetch_all_{{ $fetcher.ResourceType }}_graph(
     for _, all := range out.{{ $fetcher.OutputsContainers }} {
       for _, output := range all.{{ $fetcher.OutputsExtractor }} {
 				cloudResources = append(cloudResources, output)
-        res, err := newResource(output)
+        res, err := newResource(s, output)
         if err != nil {
           return g, cloudResources, err
         }
@@ -326,7 +334,7 @@ func (s *{{ Title $service.Name }}) fetch_all_{{ $fetcher.ResourceType }}_graph(
   	{{ else }}
     for _, output := range out.{{ $fetcher.OutputsExtractor }} {
 			cloudResources = append(cloudResources, output)
-      res, err := newResource(output)
+      res, err := newResource(s, output)
       if err != nil {
         return g, cloudResources, err
       }
@@ -1,26 +1,56 @@
-//go:generate go run $GOFILE drivers.go fetchers.go
+//go:generate go run $GOFILE drivers.go fetchers.go properties.go
 //go:generate gofmt -s -w ../../../aws
 //go:generate goimports -w ../../../aws
 //go:generate gofmt -s -w ../../../aws/driver
 //go:generate goimports -w ../../../aws/driver
+//go:generate gofmt -s -w ../../../console
 
 package main
 
-import "path/filepath"
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/wallix/awless/gen/aws"
+)
 
 var (
 	ROOT_DIR = filepath.Join("..", "..", "..")
 
 	FETCHERS_DIR = filepath.Join(ROOT_DIR, "aws")
 	DRIVERS_DIR  = filepath.Join(ROOT_DIR, "aws", "driver")
+	CONSOLE_DIR  = filepath.Join(ROOT_DIR, "console")
+	MODELS_DIR   = filepath.Join(ROOT_DIR, "vendor", "github.com", "aws", "aws-sdk-go", "models", "apis")
 )
 
 func main() {
-	// fetchers
+	// fetchers, properties, display columns
 	generateFetcherFuncs()
+	generateProperties()
+	generateDefaultColumns()
 
 	// drivers, templates
+	if err := aws.ResolveDriversFromModels(MODELS_DIR); err != nil {
+		panic(err)
+	}
 	generateDriverFuncs()
 	generateTemplateTemplates()
 	generateDriverTypes()
 }
+
+var apiInterfaces = map[string]string{
+	"autoscaling":    "AutoScalingAPI",
+	"cloudformation": "CloudFormationAPI",
+	"cloudwatch":     "CloudWatchAPI",
+	"dynamodb":       "DynamoDBAPI",
+	"lambda":         "LambdaAPI",
+	"route53":        "Route53API",
+}
+
+// ApiToInterface returns the name of the interface of an SDK API client
+func ApiToInterface(api string) string {
+	if name, ok := apiInterfaces[api]; ok {
+		return name
+	}
+	return strings.ToUpper(api) + "API"
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"text/template"
+
+	"github.com/wallix/awless/gen/aws"
+)
+
+func generateProperties() {
+	templ, err := template.New("properties").Parse(propertiesTempl)
+	if err != nil {
+		panic(err)
+	}
+
+	var buff bytes.Buffer
+	err = templ.Execute(&buff, aws.FetchersDefs)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(FETCHERS_DIR, "gen_model.go"), buff.Bytes(), 0666); err != nil {
+		panic(err)
+	}
+}
+
+func generateDefaultColumns() {
+	templ, err := template.New("columns").Parse(columnsTempl)
+	if err != nil {
+		panic(err)
+	}
+
+	var buff bytes.Buffer
+	err = templ.Execute(&buff, aws.FetchersDefs)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(CONSOLE_DIR, "gen_defaults.go"), buff.Bytes(), 0666); err != nil {
+		panic(err)
+	}
+}
+
+const propertiesTempl = `/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// DO NOT EDIT
+// This file was automatically generated with go generate
+package aws
+
+import "github.com/wallix/awless/graph"
+
+var awsResourcesDef = map[graph.ResourceType]map[string]*propertyTransform{
+{{- range $, $service := . }}
+	// {{ $service.Name }}
+{{- range $, $fetcher := $service.Fetchers }}
+	"{{ $fetcher.ResourceType }}": {
+	{{- range $, $prop := $fetcher.Properties }}
+	{{- if $prop.Fetch }}
+		"{{ $prop.Name }}": {fetch: {{ $prop.Fetch }}},
+	{{- else if $prop.AwsField }}
+		"{{ $prop.Name }}": {name: "{{ $prop.AwsField }}", transform: {{ $prop.TransformFn }}},
+	{{- end }}
+	{{- end }}
+	},
+{{- end }}
+{{- end }}
+}
+`
+
+const columnsTempl = `/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// DO NOT EDIT
+// This file was automatically generated with go generate
+package console
+
+import (
+	"github.com/fatih/color"
+	"github.com/wallix/awless/graph"
+)
+
+var DefaultsColumnDefinitions = map[graph.ResourceType][]ColumnDefinition{
+{{- range $, $service := . }}
+	// {{ $service.Name }}
+{{- range $, $fetcher := $service.Fetchers }}
+	"{{ $fetcher.ResourceType }}": {
+	{{- range $, $prop := $fetcher.Properties }}
+	{{- if $prop.Column }}
+		{{ $prop.Column.Definition $prop.Name }},
+	{{- end }}
+	{{- end }}
+	},
+{{- end }}
+{{- end }}
+}
+`
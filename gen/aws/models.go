@@ -0,0 +1,357 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// modelNames are the names of the API models of the SDK clients when they differ
+var modelNames = map[string]string{
+	"cloudwatch": "monitoring",
+	"elb":        "elasticloadbalancing",
+	"elbv2":      "elasticloadbalancingv2",
+}
+
+type apiModel struct {
+	Operations map[string]struct {
+		Input, Output *struct {
+			Shape string `json:"shape"`
+		}
+	} `json:"operations"`
+	Shapes map[string]*shape `json:"shapes"`
+}
+
+type shape struct {
+	Type     string   `json:"type"`
+	Required []string `json:"required"`
+	Enum     []string `json:"enum"`
+	Members  map[string]struct {
+		Shape string `json:"shape"`
+	} `json:"members"`
+	Member, Key, Value *struct {
+		Shape string `json:"shape"`
+	}
+}
+
+// loadAPIModel reads the API model (api-2.json) of an SDK client from the vendored SDK, in its latest version
+func loadAPIModel(dir, api string) (*apiModel, error) {
+	name := api
+	if n, ok := modelNames[api]; ok {
+		name = n
+	}
+	versions, err := filepath.Glob(filepath.Join(dir, name, "*", "api-2.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("no API model found for '%s' in %s", api, dir)
+	}
+	sort.Strings(versions)
+	b, err := ioutil.ReadFile(versions[len(versions)-1])
+	if err != nil {
+		return nil, err
+	}
+	model := &apiModel{}
+	if err = json.Unmarshal(b, model); err != nil {
+		return nil, fmt.Errorf("%s: %s", versions[len(versions)-1], err)
+	}
+	return model, nil
+}
+
+// ResolveDriversFromModels completes and checks the driver definitions against the API models found in dir.
+// A definition giving only its ApiMethod (no Input) gets its input and output types, the types of its params,
+// the split of its Params between required and extra params and, for a creation, its output extractor.
+// All generated definitions are checked: unknown operations or fields and unsupported shapes are reported together.
+func ResolveDriversFromModels(dir string) error {
+	return resolveDriversFromModels(dir, DriversDefs)
+}
+
+func resolveDriversFromModels(dir string, defs []driversDef) error {
+	var errs []string
+	for _, service := range defs {
+		model, err := loadAPIModel(dir, service.Api)
+		if err != nil {
+			return err
+		}
+		for i := range service.Drivers {
+			def := &service.Drivers[i]
+			if def.ManualFuncDefinition && def.ApiMethod == "" {
+				continue
+			}
+			for _, err := range model.resolve(def) {
+				errs = append(errs, fmt.Sprintf("%s %s (%s.%s): %s", def.Action, def.Entity, service.Api, def.ApiMethod, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid driver definitions:\n\t%s", strings.Join(errs, "\n\t"))
+	}
+	return nil
+}
+
+func (m *apiModel) resolve(def *driver) (errs []error) {
+	op, ok := m.Operations[def.ApiMethod]
+	if !ok {
+		return []error{fmt.Errorf("unknown operation")}
+	}
+	inputType, outputType := def.ApiMethod+"Input", def.ApiMethod+"Output"
+	input := &shape{Type: "structure"}
+	if op.Input != nil {
+		input = m.Shapes[op.Input.Shape]
+		inputType = m.goTypeName(op.Input.Shape, inputType)
+	}
+	if op.Output != nil {
+		outputType = m.goTypeName(op.Output.Shape, outputType)
+	}
+
+	inferred := def.Input == ""
+	if inferred {
+		def.Input, def.Output = inputType, outputType
+	} else if def.Input != inputType || (def.Output != "" && def.Output != outputType) {
+		errs = append(errs, fmt.Errorf("input/output types %s/%s do not match operation types %s/%s", def.Input, def.Output, inputType, outputType))
+	}
+
+	for _, p := range def.Params {
+		if m.isRequired(input, p.AwsField) {
+			def.RequiredParams = append(def.RequiredParams, p)
+		} else {
+			def.ExtraParams = append(def.ExtraParams, p)
+		}
+	}
+	def.Params = nil
+
+	for _, params := range [][]param{def.RequiredParams, def.ExtraParams} {
+		for i := range params {
+			p := &params[i]
+			sh, err := m.fieldShape(input, p.AwsField)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("param '%s': %s", p.TemplateName, err))
+				continue
+			}
+			awsType, err := m.awsType(sh, p.AwsField)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("param '%s': %s", p.TemplateName, err))
+				continue
+			}
+			switch {
+			case p.AwsType == "":
+				p.AwsType = awsType
+				if inferred && p.Type == "" && len(p.AllowedValues) == 0 {
+					p.AllowedValues = sh.Enum
+				}
+			case !compatibleAwsTypes(p.AwsType, awsType):
+				errs = append(errs, fmt.Errorf("param '%s': type %s does not match %s field %s", p.TemplateName, p.AwsType, awsType, p.AwsField))
+			}
+		}
+	}
+
+	if inferred && def.OutputExtractor == "" && def.Action == "create" && op.Output != nil {
+		extractor, err := m.outputExtractor(m.Shapes[op.Output.Shape], def.Entity)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		def.OutputExtractor = extractor
+	}
+	return
+}
+
+// fieldShape returns the shape of a field of a structure given its path (ex: ProvisionedThroughput.ReadCapacityUnits, Attributes[Policy])
+func (m *apiModel) fieldShape(sh *shape, path string) (*shape, error) {
+	for _, name := range strings.Split(path, ".") {
+		if i := strings.Index(name, "["); i > 0 {
+			name = name[:i]
+		}
+		if sh.Type != "structure" {
+			return nil, fmt.Errorf("field %s: %s is not a structure", path, name)
+		}
+		member, ok := sh.member(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %s", path)
+		}
+		sh = m.Shapes[member]
+	}
+	return sh, nil
+}
+
+// isRequired returns whether a field is required given its path: a nested field is required
+// only when it is required in its structure and its parent fields are themselves required
+func (m *apiModel) isRequired(sh *shape, path string) bool {
+	for _, name := range strings.Split(path, ".") {
+		if sh == nil || strings.Contains(name, "[") {
+			return false
+		}
+		var required bool
+		for _, n := range sh.Required {
+			if exported(n) == name {
+				required = true
+			}
+		}
+		if !required {
+			return false
+		}
+		member, _ := sh.member(name)
+		sh = m.Shapes[member]
+	}
+	return true
+}
+
+// member returns the shape of a member given its name in the SDK (some models name members in lower camel case)
+func (sh *shape) member(name string) (string, bool) {
+	for n, member := range sh.Members {
+		if exported(n) == name {
+			return member.Shape, true
+		}
+	}
+	return "", false
+}
+
+// goTypeName returns the name of the SDK type of a top level shape: as the SDK generator does, a shape only used
+// by the operation is renamed after it (ex: CreateVpcInput), a shared one keeps its name (ex: Reservation)
+func (m *apiModel) goTypeName(shapeName, opTypeName string) string {
+	if _, exists := m.Shapes[opTypeName]; !exists && m.refsCount(shapeName) == 1 {
+		return opTypeName
+	}
+	return shapeName
+}
+
+func (m *apiModel) refsCount(shapeName string) (count int) {
+	for _, op := range m.Operations {
+		if op.Input != nil && op.Input.Shape == shapeName {
+			count++
+		}
+		if op.Output != nil && op.Output.Shape == shapeName {
+			count++
+		}
+	}
+	for _, sh := range m.Shapes {
+		for _, member := range sh.Members {
+			if member.Shape == shapeName {
+				count++
+			}
+		}
+		for _, ref := range []*struct {
+			Shape string `json:"shape"`
+		}{sh.Member, sh.Key, sh.Value} {
+			if ref != nil && ref.Shape == shapeName {
+				count++
+			}
+		}
+	}
+	return
+}
+
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// awsType returns the type used to set a field of the given shape from a template value
+func (m *apiModel) awsType(sh *shape, path string) (string, error) {
+	switch sh.Type {
+	case "string":
+		return "awsstr", nil
+	case "integer", "long":
+		return "awsint64", nil
+	case "boolean":
+		return "awsbool", nil
+	case "list":
+		switch m.Shapes[sh.Member.Shape].Type {
+		case "string":
+			return "awsstringslice", nil
+		case "integer", "long":
+			return "awsint64slice", nil
+		}
+	case "map":
+		if strings.HasSuffix(path, "]") && m.Shapes[sh.Value.Shape].Type == "string" {
+			return "awsstringpointermap", nil
+		}
+	case "structure":
+		// EC2 attributes wrapping a single value (ex: AttributeBooleanValue)
+		if value, ok := sh.member("Value"); ok && len(sh.Members) == 1 {
+			switch m.Shapes[value].Type {
+			case "boolean":
+				return "awsboolattribute", nil
+			case "string":
+				return "awsstringattribute", nil
+			}
+		}
+	}
+	return "", fmt.Errorf("unsupported %s shape for field %s", sh.Type, path)
+}
+
+func compatibleAwsTypes(declared, inferred string) bool {
+	// comma separated values are set into string fields
+	return declared == inferred || (declared == "awscsvstr" && inferred == "awsstr")
+}
+
+var idSuffixes = []string{"Id", "Arn", "Url", "Name"}
+
+// outputExtractor finds the identifier of a created resource in the output of its creation: the string field of
+// the output (ex: TopicArn), or the identifier of its structure field (ex: Vpc.VpcId)
+func (m *apiModel) outputExtractor(out *shape, entity string) (string, error) {
+	if out == nil {
+		return "", fmt.Errorf("cannot infer output extractor: no output")
+	}
+	if field, ok := m.idField(out, entity); ok {
+		return fmt.Sprintf("aws.StringValue(output.%s)", field), nil
+	}
+	var structs []string
+	for name, member := range out.Members {
+		if m.Shapes[member.Shape].Type == "structure" {
+			structs = append(structs, exported(name))
+		}
+	}
+	if len(structs) == 1 {
+		member, _ := out.member(structs[0])
+		if field, ok := m.idField(m.Shapes[member], structs[0]); ok {
+			return fmt.Sprintf("aws.StringValue(output.%s.%s)", structs[0], field), nil
+		}
+	}
+	return "", fmt.Errorf("cannot infer output extractor: set OutputExtractor")
+}
+
+// idField returns the string field of a structure holding an identifier, by order of preference on its suffix:
+// the field named after the given name, case insensitively (ex: VpcId in Vpc), else the only field with the suffix
+func (m *apiModel) idField(sh *shape, name string) (string, bool) {
+	for _, suffix := range idSuffixes {
+		var found []string
+		for n, member := range sh.Members {
+			if !strings.HasSuffix(exported(n), suffix) || m.Shapes[member.Shape].Type != "string" {
+				continue
+			}
+			if strings.EqualFold(n, name+suffix) {
+				return exported(n), true
+			}
+			found = append(found, exported(n))
+		}
+		if len(found) == 1 {
+			return found[0], true
+		}
+		if len(found) > 1 {
+			return "", false
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDriversDefinitionsMatchAPIModels(t *testing.T) {
+	if err := ResolveDriversFromModels(filepath.Join("..", "..", "vendor", "github.com", "aws", "aws-sdk-go", "models", "apis")); err != nil {
+		t.Fatal(err)
+	}
+	for _, service := range DriversDefs {
+		for _, def := range service.Drivers {
+			if def.ManualFuncDefinition {
+				continue
+			}
+			if def.Input == "" || len(def.Params) > 0 {
+				t.Fatalf("%s %s: unresolved definition", def.Action, def.Entity)
+			}
+			for _, p := range def.ParamsDefinitions() {
+				if p.AwsType == "" {
+					t.Fatalf("%s %s: param '%s' without type", def.Action, def.Entity, p.TemplateName)
+				}
+			}
+		}
+	}
+}
+
+func TestHasGeneratedDrivers(t *testing.T) {
+	generated := make(map[string]bool)
+	for _, service := range DriversDefs {
+		generated[service.Api] = service.HasGeneratedDrivers()
+	}
+	// the drivers of route53 and elb are all written by hand: their SDK packages must not be imported
+	for api, exp := range map[string]bool{"ec2": true, "elbv2": true, "route53": false, "elb": false} {
+		if got, ok := generated[api]; !ok || got != exp {
+			t.Fatalf("%s: got %t, want %t", api, got, exp)
+		}
+	}
+}
+
+const testModel = `{
+  "operations": {
+    "CreateQueue": {"input": {"shape": "CreateQueueRequest"}, "output": {"shape": "CreateQueueResult"}},
+    "CreateThing": {"input": {"shape": "CreateThingRequest"}, "output": {"shape": "Thing"}},
+    "DescribeThing": {"input": {"shape": "DescribeThingRequest"}, "output": {"shape": "Thing"}},
+    "DeleteThing": {"input": {"shape": "DeleteThingRequest"}}
+  },
+  "shapes": {
+    "CreateQueueRequest": {"type": "structure", "required": ["queueName"], "members": {
+      "queueName": {"shape": "String"}, "Attributes": {"shape": "AttributeMap"}, "Tags": {"shape": "StringList"}
+    }},
+    "CreateQueueResult": {"type": "structure", "members": {"QueueUrl": {"shape": "String"}}},
+    "CreateThingRequest": {"type": "structure", "required": ["Name", "Config"], "members": {
+      "Name": {"shape": "String"}, "Size": {"shape": "Integer"}, "Kind": {"shape": "Kind"},
+      "Config": {"shape": "Config"}, "Expiration": {"shape": "Timestamp"}, "Enabled": {"shape": "BooleanValue"}
+    }},
+    "DescribeThingRequest": {"type": "structure", "members": {"ThingId": {"shape": "String"}}},
+    "DeleteThingRequest": {"type": "structure", "required": ["ThingId"], "members": {"ThingId": {"shape": "String"}}},
+    "Thing": {"type": "structure", "members": {"ThingId": {"shape": "String"}, "OwnerId": {"shape": "String"}, "Name": {"shape": "String"}}},
+    "Config": {"type": "structure", "required": ["Capacity"], "members": {"Capacity": {"shape": "Long"}, "Zones": {"shape": "StringList"}}},
+    "BooleanValue": {"type": "structure", "members": {"Value": {"shape": "Boolean"}}},
+    "AttributeMap": {"type": "map", "key": {"shape": "String"}, "value": {"shape": "String"}},
+    "StringList": {"type": "list", "member": {"shape": "String"}},
+    "Kind": {"type": "string", "enum": ["small", "large"]},
+    "String": {"type": "string"},
+    "Integer": {"type": "integer"},
+    "Long": {"type": "long"},
+    "Boolean": {"type": "boolean"},
+    "Timestamp": {"type": "timestamp"}
+  }
+}`
+
+func TestResolveDriverFromModel(t *testing.T) {
+	model := &apiModel{}
+	if err := json.Unmarshal([]byte(testModel), model); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("inferred", func(t *testing.T) {
+		def := &driver{Action: "create", Entity: "queue", ApiMethod: "CreateQueue", Params: []param{
+			{AwsField: "Attributes[Policy]", TemplateName: "policy"},
+			{AwsField: "QueueName", TemplateName: "name"},
+			{AwsField: "Tags", TemplateName: "tags"},
+		}}
+		if errs := model.resolve(def); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+		if got, want := def.Input+"/"+def.Output, "CreateQueueInput/CreateQueueOutput"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := def.RequiredParams, []param{{AwsField: "QueueName", TemplateName: "name", AwsType: "awsstr"}}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %#v, want %#v", got, want)
+		}
+		if got, want := def.ExtraParams, []param{
+			{AwsField: "Attributes[Policy]", TemplateName: "policy", AwsType: "awsstringpointermap"},
+			{AwsField: "Tags", TemplateName: "tags", AwsType: "awsstringslice"},
+		}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %#v, want %#v", got, want)
+		}
+		if got, want := def.OutputExtractor, "aws.StringValue(output.QueueUrl)"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("shared output shape", func(t *testing.T) {
+		def := &driver{Action: "create", Entity: "thing", ApiMethod: "CreateThing", Params: []param{
+			{AwsField: "Name", TemplateName: "name"},
+			{AwsField: "Config.Capacity", TemplateName: "capacity"},
+			{AwsField: "Config.Zones", TemplateName: "zones"},
+			{AwsField: "Size", TemplateName: "size"},
+			{AwsField: "Kind", TemplateName: "kind"},
+			{AwsField: "Enabled", TemplateName: "enabled"},
+		}}
+		if errs := model.resolve(def); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+		if got, want := def.Input+"/"+def.Output, "CreateThingInput/Thing"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		var required []string
+		for _, p := range def.RequiredParams {
+			required = append(required, p.TemplateName+":"+p.AwsType)
+		}
+		if got, want := required, []string{"name:awsstr", "capacity:awsint64"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		var extra []string
+		for _, p := range def.ExtraParams {
+			extra = append(extra, p.TemplateName+":"+p.TemplateType())
+		}
+		if got, want := extra, []string{"zones:awsstr", "size:awsint", "kind:enum", "enabled:awsbool"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := def.ExtraParams[2].AllowedValues, []string{"small", "large"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := def.ExtraParams[3].AwsType, "awsboolattribute"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := def.OutputExtractor, "aws.StringValue(output.ThingId)"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tcases := []struct {
+			def *driver
+			err string
+		}{
+			{def: &driver{Action: "create", Entity: "thing", ApiMethod: "CreateThing", Params: []param{{AwsField: "Expiration", TemplateName: "expiration"}}}, err: "unsupported timestamp shape"},
+			{def: &driver{Action: "create", Entity: "thing", ApiMethod: "CreateThing", Params: []param{{AwsField: "Config", TemplateName: "config"}}}, err: "unsupported structure shape"},
+			{def: &driver{Action: "create", Entity: "thing", ApiMethod: "CreateThing", Params: []param{{AwsField: "Unknown", TemplateName: "unknown"}}}, err: "unknown field Unknown"},
+			{def: &driver{Action: "delete", Entity: "thing", ApiMethod: "RemoveThing"}, err: "unknown operation"},
+			{def: &driver{Action: "delete", Entity: "thing", ApiMethod: "DeleteThing", Input: "DeleteThingInput", Output: "DeleteThingOutput", RequiredParams: []param{{AwsField: "ThingId", TemplateName: "id", AwsType: "awsbool"}}}, err: "type awsbool does not match awsstr"},
+			{def: &driver{Action: "describe", Entity: "thing", ApiMethod: "DescribeThing", Input: "DescribeThingInput", Output: "DescribeThingOutput"}, err: "do not match operation types DescribeThingInput/Thing"},
+		}
+		for i, tcase := range tcases {
+			errs := model.resolve(tcase.def)
+			if len(errs) != 1 || !strings.Contains(errs[0].Error(), tcase.err) {
+				t.Fatalf("%d: got %v, want error containing '%s'", i+1, errs, tcase.err)
+			}
+		}
+	})
+}
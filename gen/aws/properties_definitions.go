@@ -0,0 +1,638 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/graph"
+)
+
+// property is a property of the resources of a fetcher, generated into the property transforms (aws/gen_model.go)
+// and, when displayed, into the default columns of their listing (console/gen_defaults.go)
+type property struct {
+	Name string
+	// AwsField is the field of the AWS type of the fetcher holding the property, extracted according to the Transform.
+	// A property without AwsField nor Fetch is set manually by its fetcher
+	AwsField string
+	// Transform is the kind of extraction of the AWS field (default: its value):
+	//   time, stringtime (TransformArg: layout constant), tag (TransformArg: tag key), field (TransformArg: field of a struct),
+	//   slicevalues (TransformArg: field of the structs of a slice), stringslice, stringslicefield (TransformArg: field of a struct),
+	//   anytrue (TransformArg: bool field of the structs of a slice), commaseparated, custom (TransformArg: transform function)
+	Transform, TransformArg string
+	// Fetch is the function fetching the property when it is not held by the AWS type (ex: fetchBucketPolicyFn)
+	Fetch string
+	// Column displays the property by default when listing resources, columns being in the order of the properties
+	Column *column
+}
+
+type column struct {
+	// Kind is the kind of column (default: string): time, firewallrules, routes, grants.
+	// A column with colored values is colored
+	Kind                           string
+	Friendly                       string
+	DisableTruncate, TruncateRight bool
+	ColoredValues                  map[string]string
+}
+
+// TransformFn returns the transform function extracting the property from its AWS field
+func (p property) TransformFn() (string, error) {
+	switch p.Transform {
+	case "":
+		return "extractValueFn", nil
+	case "time":
+		return "extractTimeFn", nil
+	case "stringtime":
+		return fmt.Sprintf("extractStringTimeFn(%s)", p.TransformArg), nil
+	case "tag":
+		return fmt.Sprintf("extractTagFn(%q)", p.TransformArg), nil
+	case "field":
+		return fmt.Sprintf("extractFieldFn(%q)", p.TransformArg), nil
+	case "slicevalues":
+		return fmt.Sprintf("extractSliceValues(%q)", p.TransformArg), nil
+	case "stringslice":
+		return "extractStringSliceFn", nil
+	case "stringslicefield":
+		return fmt.Sprintf("extractStringSliceFieldFn(%q)", p.TransformArg), nil
+	case "anytrue":
+		return fmt.Sprintf("extractHasATrueBoolInStructSliceFn(%q)", p.TransformArg), nil
+	case "commaseparated":
+		return "extractCommaSeparatedFn", nil
+	case "custom":
+		return p.TransformArg, nil
+	}
+	return "", fmt.Errorf("property %s: unknown transform '%s'", p.Name, p.Transform)
+}
+
+// Definition returns the console column definition of the property prop
+func (c column) Definition(prop string) (string, error) {
+	def := fmt.Sprintf("StringColumnDefinition{Prop: %q", prop)
+	if c.Friendly != "" {
+		def += fmt.Sprintf(", Friendly: %q", c.Friendly)
+	}
+	if c.DisableTruncate {
+		def += ", DisableTruncate: true"
+	}
+	if c.TruncateRight {
+		def += ", TruncateRight: true"
+	}
+	def += "}"
+
+	if len(c.ColoredValues) > 0 {
+		var values []string
+		for v := range c.ColoredValues {
+			values = append(values, fmt.Sprintf("%q: color.%s", v, c.ColoredValues[v]))
+		}
+		sort.Strings(values)
+		return fmt.Sprintf("ColoredValueColumnDefinition{StringColumnDefinition: %s, ColoredValues: map[string]color.Attribute{%s}}", def, strings.Join(values, ", ")), nil
+	}
+	switch c.Kind {
+	case "":
+		return def, nil
+	case "time":
+		return fmt.Sprintf("TimeColumnDefinition{StringColumnDefinition: %s}", def), nil
+	case "firewallrules":
+		return fmt.Sprintf("FirewallRulesColumnDefinition{StringColumnDefinition: %s}", def), nil
+	case "routes":
+		return fmt.Sprintf("RoutesColumnDefinition{StringColumnDefinition: %s}", def), nil
+	case "grants":
+		return fmt.Sprintf("GrantsColumnDefinition{StringColumnDefinition: %s}", def), nil
+	}
+	return "", fmt.Errorf("unknown column kind '%s'", c.Kind)
+}
+
+// Properties returns the properties of the resources of the fetcher
+func (f fetcher) Properties() []property {
+	return propertiesDefs[f.ResourceType]
+}
+
+var propertiesDefs = map[string][]property{
+	// infra
+	graph.Instance.String(): {
+		{Name: "Id", AwsField: "InstanceId", Column: &column{}},
+		{Name: "SubnetId", AwsField: "SubnetId", Column: &column{}},
+		{Name: "Name", AwsField: "Tags", Transform: "tag", TransformArg: "Name", Column: &column{}},
+		{Name: "State", AwsField: "State", Transform: "field", TransformArg: "Name", Column: &column{ColoredValues: map[string]string{"running": "FgGreen", "stopped": "FgRed"}}},
+		{Name: "Type", AwsField: "InstanceType", Column: &column{}},
+		{Name: "KeyName", AwsField: "KeyName", Column: &column{Friendly: "Access Key"}},
+		{Name: "PublicIp", AwsField: "PublicIpAddress", Column: &column{Friendly: "Public IP"}},
+		{Name: "LaunchTime", AwsField: "LaunchTime", Column: &column{Kind: "time"}},
+		{Name: "VpcId", AwsField: "VpcId"},
+		{Name: "PrivateIp", AwsField: "PrivateIpAddress"},
+		{Name: "ImageId", AwsField: "ImageId"},
+		{Name: "SecurityGroups", AwsField: "SecurityGroups", Transform: "slicevalues", TransformArg: "GroupId"},
+	},
+	graph.Subnet.String(): {
+		{Name: "Id", AwsField: "SubnetId", Column: &column{}},
+		{Name: "Name", AwsField: "Tags", Transform: "tag", TransformArg: "Name", Column: &column{}},
+		{Name: "CidrBlock", AwsField: "CidrBlock", Column: &column{}},
+		{Name: "AvailabilityZone", AwsField: "AvailabilityZone", Column: &column{Friendly: "Zone"}},
+		{Name: "VpcId", AwsField: "VpcId", Column: &column{}},
+		{Name: "MapPublicIpOnLaunch", AwsField: "MapPublicIpOnLaunch", Column: &column{Friendly: "Public VMs", ColoredValues: map[string]string{"true": "FgYellow"}}},
+		{Name: "State", AwsField: "State", Column: &column{ColoredValues: map[string]string{"available": "FgGreen"}}},
+		{Name: "DefaultForAz", AwsField: "DefaultForAz", Column: &column{Friendly: "ZoneDefault", ColoredValues: map[string]string{"true": "FgGreen"}}},
+	},
+	graph.Vpc.String(): {
+		{Name: "Id", AwsField: "VpcId", Column: &column{}},
+		{Name: "Name", AwsField: "Tags", Transform: "tag", TransformArg: "Name", Column: &column{}},
+		{Name: "IsDefault", AwsField: "IsDefault", Column: &column{Friendly: "Default", ColoredValues: map[string]string{"true": "FgGreen"}}},
+		{Name: "State", AwsField: "State", Column: &column{}},
+		{Name: "CidrBlock", AwsField: "CidrBlock", Column: &column{}},
+	},
+	graph.Keypair.String(): {
+		{Name: "Id", AwsField: "KeyName", Column: &column{}},
+		{Name: "KeyFingerprint", AwsField: "KeyFingerprint", Column: &column{DisableTruncate: true}},
+		{Name: "Name", AwsField: "KeyName"},
+	},
+	graph.SecurityGroup.String(): {
+		{Name: "Id", AwsField: "GroupId", Column: &column{}},
+		{Name: "VpcId", AwsField: "VpcId", Column: &column{}},
+		{Name: "InboundRules", AwsField: "IpPermissions", Transform: "custom", TransformArg: "extractIpPermissionSliceFn", Column: &column{Kind: "firewallrules", Friendly: "Inbound"}},
+		{Name: "OutboundRules", AwsField: "IpPermissionsEgress", Transform: "custom", TransformArg: "extractIpPermissionSliceFn", Column: &column{Kind: "firewallrules", Friendly: "Outbound"}},
+		{Name: "Name", AwsField: "GroupName", Column: &column{DisableTruncate: true}},
+		{Name: "Description", AwsField: "Description", Column: &column{DisableTruncate: true}},
+		{Name: "OwnerId", AwsField: "OwnerId"},
+	},
+	graph.Volume.String(): {
+		{Name: "Id", AwsField: "VolumeId", Column: &column{}},
+		{Name: "Name", AwsField: "Tags", Transform: "tag", TransformArg: "Name", Column: &column{DisableTruncate: true}},
+		{Name: "VolumeType", AwsField: "VolumeType", Column: &column{}},
+		{Name: "State", AwsField: "State", Column: &column{}},
+		{Name: "Size", AwsField: "Size", Column: &column{Friendly: "Size (Gb)"}},
+		{Name: "Encrypted", AwsField: "Encrypted", Column: &column{}},
+		{Name: "CreateTime", AwsField: "CreateTime", Transform: "time", Column: &column{Kind: "time"}},
+		{Name: "AvailabilityZone", AwsField: "AvailabilityZone", Column: &column{}},
+		{Name: "Key", AwsField: "KmsKeyId"},
+	},
+	graph.InternetGateway.String(): {
+		{Name: "Id", AwsField: "InternetGatewayId", Column: &column{}},
+		{Name: "Name", AwsField: "Tags", Transform: "tag", TransformArg: "Name", Column: &column{DisableTruncate: true}},
+		{Name: "Vpcs", AwsField: "Attachments", Transform: "slicevalues", TransformArg: "VpcId", Column: &column{DisableTruncate: true}},
+	},
+	graph.RouteTable.String(): {
+		{Name: "Id", AwsField: "RouteTableId", Column: &column{}},
+		{Name: "Name", AwsField: "Tags", Transform: "tag", TransformArg: "Name", Column: &column{DisableTruncate: true}},
+		{Name: "VpcId", AwsField: "VpcId", Column: &column{}},
+		{Name: "Main", AwsField: "Associations", Transform: "anytrue", TransformArg: "Main", Column: &column{}},
+		{Name: "Routes", AwsField: "Routes", Transform: "custom", TransformArg: "extractRoutesSliceFn", Column: &column{Kind: "routes"}},
+	},
+	graph.NatGateway.String(): {
+		{Name: "Id", AwsField: "NatGatewayId", Column: &column{}},
+		{Name: "State", AwsField: "State", Column: &column{ColoredValues: map[string]string{"available": "FgGreen", "failed": "FgRed"}}},
+		{Name: "VpcId", AwsField: "VpcId", Column: &column{}},
+		{Name: "SubnetId", AwsField: "SubnetId", Column: &column{}},
+		{Name: "PublicIPs", AwsField: "NatGatewayAddresses", Transform: "slicevalues", TransformArg: "PublicIp", Column: &column{}},
+		{Name: "PrivateIPs", AwsField: "NatGatewayAddresses", Transform: "slicevalues", TransformArg: "PrivateIp", Column: &column{}},
+		{Name: "CreateTime", AwsField: "CreateTime", Transform: "time", Column: &column{Kind: "time", Friendly: "Created"}},
+		{Name: "ElasticIPs", AwsField: "NatGatewayAddresses", Transform: "slicevalues", TransformArg: "AllocationId"},
+		{Name: "FailureCode", AwsField: "FailureCode"},
+	},
+	graph.ElasticIP.String(): {
+		{Name: "Id", AwsField: "AllocationId", Column: &column{}},
+		{Name: "PublicIp", AwsField: "PublicIp", Column: &column{}},
+		{Name: "PrivateIp", AwsField: "PrivateIpAddress", Column: &column{}},
+		{Name: "Domain", AwsField: "Domain", Column: &column{}},
+		{Name: "InstanceId", AwsField: "InstanceId", Column: &column{}},
+		{Name: "AssociationId", AwsField: "AssociationId", Column: &column{}},
+		{Name: "NetworkInterfaceId", AwsField: "NetworkInterfaceId"},
+	},
+	graph.Image.String(): {
+		{Name: "Id", AwsField: "ImageId", Column: &column{}},
+		{Name: "Name", AwsField: "Name", Column: &column{DisableTruncate: true}},
+		{Name: "State", AwsField: "State", Column: &column{ColoredValues: map[string]string{"available": "FgGreen", "failed": "FgRed"}}},
+		{Name: "Architecture", AwsField: "Architecture", Column: &column{}},
+		{Name: "Type", AwsField: "VirtualizationType", Column: &column{}},
+		{Name: "RootDeviceType", AwsField: "RootDeviceType", Column: &column{}},
+		{Name: "Public", AwsField: "Public", Column: &column{}},
+		{Name: "CreateTime", AwsField: "CreationDate", Transform: "stringtime", TransformArg: "imageTimeLayout", Column: &column{Kind: "time", Friendly: "Created"}},
+		{Name: "Description", AwsField: "Description"},
+		{Name: "Hypervisor", AwsField: "Hypervisor"},
+		{Name: "Location", AwsField: "ImageLocation"},
+		{Name: "RootDevice", AwsField: "RootDeviceName"},
+		{Name: "Snapshots", AwsField: "BlockDeviceMappings", Transform: "custom", TransformArg: "extractImageSnapshotsFn"},
+	},
+	graph.Snapshot.String(): {
+		{Name: "Id", AwsField: "SnapshotId", Column: &column{}},
+		{Name: "Name", AwsField: "Tags", Transform: "tag", TransformArg: "Name", Column: &column{DisableTruncate: true}},
+		{Name: "VolumeId", AwsField: "VolumeId", Column: &column{}},
+		{Name: "State", AwsField: "State", Column: &column{ColoredValues: map[string]string{"completed": "FgGreen", "error": "FgRed"}}},
+		{Name: "Progress", AwsField: "Progress", Column: &column{}},
+		{Name: "Size", AwsField: "VolumeSize", Column: &column{Friendly: "Size (Gb)"}},
+		{Name: "Encrypted", AwsField: "Encrypted", Column: &column{}},
+		{Name: "CreateTime", AwsField: "StartTime", Transform: "time", Column: &column{Kind: "time", Friendly: "Created"}},
+		{Name: "Description", AwsField: "Description"},
+		{Name: "Key", AwsField: "KmsKeyId"},
+	},
+	graph.AvailabilityZone.String(): {
+		{Name: "Name", AwsField: "ZoneName", Column: &column{}},
+		{Name: "State", AwsField: "State", Column: &column{}},
+		{Name: "Region", AwsField: "RegionName", Column: &column{}},
+		{Name: "Messages", AwsField: "Messages", Transform: "slicevalues", TransformArg: "Message", Column: &column{}},
+		{Name: "Id", AwsField: "ZoneName"},
+	},
+	graph.LoadBalancer.String(): {
+		{Name: "Name", AwsField: "LoadBalancerName", Column: &column{}},
+		{Name: "VpcId", AwsField: "VpcId", Column: &column{}},
+		{Name: "State", AwsField: "State", Transform: "field", TransformArg: "Code", Column: &column{}},
+		{Name: "DNSName", AwsField: "DNSName", Column: &column{}},
+		{Name: "CreateTime", AwsField: "CreatedTime", Transform: "time", Column: &column{Kind: "time"}},
+		{Name: "Scheme", AwsField: "Scheme", Column: &column{}},
+		{Name: "Id", AwsField: "LoadBalancerArn"},
+		{Name: "AvailabilityZones", AwsField: "AvailabilityZones", Transform: "slicevalues", TransformArg: "ZoneName"},
+		{Name: "Subnets", AwsField: "AvailabilityZones", Transform: "slicevalues", TransformArg: "SubnetId"},
+		{Name: "CanonicalHostedZoneId", AwsField: "CanonicalHostedZoneId"},
+		{Name: "IpAddressType", AwsField: "IpAddressType"},
+		{Name: "Type", AwsField: "Type"},
+	},
+	graph.TargetGroup.String(): {
+		{Name: "Name", AwsField: "TargetGroupName", Column: &column{}},
+		{Name: "VpcId", AwsField: "VpcId", Column: &column{}},
+		{Name: "Matcher", AwsField: "Matcher", Transform: "field", TransformArg: "HttpCode", Column: &column{}},
+		{Name: "Port", AwsField: "Port", Column: &column{}},
+		{Name: "Protocol", AwsField: "Protocol", Column: &column{}},
+		{Name: "HealthCheckIntervalSeconds", AwsField: "HealthCheckIntervalSeconds", Column: &column{Friendly: "HCInterval"}},
+		{Name: "HealthCheckPath", AwsField: "HealthCheckPath", Column: &column{Friendly: "HCPath"}},
+		{Name: "HealthCheckPort", AwsField: "HealthCheckPort", Column: &column{Friendly: "HCPort"}},
+		{Name: "HealthCheckProtocol", AwsField: "HealthCheckProtocol", Column: &column{Friendly: "HCProtocol"}},
+		{Name: "Id", AwsField: "TargetGroupArn"},
+		{Name: "HealthCheckTimeoutSeconds", AwsField: "HealthCheckTimeoutSeconds"},
+		{Name: "HealthyThresholdCount", AwsField: "HealthyThresholdCount"},
+		{Name: "UnhealthyThresholdCount", AwsField: "UnhealthyThresholdCount"},
+	},
+	graph.Listener.String(): {
+		{Name: "Id", AwsField: "ListenerArn", Column: &column{}},
+		{Name: "Actions", AwsField: "DefaultActions", Transform: "slicevalues", TransformArg: "Type", Column: &column{}},
+		{Name: "LoadBalancer", AwsField: "LoadBalancerArn", Column: &column{}},
+		{Name: "Port", AwsField: "Port", Column: &column{}},
+		{Name: "Protocol", AwsField: "Protocol", Column: &column{}},
+		{Name: "SslPolicy", AwsField: "SslPolicy", Column: &column{}},
+		{Name: "Certificates", AwsField: "Certificates", Transform: "slicevalues", TransformArg: "CertificateArn"},
+	},
+	graph.ClassicLoadBalancer.String(): {
+		{Name: "Name", AwsField: "LoadBalancerName", Column: &column{}},
+		{Name: "VpcId", AwsField: "VPCId", Column: &column{}},
+		{Name: "DNSName", AwsField: "DNSName", Column: &column{}},
+		{Name: "Listeners", AwsField: "ListenerDescriptions", Transform: "custom", TransformArg: "extractClassicListenersFn", Column: &column{}},
+		{Name: "Instances", AwsField: "Instances", Transform: "slicevalues", TransformArg: "InstanceId", Column: &column{}},
+		{Name: "CreateTime", AwsField: "CreatedTime", Transform: "time", Column: &column{Kind: "time"}},
+		{Name: "Scheme", AwsField: "Scheme", Column: &column{}},
+		{Name: "Id", AwsField: "LoadBalancerName"},
+		{Name: "AvailabilityZones", AwsField: "AvailabilityZones", Transform: "stringslice"},
+		{Name: "Subnets", AwsField: "Subnets", Transform: "stringslice"},
+		{Name: "SecurityGroups", AwsField: "SecurityGroups", Transform: "stringslice"},
+		{Name: "HealthCheck", AwsField: "HealthCheck", Transform: "field", TransformArg: "Target"},
+		{Name: "CanonicalHostedZoneId", AwsField: "CanonicalHostedZoneNameID"},
+	},
+	// access
+	graph.User.String(): {
+		{Name: "Id", AwsField: "UserId", Column: &column{}},
+		{Name: "Name", AwsField: "UserName", Column: &column{DisableTruncate: true}},
+		{Name: "PasswordLastUsedDate", AwsField: "PasswordLastUsed", Transform: "time", Column: &column{Kind: "time", Friendly: "PasswordLastUsed"}},
+		{Name: "CreateDate", AwsField: "CreateDate", Transform: "time", Column: &column{Kind: "time"}},
+		{Name: "Arn", AwsField: "Arn"},
+		{Name: "Path", AwsField: "Path"},
+		{Name: "InlinePolicies", AwsField: "UserPolicyList", Transform: "slicevalues", TransformArg: "PolicyName"},
+	},
+	graph.Group.String(): {
+		{Name: "Id", AwsField: "GroupId", Column: &column{}},
+		{Name: "Name", AwsField: "GroupName", Column: &column{DisableTruncate: true}},
+		{Name: "CreateDate", AwsField: "CreateDate", Transform: "time", Column: &column{Kind: "time"}},
+		{Name: "Arn", AwsField: "Arn"},
+		{Name: "Path", AwsField: "Path"},
+		{Name: "InlinePolicies", AwsField: "GroupPolicyList", Transform: "slicevalues", TransformArg: "PolicyName"},
+	},
+	graph.Role.String(): {
+		{Name: "Id", AwsField: "RoleId", Column: &column{}},
+		{Name: "Name", AwsField: "RoleName", Column: &column{DisableTruncate: true}},
+		{Name: "CreateDate", AwsField: "CreateDate", Transform: "time", Column: &column{Kind: "time"}},
+		{Name: "Arn", AwsField: "Arn"},
+		{Name: "Path", AwsField: "Path"},
+		{Name: "InlinePolicies", AwsField: "RolePolicyList", Transform: "slicevalues", TransformArg: "PolicyName"},
+	},
+	graph.Policy.String(): {
+		{Name: "Id", AwsField: "PolicyId", Column: &column{}},
+		{Name: "Name", AwsField: "PolicyName", Column: &column{DisableTruncate: true}},
+		{Name: "CreateDate", AwsField: "CreateDate", Transform: "time", Column: &column{Kind: "time"}},
+		{Name: "UpdateDate", AwsField: "UpdateDate", Transform: "time", Column: &column{Kind: "time"}},
+		{Name: "Arn", AwsField: "Arn"},
+		{Name: "Description", AwsField: "Description"},
+		{Name: "IsAttachable", AwsField: "IsAttachable"},
+		{Name: "Path", AwsField: "Path"},
+	},
+	// storage
+	graph.Bucket.String(): {
+		{Name: "Name", AwsField: "Name", Column: &column{DisableTruncate: true}},
+		{Name: "Grants", Fetch: "fetchAndExtractGrantsFn", Column: &column{Kind: "grants"}},
+		{Name: "Public", Fetch: "fetchBucketPublicFn", Column: &column{ColoredValues: map[string]string{"true": "FgRed"}}},
+		{Name: "Versioning", Fetch: "fetchBucketVersioningFn", Column: &column{}},
+		{Name: "Encryption", Fetch: "fetchBucketEncryptionFn", Column: &column{}},
+		{Name: "CreateDate", AwsField: "CreationDate", Transform: "time", Column: &column{Kind: "time"}},
+		{Name: "Id", AwsField: "Name"},
+		{Name: "Policy", Fetch: "fetchBucketPolicyFn"},
+		{Name: "Lifecycle", Fetch: "fetchBucketLifecycleFn"},
+		{Name: "PublicAccessBlock", Fetch: "fetchBucketPublicAccessBlockFn"},
+	},
+	graph.Object.String(): {
+		{Name: "Key", AwsField: "Key", Column: &column{TruncateRight: true}},
+		{Name: "BucketName", Column: &column{}},
+		{Name: "ModifiedDate", AwsField: "LastModified", Transform: "time", Column: &column{Kind: "time"}},
+		{Name: "OwnerId", AwsField: "Owner", Transform: "field", TransformArg: "ID", Column: &column{TruncateRight: true}},
+		{Name: "Size", AwsField: "Size", Column: &column{}},
+		{Name: "Class", AwsField: "StorageClass", Column: &column{}},
+		{Name: "Id", AwsField: "Key"},
+		{Name: "ETag", AwsField: "ETag", Transform: "custom", TransformArg: "extractETagFn"},
+	},
+	// notification
+	graph.Subscription.String(): {
+		{Name: "SubscriptionArn", AwsField: "SubscriptionArn", Column: &column{}},
+		{Name: "TopicArn", AwsField: "TopicArn", Column: &column{}},
+		{Name: "Endpoint", AwsField: "Endpoint", Column: &column{DisableTruncate: true}},
+		{Name: "Protocol", AwsField: "Protocol", Column: &column{}},
+		{Name: "Owner", AwsField: "Owner", Column: &column{}},
+		{Name: "Id", AwsField: "Endpoint"},
+	},
+	graph.Topic.String(): {
+		{Name: "TopicArn", AwsField: "TopicArn", Column: &column{DisableTruncate: true}},
+		{Name: "Id", AwsField: "TopicArn"},
+	},
+	// queue
+	graph.Queue.String(): {
+		{Name: "Id", Column: &column{Friendly: "URL", DisableTruncate: true}},
+		{Name: "ApproximateNumberOfMessages", Column: &column{Friendly: "~NbMsg"}},
+		{Name: "CreatedTimestamp", Column: &column{Kind: "time", Friendly: "Created"}},
+		{Name: "LastModifiedTimestamp", Column: &column{Kind: "time", Friendly: "LastModif"}},
+		{Name: "DelaySeconds", Column: &column{Friendly: "Delay(s)"}},
+	},
+	// database
+	graph.Database.String(): {
+		{Name: "Id", AwsField: "DBInstanceIdentifier", Column: &column{}},
+		{Name: "State", AwsField: "DBInstanceStatus", Column: &column{ColoredValues: map[string]string{"available": "FgGreen", "stopped": "FgRed", "failed": "FgRed"}}},
+		{Name: "Class", AwsField: "DBInstanceClass", Column: &column{}},
+		{Name: "Engine", AwsField: "Engine", Column: &column{}},
+		{Name: "EngineVersion", AwsField: "EngineVersion", Column: &column{Friendly: "Version"}},
+		{Name: "Storage", AwsField: "AllocatedStorage", Column: &column{Friendly: "Storage(GiB)"}},
+		{Name: "Address", AwsField: "Endpoint", Transform: "field", TransformArg: "Address", Column: &column{DisableTruncate: true}},
+		{Name: "Port", AwsField: "Endpoint", Transform: "field", TransformArg: "Port", Column: &column{}},
+		{Name: "VpcId", AwsField: "DBSubnetGroup", Transform: "field", TransformArg: "VpcId", Column: &column{}},
+		{Name: "CreateTime", AwsField: "InstanceCreateTime", Transform: "time", Column: &column{Kind: "time", Friendly: "Created"}},
+		{Name: "Name", AwsField: "DBInstanceIdentifier"},
+		{Name: "Arn", AwsField: "DBInstanceArn"},
+		{Name: "DBName", AwsField: "DBName"},
+		{Name: "Username", AwsField: "MasterUsername"},
+		{Name: "StorageType", AwsField: "StorageType"},
+		{Name: "Encrypted", AwsField: "StorageEncrypted"},
+		{Name: "Key", AwsField: "KmsKeyId"},
+		{Name: "AvailabilityZone", AwsField: "AvailabilityZone"},
+		{Name: "MultiAZ", AwsField: "MultiAZ"},
+		{Name: "Public", AwsField: "PubliclyAccessible"},
+		{Name: "BackupRetention", AwsField: "BackupRetentionPeriod"},
+		{Name: "SubnetGroup", AwsField: "DBSubnetGroup", Transform: "field", TransformArg: "DBSubnetGroupName"},
+		{Name: "SecurityGroups", AwsField: "VpcSecurityGroups", Transform: "slicevalues", TransformArg: "VpcSecurityGroupId"},
+		{Name: "ParameterGroups", AwsField: "DBParameterGroups", Transform: "slicevalues", TransformArg: "DBParameterGroupName"},
+		{Name: "MaintenanceWindow", AwsField: "PreferredMaintenanceWindow"},
+	},
+	graph.DbSubnetGroup.String(): {
+		{Name: "Name", AwsField: "DBSubnetGroupName", Column: &column{}},
+		{Name: "VpcId", AwsField: "VpcId", Column: &column{}},
+		{Name: "State", AwsField: "SubnetGroupStatus", Column: &column{}},
+		{Name: "Subnets", AwsField: "Subnets", Transform: "slicevalues", TransformArg: "SubnetIdentifier", Column: &column{}},
+		{Name: "Description", AwsField: "DBSubnetGroupDescription", Column: &column{TruncateRight: true}},
+		{Name: "Id", AwsField: "DBSubnetGroupName"},
+		{Name: "Arn", AwsField: "DBSubnetGroupArn"},
+	},
+	graph.DbParameterGroup.String(): {
+		{Name: "Name", AwsField: "DBParameterGroupName", Column: &column{}},
+		{Name: "Family", AwsField: "DBParameterGroupFamily", Column: &column{}},
+		{Name: "Description", AwsField: "Description", Column: &column{TruncateRight: true}},
+		{Name: "Id", AwsField: "DBParameterGroupName"},
+		{Name: "Arn", AwsField: "DBParameterGroupArn"},
+	},
+	// lambda
+	graph.Function.String(): {
+		{Name: "Name", AwsField: "FunctionName", Column: &column{}},
+		{Name: "Runtime", AwsField: "Runtime", Column: &column{}},
+		{Name: "Handler", AwsField: "Handler", Column: &column{}},
+		{Name: "Memory", AwsField: "MemorySize", Column: &column{Friendly: "Memory(MB)"}},
+		{Name: "Timeout", AwsField: "Timeout", Column: &column{Friendly: "Timeout(s)"}},
+		{Name: "Size", AwsField: "CodeSize", Column: &column{Friendly: "Size(B)"}},
+		{Name: "VpcId", AwsField: "VpcConfig", Transform: "field", TransformArg: "VpcId", Column: &column{}},
+		{Name: "Modified", AwsField: "LastModified", Transform: "stringtime", TransformArg: "lambdaTimeLayout", Column: &column{Kind: "time"}},
+		{Name: "Id", AwsField: "FunctionArn"},
+		{Name: "Arn", AwsField: "FunctionArn"},
+		{Name: "Role", AwsField: "Role"},
+		{Name: "Description", AwsField: "Description"},
+		{Name: "Hash", AwsField: "CodeSha256"},
+		{Name: "Version", AwsField: "Version"},
+		{Name: "Subnets", AwsField: "VpcConfig", Transform: "stringslicefield", TransformArg: "SubnetIds"},
+		{Name: "SecurityGroups", AwsField: "VpcConfig", Transform: "stringslicefield", TransformArg: "SecurityGroupIds"},
+	},
+	// dns
+	graph.Zone.String(): {
+		{Name: "Id", AwsField: "Id", Column: &column{}},
+		{Name: "Name", AwsField: "Name", Column: &column{}},
+		{Name: "Comment", AwsField: "Config", Transform: "field", TransformArg: "Comment", Column: &column{}},
+		{Name: "Private", AwsField: "Config", Transform: "field", TransformArg: "PrivateZone", Column: &column{}},
+		{Name: "RecordCount", AwsField: "ResourceRecordSetCount", Column: &column{Friendly: "Records"}},
+		{Name: "CallerReference", AwsField: "CallerReference"},
+	},
+	graph.Record.String(): {
+		{Name: "Name", AwsField: "Name", Column: &column{}},
+		{Name: "Type", AwsField: "Type", Column: &column{}},
+		{Name: "TTL", AwsField: "TTL", Column: &column{}},
+		{Name: "Records", AwsField: "ResourceRecords", Transform: "slicevalues", TransformArg: "Value", Column: &column{DisableTruncate: true}},
+		{Name: "Alias", AwsField: "AliasTarget", Transform: "field", TransformArg: "DNSName", Column: &column{}},
+		{Name: "Zone", Column: &column{}},
+		{Name: "SetIdentifier", AwsField: "SetIdentifier"},
+		{Name: "Weight", AwsField: "Weight"},
+		{Name: "Region", AwsField: "Region"},
+		{Name: "Failover", AwsField: "Failover"},
+		{Name: "HealthCheckId", AwsField: "HealthCheckId"},
+	},
+	// autoscaling
+	graph.LaunchConfiguration.String(): {
+		{Name: "Name", AwsField: "LaunchConfigurationName", Column: &column{}},
+		{Name: "Type", AwsField: "InstanceType", Column: &column{}},
+		{Name: "ImageId", AwsField: "ImageId", Column: &column{}},
+		{Name: "KeyName", AwsField: "KeyName", Column: &column{}},
+		{Name: "SecurityGroups", AwsField: "SecurityGroups", Transform: "stringslice", Column: &column{}},
+		{Name: "CreateTime", AwsField: "CreatedTime", Transform: "time", Column: &column{Kind: "time", Friendly: "Created"}},
+		{Name: "Id", AwsField: "LaunchConfigurationName"},
+		{Name: "Arn", AwsField: "LaunchConfigurationARN"},
+		{Name: "Profile", AwsField: "IamInstanceProfile"},
+		{Name: "SpotPrice", AwsField: "SpotPrice"},
+		{Name: "PublicIp", AwsField: "AssociatePublicIpAddress"},
+	},
+	graph.ScalingGroup.String(): {
+		{Name: "Name", AwsField: "AutoScalingGroupName", Column: &column{}},
+		{Name: "LaunchConfigurationName", AwsField: "LaunchConfigurationName", Column: &column{Friendly: "LaunchConfig"}},
+		{Name: "MinSize", AwsField: "MinSize", Column: &column{Friendly: "Min"}},
+		{Name: "DesiredCapacity", AwsField: "DesiredCapacity", Column: &column{Friendly: "Desired"}},
+		{Name: "MaxSize", AwsField: "MaxSize", Column: &column{Friendly: "Max"}},
+		{Name: "Instances", AwsField: "Instances", Transform: "slicevalues", TransformArg: "InstanceId", Column: &column{}},
+		{Name: "Subnets", AwsField: "VPCZoneIdentifier", Transform: "commaseparated", Column: &column{}},
+		{Name: "CreateTime", AwsField: "CreatedTime", Transform: "time", Column: &column{Kind: "time", Friendly: "Created"}},
+		{Name: "Id", AwsField: "AutoScalingGroupName"},
+		{Name: "Arn", AwsField: "AutoScalingGroupARN"},
+		{Name: "DefaultCooldown", AwsField: "DefaultCooldown"},
+		{Name: "HealthCheckType", AwsField: "HealthCheckType"},
+		{Name: "HealthCheckGracePeriod", AwsField: "HealthCheckGracePeriod"},
+		{Name: "State", AwsField: "Status"},
+		{Name: "AvailabilityZones", AwsField: "AvailabilityZones", Transform: "stringslice"},
+		{Name: "TargetGroups", AwsField: "TargetGroupARNs", Transform: "stringslice"},
+	},
+	// monitoring
+	graph.Alarm.String(): {
+		{Name: "Name", AwsField: "AlarmName", Column: &column{}},
+		{Name: "State", AwsField: "StateValue", Column: &column{ColoredValues: map[string]string{"OK": "FgGreen", "ALARM": "FgRed"}}},
+		{Name: "Namespace", AwsField: "Namespace", Column: &column{}},
+		{Name: "MetricName", AwsField: "MetricName", Column: &column{Friendly: "Metric"}},
+		{Name: "Operator", AwsField: "ComparisonOperator", Column: &column{}},
+		{Name: "Threshold", AwsField: "Threshold", Column: &column{}},
+		{Name: "Dimensions", AwsField: "Dimensions", Transform: "custom", TransformArg: "extractAlarmDimensionsFn", Column: &column{}},
+		{Name: "StateUpdated", AwsField: "StateUpdatedTimestamp", Transform: "time", Column: &column{Kind: "time", Friendly: "Updated"}},
+		{Name: "Id", AwsField: "AlarmName"},
+		{Name: "Arn", AwsField: "AlarmArn"},
+		{Name: "Description", AwsField: "AlarmDescription"},
+		{Name: "StateReason", AwsField: "StateReason"},
+		{Name: "Statistic", AwsField: "Statistic"},
+		{Name: "Period", AwsField: "Period"},
+		{Name: "EvaluationPeriods", AwsField: "EvaluationPeriods"},
+		{Name: "Unit", AwsField: "Unit"},
+		{Name: "ActionsEnabled", AwsField: "ActionsEnabled"},
+		{Name: "AlarmActions", AwsField: "AlarmActions", Transform: "stringslice"},
+		{Name: "OKActions", AwsField: "OKActions", Transform: "stringslice"},
+		{Name: "InsufficientDataActions", AwsField: "InsufficientDataActions", Transform: "stringslice"},
+		{Name: "UpdateTime", AwsField: "AlarmConfigurationUpdatedTimestamp", Transform: "time"},
+	},
+	// stack
+	graph.Stack.String(): {
+		{Name: "Name", AwsField: "StackName", Column: &column{}},
+		{Name: "State", AwsField: "StackStatus", Column: &column{ColoredValues: map[string]string{"CREATE_COMPLETE": "FgGreen", "UPDATE_COMPLETE": "FgGreen", "ROLLBACK_COMPLETE": "FgRed", "UPDATE_ROLLBACK_COMPLETE": "FgRed", "CREATE_FAILED": "FgRed", "DELETE_FAILED": "FgRed", "ROLLBACK_FAILED": "FgRed", "UPDATE_ROLLBACK_FAILED": "FgRed"}}},
+		{Name: "Parameters", AwsField: "Parameters", Transform: "custom", TransformArg: "extractStackParametersFn", Column: &column{}},
+		{Name: "Outputs", AwsField: "Outputs", Transform: "custom", TransformArg: "extractStackOutputsFn", Column: &column{}},
+		{Name: "CreateTime", AwsField: "CreationTime", Transform: "time", Column: &column{Kind: "time", Friendly: "Created"}},
+		{Name: "UpdateTime", AwsField: "LastUpdatedTime", Transform: "time", Column: &column{Kind: "time", Friendly: "Updated"}},
+		{Name: "Id", AwsField: "StackId"},
+		{Name: "Description", AwsField: "Description"},
+		{Name: "StateReason", AwsField: "StackStatusReason"},
+		{Name: "Capabilities", AwsField: "Capabilities", Transform: "stringslice"},
+		{Name: "Notifications", AwsField: "NotificationARNs", Transform: "stringslice"},
+		{Name: "Role", AwsField: "RoleARN"},
+		{Name: "DisableRollback", AwsField: "DisableRollback"},
+		{Name: "Timeout", AwsField: "TimeoutInMinutes"},
+		{Name: "Resources", Fetch: "fetchStackResourcesFn"},
+	},
+	// container
+	graph.Cluster.String(): {
+		{Name: "Name", AwsField: "ClusterName", Column: &column{}},
+		{Name: "State", AwsField: "Status", Column: &column{ColoredValues: map[string]string{"ACTIVE": "FgGreen", "INACTIVE": "FgRed"}}},
+		{Name: "ActiveServicesCount", AwsField: "ActiveServicesCount", Column: &column{Friendly: "Services"}},
+		{Name: "RunningTasksCount", AwsField: "RunningTasksCount", Column: &column{Friendly: "Running"}},
+		{Name: "PendingTasksCount", AwsField: "PendingTasksCount", Column: &column{Friendly: "Pending"}},
+		{Name: "ContainerInstancesCount", AwsField: "RegisteredContainerInstancesCount", Column: &column{Friendly: "Instances"}},
+		{Name: "Id", AwsField: "ClusterArn"},
+		{Name: "Arn", AwsField: "ClusterArn"},
+	},
+	graph.ContainerService.String(): {
+		{Name: "Name", AwsField: "ServiceName", Column: &column{}},
+		{Name: "Cluster", AwsField: "ClusterArn", Column: &column{}},
+		{Name: "State", AwsField: "Status", Column: &column{ColoredValues: map[string]string{"ACTIVE": "FgGreen", "DRAINING": "FgYellow", "INACTIVE": "FgRed"}}},
+		{Name: "TaskDefinition", AwsField: "TaskDefinition", Column: &column{}},
+		{Name: "DesiredCount", AwsField: "DesiredCount", Column: &column{Friendly: "Desired"}},
+		{Name: "RunningCount", AwsField: "RunningCount", Column: &column{Friendly: "Running"}},
+		{Name: "PendingCount", AwsField: "PendingCount", Column: &column{Friendly: "Pending"}},
+		{Name: "CreateTime", AwsField: "CreatedAt", Transform: "time", Column: &column{Kind: "time", Friendly: "Created"}},
+		{Name: "Id", AwsField: "ServiceArn"},
+		{Name: "Arn", AwsField: "ServiceArn"},
+		{Name: "Role", AwsField: "RoleArn"},
+		{Name: "TargetGroups", AwsField: "LoadBalancers", Transform: "slicevalues", TransformArg: "TargetGroupArn"},
+		{Name: "DeploymentMaxPercent", AwsField: "DeploymentConfiguration", Transform: "field", TransformArg: "MaximumPercent"},
+		{Name: "DeploymentMinPercent", AwsField: "DeploymentConfiguration", Transform: "field", TransformArg: "MinimumHealthyPercent"},
+	},
+	graph.TaskDefinition.String(): {
+		{Name: "Name", AwsField: "Family", Column: &column{}},
+		{Name: "Revision", AwsField: "Revision", Column: &column{}},
+		{Name: "State", AwsField: "Status", Column: &column{}},
+		{Name: "Containers", AwsField: "ContainerDefinitions", Transform: "slicevalues", TransformArg: "Name", Column: &column{}},
+		{Name: "Images", AwsField: "ContainerDefinitions", Transform: "slicevalues", TransformArg: "Image", Column: &column{}},
+		{Name: "Role", AwsField: "TaskRoleArn", Column: &column{}},
+		{Name: "Id", AwsField: "TaskDefinitionArn"},
+		{Name: "Arn", AwsField: "TaskDefinitionArn"},
+		{Name: "NetworkMode", AwsField: "NetworkMode"},
+	},
+	graph.Task.String(): {
+		{Name: "Id", AwsField: "TaskArn", Column: &column{}},
+		{Name: "Cluster", AwsField: "ClusterArn", Column: &column{}},
+		{Name: "TaskDefinition", AwsField: "TaskDefinitionArn", Column: &column{}},
+		{Name: "State", AwsField: "LastStatus", Column: &column{ColoredValues: map[string]string{"RUNNING": "FgGreen", "PENDING": "FgYellow", "STOPPED": "FgRed"}}},
+		{Name: "Group", AwsField: "Group", Column: &column{}},
+		{Name: "ContainerInstance", AwsField: "ContainerInstanceArn", Column: &column{}},
+		{Name: "LaunchTime", AwsField: "StartedAt", Transform: "time", Column: &column{Kind: "time", Friendly: "Started"}},
+		{Name: "Arn", AwsField: "TaskArn"},
+		{Name: "DesiredState", AwsField: "DesiredStatus"},
+		{Name: "StateReason", AwsField: "StoppedReason"},
+		{Name: "StartedBy", AwsField: "StartedBy"},
+		{Name: "CreateTime", AwsField: "CreatedAt", Transform: "time"},
+		{Name: "StopTime", AwsField: "StoppedAt", Transform: "time"},
+	},
+	graph.ContainerInstance.String(): {
+		{Name: "Id", AwsField: "ContainerInstanceArn", Column: &column{}},
+		{Name: "Cluster", Column: &column{}},
+		{Name: "InstanceId", AwsField: "Ec2InstanceId", Column: &column{Friendly: "Instance"}},
+		{Name: "State", AwsField: "Status", Column: &column{ColoredValues: map[string]string{"ACTIVE": "FgGreen", "DRAINING": "FgYellow", "INACTIVE": "FgRed"}}},
+		{Name: "AgentConnected", AwsField: "AgentConnected", Column: &column{Friendly: "Agent"}},
+		{Name: "RunningTasksCount", AwsField: "RunningTasksCount", Column: &column{Friendly: "Running"}},
+		{Name: "PendingTasksCount", AwsField: "PendingTasksCount", Column: &column{Friendly: "Pending"}},
+		{Name: "Arn", AwsField: "ContainerInstanceArn"},
+		{Name: "AgentVersion", AwsField: "VersionInfo", Transform: "field", TransformArg: "AgentVersion"},
+	},
+	// nosql
+	graph.Table.String(): {
+		{Name: "Name", AwsField: "TableName", Column: &column{}},
+		{Name: "State", AwsField: "TableStatus", Column: &column{ColoredValues: map[string]string{"ACTIVE": "FgGreen", "CREATING": "FgYellow", "UPDATING": "FgYellow", "DELETING": "FgRed"}}},
+		{Name: "KeySchema", AwsField: "KeySchema", Transform: "custom", TransformArg: "extractTableKeySchemaFn", Column: &column{Friendly: "Keys"}},
+		{Name: "ReadCapacity", AwsField: "ProvisionedThroughput", Transform: "field", TransformArg: "ReadCapacityUnits", Column: &column{Friendly: "Read"}},
+		{Name: "WriteCapacity", AwsField: "ProvisionedThroughput", Transform: "field", TransformArg: "WriteCapacityUnits", Column: &column{Friendly: "Write"}},
+		{Name: "ItemCount", AwsField: "ItemCount", Column: &column{Friendly: "Items"}},
+		{Name: "Size", AwsField: "TableSizeBytes", Column: &column{Friendly: "Size(B)"}},
+		{Name: "CreateTime", AwsField: "CreationDateTime", Transform: "time", Column: &column{Kind: "time", Friendly: "Created"}},
+		{Name: "Id", AwsField: "TableName"},
+		{Name: "Arn", AwsField: "TableArn"},
+		{Name: "StreamArn", AwsField: "LatestStreamArn"},
+		{Name: "StreamView", AwsField: "StreamSpecification", Transform: "field", TransformArg: "StreamViewType"},
+	},
+	// encryption
+	graph.Key.String(): {
+		{Name: "Id", AwsField: "KeyId", Column: &column{}},
+		{Name: "Description", AwsField: "Description", Column: &column{}},
+		{Name: "State", AwsField: "KeyState", Column: &column{ColoredValues: map[string]string{"Enabled": "FgGreen", "Disabled": "FgYellow", "PendingImport": "FgYellow", "PendingDeletion": "FgRed"}}},
+		{Name: "Usage", AwsField: "KeyUsage", Column: &column{}},
+		{Name: "Origin", AwsField: "Origin", Column: &column{}},
+		{Name: "CreateTime", AwsField: "CreationDate", Transform: "time", Column: &column{Kind: "time", Friendly: "Created"}},
+		{Name: "DeletionTime", AwsField: "DeletionDate", Transform: "time", Column: &column{Kind: "time", Friendly: "Deletion"}},
+		{Name: "Arn", AwsField: "Arn"},
+		{Name: "Enabled", AwsField: "Enabled"},
+	},
+	graph.KeyAlias.String(): {
+		{Name: "Name", AwsField: "AliasName", Column: &column{}},
+		{Name: "Key", AwsField: "TargetKeyId", Column: &column{}},
+		{Name: "Id", AwsField: "AliasName"},
+		{Name: "Arn", AwsField: "AliasArn"},
+	},
+}
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/graph"
+)
+
+// mergedAWSTypes are the other API types merged into the resources of a fetcher
+var mergedAWSTypes = map[string][]string{
+	graph.User.String(): {"User"},
+}
+
+// renamedShapes are the shapes of the API types renamed by the SDK
+var renamedShapes = map[string]string{
+	"autoscaling.Group": "AutoScalingGroup",
+}
+
+func TestPropertiesDefinitionsMatchFetchers(t *testing.T) {
+	fetched := make(map[string]bool)
+	for _, service := range FetchersDefs {
+		for _, f := range service.Fetchers {
+			fetched[f.ResourceType] = true
+			if len(f.Properties()) == 0 {
+				t.Fatalf("%s: no properties", f.ResourceType)
+			}
+			names := make(map[string]bool)
+			for _, p := range f.Properties() {
+				if names[p.Name] {
+					t.Fatalf("%s: duplicated property %s", f.ResourceType, p.Name)
+				}
+				names[p.Name] = true
+				if p.AwsField == "" && p.Fetch == "" {
+					continue
+				}
+				if _, err := p.TransformFn(); err != nil {
+					t.Fatalf("%s: %s", f.ResourceType, err)
+				}
+			}
+		}
+	}
+	for resType, props := range propertiesDefs {
+		if !fetched[resType] {
+			t.Fatalf("%s: properties without fetcher", resType)
+		}
+		for _, p := range props {
+			if p.Column == nil {
+				continue
+			}
+			if _, err := p.Column.Definition(p.Name); err != nil {
+				t.Fatalf("%s %s: %s", resType, p.Name, err)
+			}
+		}
+	}
+}
+
+func TestPropertiesDefinitionsMatchAPIModels(t *testing.T) {
+	dir := filepath.Join("..", "..", "vendor", "github.com", "aws", "aws-sdk-go", "models", "apis")
+	for _, service := range FetchersDefs {
+		for _, f := range service.Fetchers {
+			model, err := loadAPIModel(dir, f.Api)
+			if err != nil {
+				t.Fatal(err)
+			}
+			name := strings.TrimPrefix(f.AWSType, f.Api+".")
+			if renamed, ok := renamedShapes[f.AWSType]; ok {
+				name = renamed
+			}
+			var shapes []*shape
+			for _, name := range append([]string{name}, mergedAWSTypes[f.ResourceType]...) {
+				if sh, ok := model.Shapes[name]; ok && sh.Type == "structure" {
+					shapes = append(shapes, sh)
+				}
+			}
+			if len(shapes) == 0 {
+				// resources without an API type of their own (ex: queues given by their URL)
+				continue
+			}
+			for _, p := range f.Properties() {
+				if p.AwsField == "" {
+					continue
+				}
+				var field *shape
+				for _, sh := range shapes {
+					if field, err = model.fieldShape(sh, p.AwsField); err == nil {
+						break
+					}
+				}
+				if err != nil {
+					t.Fatalf("%s %s: %s", f.ResourceType, p.Name, err)
+				}
+				switch p.Transform {
+				case "field", "slicevalues", "anytrue", "stringslicefield":
+					if field.Member != nil {
+						field = model.Shapes[field.Member.Shape]
+					}
+					if _, err := model.fieldShape(field, p.TransformArg); err != nil {
+						t.Fatalf("%s %s: %s", f.ResourceType, p.Name, err)
+					}
+				}
+			}
+		}
+	}
+}
@@ -50,6 +50,46 @@ func (g *Graph) AddResource(resources ...*Resource) error {
 	return nil
 }
 
+// DeleteResource removes a resource with all its properties and relations
+func (g *Graph) DeleteResource(res *Resource) error {
+	n, err := res.toRDFNode()
+	if err != nil {
+		return err
+	}
+
+	triples, err := g.rdfG.TriplesForSubjectOnly(n)
+	if err != nil {
+		return err
+	}
+	g.rdfG.Remove(triples...)
+
+	triples, err = g.rdfG.TriplesForObjectOnly(triple.NewNodeObject(n))
+	if err != nil {
+		return err
+	}
+	g.rdfG.Remove(triples...)
+
+	return nil
+}
+
+// UpdateResource replaces the properties and meta of a resource, keeping its relations
+func (g *Graph) UpdateResource(res *Resource) error {
+	n, err := res.toRDFNode()
+	if err != nil {
+		return err
+	}
+
+	for _, pred := range []*predicate.Predicate{rdf.PropertyPredicate, rdf.MetaPredicate} {
+		triples, err := g.rdfG.TriplesForSubjectPredicate(n, pred)
+		if err != nil {
+			return err
+		}
+		g.rdfG.Remove(triples...)
+	}
+
+	return g.AddResource(res)
+}
+
 func (g *Graph) AddGraph(gph *Graph) {
 	g.rdfG.AddGraph(gph.rdfG)
 }
@@ -62,6 +102,14 @@ func (g *Graph) AddAppliesOnRelation(parent, child *Resource) error {
 	return g.addRelation(parent, child, rdf.AppliesOnPredicate)
 }
 
+func (g *Graph) RemoveParentRelation(parent, child *Resource) error {
+	return g.removeRelation(parent, child, rdf.ParentOfPredicate)
+}
+
+func (g *Graph) RemoveAppliesOnRelation(parent, child *Resource) error {
+	return g.removeRelation(parent, child, rdf.AppliesOnPredicate)
+}
+
 func (g *Graph) GetResource(t ResourceType, id string) (*Resource, error) {
 	resource := InitResource(id, t)
 
@@ -269,22 +317,37 @@ func (g *Graph) Marshal() ([]byte, error) {
 }
 
 func (g *Graph) addRelation(one, other *Resource, pred *predicate.Predicate) error {
-	n, err := other.toRDFNode()
+	t, err := relationTriple(one, other, pred)
 	if err != nil {
 		return err
 	}
 
+	g.rdfG.Add(t)
+
+	return nil
+}
+
+func relationTriple(one, other *Resource, pred *predicate.Predicate) (*triple.Triple, error) {
+	n, err := other.toRDFNode()
+	if err != nil {
+		return nil, err
+	}
+
 	oneN, err := node.NewNodeFromStrings(one.Type().ToRDFString(), one.Id())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	t, err := triple.New(oneN, pred, triple.NewNodeObject(n))
+	return triple.New(oneN, pred, triple.NewNodeObject(n))
+}
+
+func (g *Graph) removeRelation(one, other *Resource, pred *predicate.Predicate) error {
+	t, err := relationTriple(one, other, pred)
 	if err != nil {
 		return err
 	}
 
-	g.rdfG.Add(t)
+	g.rdfG.Remove(t)
 
 	return nil
 }
@@ -63,6 +63,68 @@ func TestAddGraphRelation(t *testing.T) {
 	})
 }
 
+func TestRemoveGraphRelation(t *testing.T) {
+	g := NewGraph()
+	g.Unmarshal([]byte(`/instance<inst_1>  "has_type"@[] "/instance"^^type:text
+/subnet<subnet_1>  "has_type"@[] "/subnet"^^type:text
+/subnet<subnet_1>	"parent_of"@[]	/instance<inst_1>
+/volume<vol_1>	"applies_on"@[]	/instance<inst_1>`))
+
+	g.RemoveAppliesOnRelation(InitResource("vol_1", Volume), InitResource("inst_1", Instance))
+	exp := `/instance<inst_1>	"has_type"@[]	"/instance"^^type:text
+/subnet<subnet_1>	"has_type"@[]	"/subnet"^^type:text
+/subnet<subnet_1>	"parent_of"@[]	/instance<inst_1>`
+	if got, want := g.MustMarshal(), exp; got != want {
+		t.Fatalf("got\n%q\nwant\n%q\n", got, want)
+	}
+
+	g.RemoveParentRelation(InitResource("subnet_1", Subnet), InitResource("inst_1", Instance))
+	exp = `/instance<inst_1>	"has_type"@[]	"/instance"^^type:text
+/subnet<subnet_1>	"has_type"@[]	"/subnet"^^type:text`
+	if got, want := g.MustMarshal(), exp; got != want {
+		t.Fatalf("got\n%q\nwant\n%q\n", got, want)
+	}
+}
+
+func TestDeleteAndUpdateResource(t *testing.T) {
+	g := NewGraph()
+	g.Unmarshal([]byte(`/instance<inst_1>  "has_type"@[] "/instance"^^type:text
+/instance<inst_1>  "property"@[] "{"Key":"Id","Value":"inst_1"}"^^type:text
+/instance<inst_1>  "property"@[] "{"Key":"State","Value":"running"}"^^type:text
+/subnet<subnet_1>  "has_type"@[] "/subnet"^^type:text
+/subnet<subnet_1>	"parent_of"@[]	/instance<inst_1>
+/instance<inst_2>  "has_type"@[] "/instance"^^type:text
+/subnet<subnet_1>	"parent_of"@[]	/instance<inst_2>`))
+
+	res, err := g.GetResource(Instance, "inst_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Properties["State"] = "stopped"
+	if err = g.UpdateResource(res); err != nil {
+		t.Fatal(err)
+	}
+	if res, err = g.GetResource(Instance, "inst_1"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res.Properties["State"], "stopped"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := len(res.Properties), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	if err = g.DeleteResource(res); err != nil {
+		t.Fatal(err)
+	}
+	exp := `/instance<inst_2>	"has_type"@[]	"/instance"^^type:text
+/subnet<subnet_1>	"has_type"@[]	"/subnet"^^type:text
+/subnet<subnet_1>	"parent_of"@[]	/instance<inst_2>`
+	if got, want := g.MustMarshal(), exp; got != want {
+		t.Fatalf("got\n%q\nwant\n%q\n", got, want)
+	}
+}
+
 func TestGetResource(t *testing.T) {
 	g := NewGraph()
 
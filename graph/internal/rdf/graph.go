@@ -80,6 +80,15 @@ func (g *Graph) Add(triples ...*triple.Triple) {
 	_ = g.AddTriples(context.Background(), triples) // badwolf mem store implementation always returns nil error
 }
 
+func (g *Graph) Remove(triples ...*triple.Triple) {
+	for _, t := range triples {
+		if g.HasTriple(t) && g.size() > 0 {
+			atomic.AddUint32(&g.triplesCount, ^uint32(0))
+		}
+	}
+	_ = g.RemoveTriples(context.Background(), triples) // badwolf mem store implementation always returns nil error
+}
+
 func (g *Graph) AddGraph(graph *Graph) {
 	all, _ := graph.allTriples()
 	g.Add(all...)
@@ -34,17 +34,23 @@ const (
 	Instance         ResourceType = "instance"
 	InternetGateway  ResourceType = "internetgateway"
 	RouteTable       ResourceType = "routetable"
+	NatGateway       ResourceType = "natgateway"
+	ElasticIP        ResourceType = "elasticip"
+	Snapshot         ResourceType = "snapshot"
 
 	//loadbalancer
-	LoadBalancer ResourceType = "loadbalancer"
-	TargetGroup  ResourceType = "targetgroup"
-	Listener     ResourceType = "listener"
+	LoadBalancer        ResourceType = "loadbalancer"
+	ClassicLoadBalancer ResourceType = "classicloadbalancer"
+	TargetGroup         ResourceType = "targetgroup"
+	Listener            ResourceType = "listener"
 
 	//access
-	User   ResourceType = "user"
-	Role   ResourceType = "role"
-	Group  ResourceType = "group"
-	Policy ResourceType = "policy"
+	User            ResourceType = "user"
+	Role            ResourceType = "role"
+	Group           ResourceType = "group"
+	Policy          ResourceType = "policy"
+	InstanceProfile ResourceType = "instanceprofile"
+	AccessKey       ResourceType = "accesskey"
 
 	//storage
 	Bucket ResourceType = "bucket"
@@ -57,6 +63,42 @@ const (
 
 	//queue
 	Queue ResourceType = "queue"
+
+	//database
+	Database         ResourceType = "database"
+	DbSubnetGroup    ResourceType = "dbsubnetgroup"
+	DbParameterGroup ResourceType = "dbparametergroup"
+
+	//lambda
+	Function ResourceType = "function"
+
+	//dns
+	Zone   ResourceType = "zone"
+	Record ResourceType = "record"
+
+	//autoscaling
+	ScalingGroup        ResourceType = "scalinggroup"
+	LaunchConfiguration ResourceType = "launchconfiguration"
+
+	//monitoring
+	Alarm ResourceType = "alarm"
+
+	//stack
+	Stack ResourceType = "stack"
+
+	//container
+	Cluster           ResourceType = "cluster"
+	ContainerService  ResourceType = "service"
+	TaskDefinition    ResourceType = "taskdefinition"
+	Task              ResourceType = "task"
+	ContainerInstance ResourceType = "containerinstance"
+
+	//nosql
+	Table ResourceType = "table"
+
+	//encryption
+	Key      ResourceType = "key"
+	KeyAlias ResourceType = "keyalias"
 )
 
 type FirewallRule struct {
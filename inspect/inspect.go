@@ -27,7 +27,7 @@ var InspectorsRegister map[string]Inspector
 
 func init() {
 	all := []Inspector{
-		&inspectors.Pricer{}, &inspectors.BucketSizer{},
+		&inspectors.Pricer{}, &inspectors.BucketSizer{}, &inspectors.NoAlarm{},
 	}
 
 	InspectorsRegister = make(map[string]Inspector)
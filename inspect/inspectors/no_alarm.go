@@ -0,0 +1,103 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectors
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/wallix/awless/graph"
+)
+
+// NoAlarm lists the instances, load balancers and queues on which no alarm applies
+type NoAlarm struct {
+	resources []*graph.Resource
+}
+
+func (*NoAlarm) Name() string {
+	return "no_alarm"
+}
+
+func (*NoAlarm) Services() []string {
+	return []string{"infra", "queue", "monitoring"}
+}
+
+func (i *NoAlarm) Inspect(graphs ...*graph.Graph) error {
+	if len(graphs) == 0 {
+		return errors.New("no graph provided for")
+	}
+
+	// relations from alarms span services
+	g := graph.NewGraph()
+	for _, gph := range graphs {
+		g.AddGraph(gph)
+	}
+
+	alarms, err := g.GetAllResources(graph.Alarm)
+	if err != nil {
+		return err
+	}
+
+	watched := make(map[string]bool)
+	for _, alarm := range alarms {
+		resources, err := g.ListResourcesAppliedOn(alarm)
+		if err != nil {
+			return err
+		}
+		for _, res := range resources {
+			watched[res.Id()] = true
+		}
+	}
+
+	i.resources = nil
+	for _, typ := range []graph.ResourceType{graph.Instance, graph.LoadBalancer, graph.Queue} {
+		resources, err := g.GetAllResources(typ)
+		if err != nil {
+			return err
+		}
+		sort.Sort(graph.ResourceById(resources))
+		for _, res := range resources {
+			if !watched[res.Id()] {
+				i.resources = append(i.resources, res)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (i *NoAlarm) Print(w io.Writer) {
+	if len(i.resources) == 0 {
+		fmt.Fprintln(w, "All instances, load balancers and queues have an alarm")
+		return
+	}
+
+	tabw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
+
+	fmt.Fprintln(tabw, "Type\tId\tName\t")
+	fmt.Fprintln(tabw, "--------\t----------\t----------\t")
+
+	for _, res := range i.resources {
+		name, _ := res.Properties["Name"].(string)
+		fmt.Fprintf(tabw, "%s\t%s\t%s\t\n", res.Type(), res.Id(), name)
+	}
+
+	tabw.Flush()
+}
@@ -41,6 +41,7 @@ type Logger struct {
 
 var (
 	infoPrefix         = color.GreenString("[info]   ")
+	warningPrefix      = color.YellowString("[warning]")
 	errorPrefix        = color.RedString("[error]  ")
 	verbosePrefix      = color.YellowString("[verbose]")
 	extraVerbosePrefix = color.MagentaString("[extra]  ")
@@ -82,6 +83,14 @@ func (l *Logger) Infof(format string, v ...interface{}) {
 	l.out.Println(prepend(infoPrefix, fmt.Sprintf(format, v...))...)
 }
 
+func (l *Logger) Warning(v ...interface{}) {
+	l.out.Println(prepend(warningPrefix, v...)...)
+}
+
+func (l *Logger) Warningf(format string, v ...interface{}) {
+	l.out.Println(prepend(warningPrefix, fmt.Sprintf(format, v...))...)
+}
+
 func (l *Logger) Error(v ...interface{}) {
 	l.out.Println(prepend(errorPrefix, v...)...)
 }
@@ -122,6 +131,14 @@ func Infof(format string, v ...interface{}) {
 	DefaultLogger.Infof(format, v...)
 }
 
+func Warning(v ...interface{}) {
+	DefaultLogger.Warning(v...)
+}
+
+func Warningf(format string, v ...interface{}) {
+	DefaultLogger.Warningf(format, v...)
+}
+
 func Error(v ...interface{}) {
 	DefaultLogger.Error(v...)
 }
@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -144,26 +145,37 @@ func (r *gitRepo) LoadRev(version string) (*Rev, error) {
 	rev.Infra = graph.NewGraph()
 	rev.Access = graph.NewGraph()
 
-	if err := unmarshalIntoGraph(rev.Infra, commit, config.InfraFilename); err != nil {
+	accountDir := config.AccountDir(config.GetAWSAccount())
+	infraFiles := []string{path.Join(accountDir, config.GetAWSRegion(), config.InfraFilename)}
+	if accountDir == "" {
+		// revisions synced before graphs were stored per region have the infra graph at the root
+		infraFiles = append(infraFiles, config.InfraFilename)
+	}
+	if err := unmarshalIntoGraph(rev.Infra, commit, infraFiles...); err != nil {
 		return rev, err
 	}
-	if err := unmarshalIntoGraph(rev.Access, commit, config.AccessFilename); err != nil {
+	if err := unmarshalIntoGraph(rev.Access, commit, path.Join(accountDir, config.AccessFilename)); err != nil {
 		return rev, err
 	}
 
 	return rev, nil
 }
 
-func unmarshalIntoGraph(g *graph.Graph, commit *object.Commit, filename string) error {
-	f, err := commit.File(filename)
-	if err != nil && err != object.ErrFileNotFound {
-		return err
-	} else if err == nil {
+// unmarshalIntoGraph unmarshals the first file of the commit found among filenames
+func unmarshalIntoGraph(g *graph.Graph, commit *object.Commit, filenames ...string) error {
+	for _, filename := range filenames {
+		f, err := commit.File(filename)
+		if err == object.ErrFileNotFound {
+			continue
+		} else if err != nil {
+			return err
+		}
 		contents, err := f.Contents()
 		if err != nil {
 			return err
 		}
 		g.Unmarshal([]byte(contents))
+		return nil
 	}
 	return nil
 }
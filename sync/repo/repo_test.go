@@ -17,11 +17,61 @@ limitations under the License.
 package repo
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"sort"
 	"testing"
 	"time"
+
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
 )
 
+func TestLoadRevWithGraphsOfLegacyLayout(t *testing.T) {
+	if !IsGitInstalled() {
+		t.Skip("git not installed")
+	}
+	dir, err := ioutil.TempDir("", "awless-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	config.Config = map[string]interface{}{config.RegionConfigKey: "eu-west-1"}
+
+	r, err := newGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := graph.NewGraph()
+	g.AddResource(graph.InitResource("inst_1", graph.Instance))
+	if err := ioutil.WriteFile(filepath.Join(dir, config.InfraFilename), []byte(g.MustMarshal()), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Commit(config.InfraFilename); err != nil {
+		t.Fatal(err)
+	}
+
+	revs, err := r.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(revs), 1; got != want {
+		t.Fatalf("got %d, want %d revisions", got, want)
+	}
+	rev, err := r.LoadRev(revs[0].Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instances, err := rev.Infra.GetAllResources(graph.Instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(instances), 1; got != want {
+		t.Fatalf("got %d, want %d instances", got, want)
+	}
+}
+
 func TestReduceToLastRevOfEachDay(t *testing.T) {
 	revs := []*Rev{
 		{Id: "1", Date: mustParse("2017-01-18 15:05")},
@@ -37,14 +37,20 @@ import (
 
 var DefaultSyncer Syncer
 
+// DefaultParallel is the default number of services fetched concurrently, to avoid the throttling
+// of the API calls when syncing many regions
+const DefaultParallel = 8
+
 type Syncer interface {
 	repo.Repo
 	Sync(...cloud.Service) (map[string]*graph.Graph, error)
+	SyncAccount(string, ...cloud.Service) (map[string]*graph.Graph, error)
 }
 
 type syncer struct {
 	repo.Repo
-	logger *logger.Logger
+	logger   *logger.Logger
+	parallel int
 }
 
 func NewSyncer(l *logger.Logger) Syncer {
@@ -57,22 +63,35 @@ func NewSyncer(l *logger.Logger) Syncer {
 		l = logger.DiscardLogger
 	}
 
-	return &syncer{Repo: repo, logger: l}
+	return &syncer{Repo: repo, logger: l, parallel: DefaultParallel}
 }
 
+// Sync fetches the resources of the services of the account context used, see SyncAccount
 func (s *syncer) Sync(services ...cloud.Service) (map[string]*graph.Graph, error) {
+	return s.SyncAccount(config.GetAWSAccount(), services...)
+}
+
+// SyncAccount fetches the resources of the services of an account context, with a bounded number of concurrent
+// fetches, and stores their graphs per region in the directory of the account. Services of several regions can be given: the graphs returned
+// are the ones of each service merged across regions
+func (s *syncer) SyncAccount(account string, services ...cloud.Service) (map[string]*graph.Graph, error) {
 	graphs := make(map[string]*graph.Graph)
 	var workers gosync.WaitGroup
+	parallel := s.parallel
+	if parallel < 1 {
+		parallel = 1
+	}
+	sem := make(chan struct{}, parallel)
 
 	type result struct {
-		name  string
-		gph   *graph.Graph
-		start time.Time
+		name, region string
+		gph          *graph.Graph
+		start        time.Time
 	}
 
 	type srvErr struct {
-		name string
-		err  error
+		name, region string
+		err          error
 	}
 
 	resultc := make(chan *result, len(services))
@@ -84,12 +103,16 @@ func (s *syncer) Sync(services ...cloud.Service) (map[string]*graph.Graph, error
 			continue
 		}
 		workers.Add(1)
+		sem <- struct{}{}
 		go func(srv cloud.Service) {
-			defer workers.Done()
+			defer func() {
+				<-sem
+				workers.Done()
+			}()
 			start := time.Now()
 			g, err := srv.FetchResources()
-			errorc <- &srvErr{name: srv.Name(), err: err}
-			resultc <- &result{name: srv.Name(), gph: g, start: start}
+			errorc <- &srvErr{name: srv.Name(), region: srv.Region(), err: err}
+			resultc <- &result{name: srv.Name(), region: srv.Region(), gph: g, start: start}
 		}(service)
 	}
 
@@ -100,34 +123,43 @@ func (s *syncer) Sync(services ...cloud.Service) (map[string]*graph.Graph, error
 	}()
 
 	var allErrors []error
+	var results []*result
 
 Loop:
 	for {
 		select {
 		case srvErr, ok := <-errorc:
 			if ok && srvErr.err != nil {
-				allErrors = append(allErrors, fmt.Errorf("syncing %s: %s", srvErr.name, srvErr.err))
+				allErrors = append(allErrors, fmt.Errorf("syncing %s (%s): %s", srvErr.name, srvErr.region, srvErr.err))
 			}
 		case res, ok := <-resultc:
 			if !ok {
 				break Loop
 			}
-			logger.ExtraVerbosef("sync: fetched %s service took %s", res.name, time.Since(res.start))
-			graphs[res.name] = res.gph
+			logger.ExtraVerbosef("sync: fetched %s service in %s took %s", res.name, res.region, time.Since(res.start))
+			results = append(results, res)
 		}
 	}
 
 	var filenames []string
 
-	for name, g := range graphs {
-		filename := fmt.Sprintf("%s.rdf", name)
-		tofile, err := g.Marshal()
+	for _, res := range results {
+		if _, ok := graphs[res.name]; !ok {
+			graphs[res.name] = graph.NewGraph()
+		}
+		graphs[res.name].AddGraph(res.gph)
+
+		filename := GraphFilename(account, res.name, res.region)
+		tofile, err := res.gph.Marshal()
 		if err != nil {
 			allErrors = append(allErrors, fmt.Errorf("marshal %s: %s", filename, err))
 		}
-		filepath := filepath.Join(config.RepoDir, filename)
-		if err = ioutil.WriteFile(filepath, tofile, 0600); err != nil {
-			allErrors = append(allErrors, fmt.Errorf("writing %s: %s", filepath, err))
+		path := filepath.Join(config.RepoDir, filename)
+		if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			allErrors = append(allErrors, fmt.Errorf("creating %s: %s", filepath.Dir(path), err))
+		}
+		if err = ioutil.WriteFile(path, tofile, 0600); err != nil {
+			allErrors = append(allErrors, fmt.Errorf("writing %s: %s", path, err))
 		}
 		filenames = append(filenames, filename)
 	}
@@ -139,6 +171,16 @@ Loop:
 	return graphs, concatErrors(allErrors)
 }
 
+// GraphFilename returns the path of the graph of a service in a region for an account context, relative to the
+// local repository. Graphs are stored in a directory per region, but the ones of global services
+func GraphFilename(account, serviceName, region string) string {
+	filename := fmt.Sprintf("%s.rdf", serviceName)
+	if !cloud.GlobalServices[serviceName] {
+		filename = filepath.Join(region, filename)
+	}
+	return filepath.Join(filepath.FromSlash(config.AccountDir(account)), filename)
+}
+
 func concatErrors(errs []error) error {
 	if len(errs) == 0 {
 		return nil
@@ -152,8 +194,19 @@ func concatErrors(errs []error) error {
 	return errors.New(strings.Join(lines, "\n"))
 }
 
+// LoadCurrentLocalGraph returns the local graph of a service in the session region of the account context used
 func LoadCurrentLocalGraph(serviceName string) *graph.Graph {
-	path := filepath.Join(config.RepoDir, fmt.Sprintf("%s.rdf", serviceName))
+	return LoadLocalGraph(serviceName, config.GetAWSRegion())
+}
+
+// LoadLocalGraph returns the local graph of a service in a region of the account context used
+func LoadLocalGraph(serviceName, region string) *graph.Graph {
+	return LoadAccountGraph(config.GetAWSAccount(), serviceName, region)
+}
+
+// LoadAccountGraph returns the local graph of a service in a region of an account context
+func LoadAccountGraph(account, serviceName, region string) *graph.Graph {
+	path := filepath.Join(config.RepoDir, GraphFilename(account, serviceName, region))
 	g, err := graph.NewGraphFromFile(path)
 	if err != nil {
 		return graph.NewGraph()
@@ -161,19 +214,34 @@ func LoadCurrentLocalGraph(serviceName string) *graph.Graph {
 	return g
 }
 
+// LoadAllGraphs returns the local graphs of all services in the session region of the account context used
 func LoadAllGraphs() (*graph.Graph, error) {
-	path := filepath.Join(config.RepoDir, "*.rdf")
-	files, _ := filepath.Glob(path)
+	return LoadAllAccountGraphs(config.GetAWSAccount())
+}
+
+// LoadAllAccountGraphs returns the local graphs of all services in the session region of an account context
+func LoadAllAccountGraphs(account string) (*graph.Graph, error) {
+	var files []string
+	for name := range cloud.GlobalServices {
+		files = append(files, filepath.Join(config.RepoDir, GraphFilename(account, name, "")))
+	}
+	regionDir := filepath.Join(config.RepoDir, filepath.FromSlash(config.AccountDir(account)), config.GetAWSRegion())
+	regionFiles, _ := filepath.Glob(filepath.Join(regionDir, "*.rdf"))
+	files = append(files, regionFiles...)
 
 	g := graph.NewGraph()
 
 	var buff bytes.Buffer
 	for _, f := range files {
 		reader, err := os.Open(f)
+		if os.IsNotExist(err) {
+			continue
+		}
 		if err != nil {
 			return g, fmt.Errorf("loading '%s': %s", f, err)
 		}
 		io.Copy(&buff, reader)
+		reader.Close()
 		buff.WriteByte('\n')
 	}
 
@@ -0,0 +1,219 @@
+package sync
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync/repo"
+	"github.com/wallix/awless/template/driver"
+)
+
+func TestSyncInSeveralRegions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(repoDir string, conf map[string]interface{}) {
+		config.RepoDir, config.Config = repoDir, conf
+		delete(cloud.GlobalServices, "access")
+	}(config.RepoDir, config.Config)
+	config.RepoDir = dir
+	config.Config = map[string]interface{}{config.RegionConfigKey: "eu-west-1"}
+	cloud.GlobalServices["access"] = true
+
+	syncer := &syncer{Repo: &noCommitRepo{}, logger: logger.DiscardLogger}
+	graphs, err := syncer.Sync(
+		newFakeService("infra", "eu-west-1", graph.Instance, "inst_1", "inst_2"),
+		newFakeService("infra", "us-east-1", graph.Instance, "inst_3"),
+		newFakeService("access", "eu-west-1", graph.User, "user_1"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range []string{filepath.Join("eu-west-1", "infra.rdf"), filepath.Join("us-east-1", "infra.rdf"), "access.rdf"} {
+		if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+			t.Fatalf("expected %s: %s", file, err)
+		}
+	}
+
+	countResources := func(g *graph.Graph, t graph.ResourceType) int {
+		res, _ := g.GetAllResources(t)
+		return len(res)
+	}
+	if got, want := countResources(graphs["infra"], graph.Instance), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := countResources(graphs["infra"], graph.Region), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := countResources(LoadLocalGraph("infra", "us-east-1"), graph.Instance), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := countResources(LoadCurrentLocalGraph("infra"), graph.Instance), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := countResources(LoadLocalGraph("access", "us-east-1"), graph.User), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	all, err := LoadAllGraphs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := countResources(all, graph.Instance), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := countResources(all, graph.User), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+func TestSyncBoundsConcurrentFetches(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(repoDir string, conf map[string]interface{}) {
+		config.RepoDir, config.Config = repoDir, conf
+	}(config.RepoDir, config.Config)
+	config.RepoDir = dir
+	config.Config = map[string]interface{}{config.RegionConfigKey: "eu-west-1"}
+
+	var running, maxRunning int32
+	var services []cloud.Service
+	for _, region := range []string{"eu-west-1", "us-east-1", "us-west-2", "ap-northeast-1"} {
+		for _, name := range []string{"infra", "lambda", "queue"} {
+			services = append(services, &slowService{
+				fakeService: newFakeService(name, region, graph.Instance),
+				running:     &running,
+				maxRunning:  &maxRunning,
+			})
+		}
+	}
+
+	syncer := &syncer{Repo: &noCommitRepo{}, logger: logger.DiscardLogger, parallel: 3}
+	if _, err := syncer.Sync(services...); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := atomic.LoadInt32(&maxRunning), int32(3); got > want {
+		t.Fatalf("got %d concurrent fetches, want at most %d", got, want)
+	}
+}
+
+func TestSyncAccounts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(repoDir string, conf map[string]interface{}) {
+		config.RepoDir, config.Config = repoDir, conf
+		delete(cloud.GlobalServices, "access")
+	}(config.RepoDir, config.Config)
+	config.RepoDir = dir
+	config.Config = map[string]interface{}{config.RegionConfigKey: "eu-west-1"}
+	cloud.GlobalServices["access"] = true
+
+	syncer := &syncer{Repo: &noCommitRepo{}, logger: logger.DiscardLogger}
+	if _, err = syncer.Sync(newFakeService("infra", "eu-west-1", graph.Instance, "inst_1")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = syncer.SyncAccount("prod",
+		newFakeService("infra", "eu-west-1", graph.Instance, "inst_2", "inst_3"),
+		newFakeService("access", "eu-west-1", graph.User, "user_1"),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range []string{filepath.Join("eu-west-1", "infra.rdf"), filepath.Join("accounts", "prod", "eu-west-1", "infra.rdf"), filepath.Join("accounts", "prod", "access.rdf")} {
+		if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+			t.Fatalf("expected %s: %s", file, err)
+		}
+	}
+
+	countResources := func(g *graph.Graph, t graph.ResourceType) int {
+		res, _ := g.GetAllResources(t)
+		return len(res)
+	}
+	if got, want := countResources(LoadCurrentLocalGraph("infra"), graph.Instance), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := countResources(LoadAccountGraph("prod", "infra", "eu-west-1"), graph.Instance), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	all, err := LoadAllAccountGraphs("prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := countResources(all, graph.Instance), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := countResources(all, graph.User), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	config.Config[config.AccountConfigKey] = "prod"
+	if got, want := countResources(LoadCurrentLocalGraph("infra"), graph.Instance), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+type noCommitRepo struct{}
+
+func (*noCommitRepo) Commit(files ...string) error              { return nil }
+func (*noCommitRepo) LoadRev(version string) (*repo.Rev, error) { return &repo.Rev{}, nil }
+func (*noCommitRepo) List() ([]*repo.Rev, error)                { return nil, nil }
+
+type fakeService struct {
+	name, region string
+	g            *graph.Graph
+}
+
+func newFakeService(name, region string, resType graph.ResourceType, ids ...string) *fakeService {
+	g := graph.NewGraph()
+	regionN := graph.InitResource(region, graph.Region)
+	g.AddResource(regionN)
+	for _, id := range ids {
+		res := graph.InitResource(id, resType)
+		g.AddResource(res)
+		g.AddParentRelation(regionN, res)
+	}
+	return &fakeService{name: name, region: region, g: g}
+}
+
+func (s *fakeService) Name() string                               { return s.name }
+func (s *fakeService) Region() string                             { return s.region }
+func (s *fakeService) Drivers() []driver.Driver                   { return nil }
+func (s *fakeService) ResourceTypes() []string                    { return nil }
+func (s *fakeService) FetchResources() (*graph.Graph, error)      { return s.g, nil }
+func (s *fakeService) IsSyncDisabled() bool                       { return false }
+func (s *fakeService) FetchByType(t string) (*graph.Graph, error) { return s.g, nil }
+
+type slowService struct {
+	*fakeService
+	running, maxRunning *int32
+}
+
+func (s *slowService) FetchResources() (*graph.Graph, error) {
+	n := atomic.AddInt32(s.running, 1)
+	defer atomic.AddInt32(s.running, -1)
+	for {
+		max := atomic.LoadInt32(s.maxRunning)
+		if n <= max || atomic.CompareAndSwapInt32(s.maxRunning, max, n) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	return s.fakeService.FetchResources()
+}
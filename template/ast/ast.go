@@ -20,9 +20,13 @@ import (
 	"bytes"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 )
 
+// values made of other characters than these are written between double quotes
+var unquotedValueRegex = regexp.MustCompile(`^@?[a-zA-Z0-9-._:/]+$`)
+
 type Node interface {
 	clone() Node
 	String() string
@@ -129,6 +133,11 @@ func (n *CommandNode) String() string {
 		switch vv := v.(type) {
 		case []string:
 			all = append(all, fmt.Sprintf("%s=%s", k, strings.Join(vv, ",")))
+		case string:
+			if !unquotedValueRegex.MatchString(vv) {
+				vv = fmt.Sprintf("\"%s\"", vv)
+			}
+			all = append(all, fmt.Sprintf("%s=%s", k, vv))
 		default:
 			all = append(all, fmt.Sprintf("%s=%v", k, v))
 		}
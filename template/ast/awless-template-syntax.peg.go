@@ -58,6 +58,7 @@ const (
 	ruleAction12
 	ruleAction13
 	ruleAction14
+	ruleAction15
 )
 
 var rul3s = [...]string{
@@ -106,6 +107,7 @@ var rul3s = [...]string{
 	"Action12",
 	"Action13",
 	"Action14",
+	"Action15",
 }
 
 type token32 struct {
@@ -222,7 +224,7 @@ type Peg struct {
 
 	Buffer string
 	buffer []rune
-	rules  [45]func() bool
+	rules  [46]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -344,6 +346,8 @@ func (p *Peg) Execute() {
 			p.addParamValue(text)
 		case ruleAction14:
 			p.LineDone()
+		case ruleAction15:
+			p.addParamValue(text)
 
 		}
 	}
@@ -689,9 +693,9 @@ func (p *Peg) Init() {
 		},
 		/* 1 Statement <- <(Spacing (Expr / Declaration / Comment) Spacing EndOfLine*)> */
 		nil,
-		/* 2 Action <- <(('c' 'r' 'e' 'a' 't' 'e') / ('d' 'e' 'l' 'e' 't' 'e') / ('s' 't' 'a' 'r' 't') / ((&('d') ('d' 'e' 't' 'a' 'c' 'h')) | (&('c') ('c' 'h' 'e' 'c' 'k')) | (&('a') ('a' 't' 't' 'a' 'c' 'h')) | (&('u') ('u' 'p' 'd' 'a' 't' 'e')) | (&('s') ('s' 't' 'o' 'p')) | (&('n') ('n' 'o' 'n' 'e'))))> */
+		/* 2 Action <- <(('p' 'u' 'b' 'l' 'i' 's' 'h') / ('s' 'e' 'n' 'd') / ('r' 'e' 'c' 'e' 'i' 'v' 'e') / ('p' 'u' 'r' 'g' 'e') / ('c' 'o' 'p' 'y') / ('c' 'r' 'e' 'a' 't' 'e') / ('d' 'e' 'l' 'e' 't' 'e') / ('s' 't' 'a' 'r' 't') / ((&('d') ('d' 'e' 't' 'a' 'c' 'h')) | (&('c') ('c' 'h' 'e' 'c' 'k')) | (&('a') ('a' 't' 't' 'a' 'c' 'h')) | (&('u') ('u' 'p' 'd' 'a' 't' 'e')) | (&('s') ('s' 't' 'o' 'p')) | (&('n') ('n' 'o' 'n' 'e'))))> */
 		nil,
-		/* 3 Entity <- <(('v' 'p' 'c') / ('s' 'u' 'b' 'n' 'e' 't') / ('i' 'n' 's' 't' 'a' 'n' 'c' 'e') / ('t' 'a' 'g') / ('r' 'o' 'l' 'e') / ('s' 'e' 'c' 'u' 'r' 'i' 't' 'y' 'g' 'r' 'o' 'u' 'p') / ('r' 'o' 'u' 't' 'e' 't' 'a' 'b' 'l' 'e') / ('s' 't' 'o' 'r' 'a' 'g' 'e' 'o' 'b' 'j' 'e' 'c' 't') / ((&('l') ('l' 'o' 'a' 'd' 'b' 'a' 'l' 'a' 'n' 'c' 'e' 'r')) | (&('q') ('q' 'u' 'e' 'u' 'e')) | (&('t') ('t' 'o' 'p' 'i' 'c')) | (&('s') ('s' 'u' 'b' 's' 'c' 'r' 'i' 'p' 't' 'i' 'o' 'n')) | (&('b') ('b' 'u' 'c' 'k' 'e' 't')) | (&('r') ('r' 'o' 'u' 't' 'e')) | (&('i') ('i' 'n' 't' 'e' 'r' 'n' 'e' 't' 'g' 'a' 't' 'e' 'w' 'a' 'y')) | (&('k') ('k' 'e' 'y' 'p' 'a' 'i' 'r')) | (&('p') ('p' 'o' 'l' 'i' 'c' 'y')) | (&('g') ('g' 'r' 'o' 'u' 'p')) | (&('u') ('u' 's' 'e' 'r')) | (&('v') ('v' 'o' 'l' 'u' 'm' 'e')) | (&('n') ('n' 'o' 'n' 'e'))))> */
+		/* 3 Entity <- <(('k' 'e' 'y' 'a' 'l' 'i' 'a' 's') / ('k' 'e' 'y' 'p' 'a' 'i' 'r') / ('k' 'e' 'y') / ('c' 'l' 'a' 's' 's' 'i' 'c' 'l' 'o' 'a' 'd' 'b' 'a' 'l' 'a' 'n' 'c' 'e' 'r') / ('t' 'a' 'b' 'l' 'e') / ('t' 'a' 's' 'k') / ('s' 'e' 'r' 'v' 'i' 'c' 'e') / ('s' 't' 'a' 'c' 'k') / ('i' 'n' 's' 't' 'a' 'n' 'c' 'e' 'p' 'r' 'o' 'f' 'i' 'l' 'e') / ('a' 'c' 'c' 'e' 's' 's' 'k' 'e' 'y') / ('i' 'm' 'a' 'g' 'e') / ('s' 'n' 'a' 'p' 's' 'h' 'o' 't') / ('n' 'a' 't' 'g' 'a' 't' 'e' 'w' 'a' 'y') / ('e' 'l' 'a' 's' 't' 'i' 'c' 'i' 'p') / ('a' 'l' 'a' 'r' 'm') / ('s' 'c' 'a' 'l' 'i' 'n' 'g' 'g' 'r' 'o' 'u' 'p') / ('l' 'a' 'u' 'n' 'c' 'h' 'c' 'o' 'n' 'f' 'i' 'g' 'u' 'r' 'a' 't' 'i' 'o' 'n') / ('z' 'o' 'n' 'e') / ('r' 'e' 'c' 'o' 'r' 'd') / ('f' 'u' 'n' 'c' 't' 'i' 'o' 'n') / ('e' 'v' 'e' 'n' 't' 's' 'o' 'u' 'r' 'c' 'e') / ('d' 'a' 't' 'a' 'b' 'a' 's' 'e') / ('v' 'p' 'c') / ('s' 'u' 'b' 'n' 'e' 't') / ('i' 'n' 's' 't' 'a' 'n' 'c' 'e') / ('t' 'a' 'g') / ('r' 'o' 'l' 'e') / ('s' 'e' 'c' 'u' 'r' 'i' 't' 'y' 'g' 'r' 'o' 'u' 'p') / ('r' 'o' 'u' 't' 'e' 't' 'a' 'b' 'l' 'e') / ('s' 't' 'o' 'r' 'a' 'g' 'e' 'o' 'b' 'j' 'e' 'c' 't') / ((&('l') ('l' 'o' 'a' 'd' 'b' 'a' 'l' 'a' 'n' 'c' 'e' 'r')) | (&('q') ('q' 'u' 'e' 'u' 'e')) | (&('t') ('t' 'o' 'p' 'i' 'c')) | (&('s') ('s' 'u' 'b' 's' 'c' 'r' 'i' 'p' 't' 'i' 'o' 'n')) | (&('b') ('b' 'u' 'c' 'k' 'e' 't')) | (&('r') ('r' 'o' 'u' 't' 'e')) | (&('i') ('i' 'n' 't' 'e' 'r' 'n' 'e' 't' 'g' 'a' 't' 'e' 'w' 'a' 'y')) | (&('p') ('p' 'o' 'l' 'i' 'c' 'y')) | (&('g') ('g' 'r' 'o' 'u' 'p')) | (&('u') ('u' 's' 'e' 'r')) | (&('v') ('v' 'o' 'l' 'u' 'm' 'e')) | (&('n') ('n' 'o' 'n' 'e'))))> */
 		nil,
 		/* 4 Declaration <- <(<Identifier> Action0 Equal Expr)> */
 		nil,
@@ -706,6 +710,129 @@ func (p *Peg) Init() {
 						position51 := position
 						{
 							position52, tokenIndex52 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1294
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l1294
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l1294
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1294
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1294
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1294
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l1294
+							}
+							position++
+							goto l52
+						l1294:
+							position, tokenIndex = position52, tokenIndex52
+							if buffer[position] != rune('s') {
+								goto l1295
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1295
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1295
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l1295
+							}
+							position++
+							goto l52
+						l1295:
+							position, tokenIndex = position52, tokenIndex52
+							if buffer[position] != rune('r') {
+								goto l1296
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1296
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1296
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1296
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1296
+							}
+							position++
+							if buffer[position] != rune('v') {
+								goto l1296
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1296
+							}
+							position++
+							goto l52
+						l1296:
+							position, tokenIndex = position52, tokenIndex52
+							if buffer[position] != rune('p') {
+								goto l1297
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l1297
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1297
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l1297
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1297
+							}
+							position++
+							goto l52
+						l1297:
+							position, tokenIndex = position52, tokenIndex52
+							if buffer[position] != rune('c') {
+								goto l1265
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l1265
+							}
+							position++
+							if buffer[position] != rune('p') {
+								goto l1265
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l1265
+							}
+							position++
+							goto l52
+						l1265:
+							position, tokenIndex = position52, tokenIndex52
 							if buffer[position] != rune('c') {
 								goto l53
 							}
@@ -923,25 +1050,839 @@ func (p *Peg) Init() {
 									break
 								}
 							}
-
-						}
-					l52:
-						add(ruleAction, position51)
-					}
-					add(rulePegText, position50)
-				}
-				{
-					add(ruleAction1, position)
-				}
-				if !_rules[ruleMustWhiteSpacing]() {
-					goto l48
-				}
-				{
-					position58 := position
-					{
-						position59 := position
-						{
-							position60, tokenIndex60 := position, tokenIndex
+
+						}
+					l52:
+						add(ruleAction, position51)
+					}
+					add(rulePegText, position50)
+				}
+				{
+					add(ruleAction1, position)
+				}
+				if !_rules[ruleMustWhiteSpacing]() {
+					goto l48
+				}
+				{
+					position58 := position
+					{
+						position59 := position
+						{
+							position60, tokenIndex60 := position, tokenIndex
+							if buffer[position] != rune('k') {
+								goto l1275
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1275
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l1275
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1275
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1275
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1275
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1275
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1275
+							}
+							position++
+							goto l60
+						l1275:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('k') {
+								goto l1274
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1274
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l1274
+							}
+							position++
+							if buffer[position] != rune('p') {
+								goto l1274
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1274
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1274
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1274
+							}
+							position++
+							goto l60
+						l1274:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('k') {
+								goto l1273
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1273
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l1273
+							}
+							position++
+							goto l60
+						l1273:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('c') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1272
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1272
+							}
+							position++
+							goto l60
+						l1272:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('t') {
+								goto l1271
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1271
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l1271
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1271
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1271
+							}
+							position++
+							goto l60
+						l1271:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('t') {
+								goto l1270
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1270
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1270
+							}
+							position++
+							if buffer[position] != rune('k') {
+								goto l1270
+							}
+							position++
+							goto l60
+						l1270:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('s') {
+								goto l1269
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1269
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1269
+							}
+							position++
+							if buffer[position] != rune('v') {
+								goto l1269
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1269
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1269
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1269
+							}
+							position++
+							goto l60
+						l1269:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('s') {
+								goto l1268
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1268
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1268
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1268
+							}
+							position++
+							if buffer[position] != rune('k') {
+								goto l1268
+							}
+							position++
+							goto l60
+						l1268:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('i') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('p') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('f') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1266
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1266
+							}
+							position++
+							goto l60
+						l1266:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('a') {
+								goto l1267
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1267
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1267
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1267
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1267
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1267
+							}
+							position++
+							if buffer[position] != rune('k') {
+								goto l1267
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1267
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l1267
+							}
+							position++
+							goto l60
+						l1267:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('i') {
+								goto l1263
+							}
+							position++
+							if buffer[position] != rune('m') {
+								goto l1263
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1263
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l1263
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1263
+							}
+							position++
+							goto l60
+						l1263:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('s') {
+								goto l1264
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1264
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1264
+							}
+							position++
+							if buffer[position] != rune('p') {
+								goto l1264
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1264
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l1264
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l1264
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1264
+							}
+							position++
+							goto l60
+						l1264:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('n') {
+								goto l1261
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1261
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1261
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l1261
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1261
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1261
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1261
+							}
+							position++
+							if buffer[position] != rune('w') {
+								goto l1261
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1261
+							}
+							position++
+							if buffer[position] != rune('y') {
+								goto l1261
+							}
+							position++
+							goto l60
+						l1261:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('e') {
+								goto l1262
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1262
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1262
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1262
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1262
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1262
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1262
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1262
+							}
+							position++
+							if buffer[position] != rune('p') {
+								goto l1262
+							}
+							position++
+							goto l60
+						l1262:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('a') {
+								goto l1260
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1260
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1260
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1260
+							}
+							position++
+							if buffer[position] != rune('m') {
+								goto l1260
+							}
+							position++
+							goto l60
+						l1260:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('s') {
+								goto l1258
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1258
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1258
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1258
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1258
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1258
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l1258
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l1258
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1258
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l1258
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l1258
+							}
+							position++
+							if buffer[position] != rune('p') {
+								goto l1258
+							}
+							position++
+							goto l60
+						l1258:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('l') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('h') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('f') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l1259
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1259
+							}
+							position++
+							goto l60
+						l1259:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('z') {
+								goto l1256
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l1256
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1256
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1256
+							}
+							position++
+							goto l60
+						l1256:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('r') {
+								goto l1257
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1257
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1257
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l1257
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1257
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l1257
+							}
+							position++
+							goto l60
+						l1257:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('f') {
+								goto l1254
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l1254
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1254
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1254
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1254
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1254
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l1254
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1254
+							}
+							position++
+							goto l60
+						l1254:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('e') {
+								goto l1255
+							}
+							position++
+							if buffer[position] != rune('v') {
+								goto l1255
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1255
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1255
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1255
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1255
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l1255
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l1255
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1255
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1255
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1255
+							}
+							position++
+							goto l60
+						l1255:
+							position, tokenIndex = position60, tokenIndex60
+							if buffer[position] != rune('d') {
+								goto l1253
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1253
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1253
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1253
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l1253
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1253
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1253
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1253
+							}
+							position++
+							goto l60
+						l1253:
+							position, tokenIndex = position60, tokenIndex60
 							if buffer[position] != rune('v') {
 								goto l61
 							}
@@ -1462,36 +2403,6 @@ func (p *Peg) Init() {
 									}
 									position++
 									break
-								case 'k':
-									if buffer[position] != rune('k') {
-										goto l48
-									}
-									position++
-									if buffer[position] != rune('e') {
-										goto l48
-									}
-									position++
-									if buffer[position] != rune('y') {
-										goto l48
-									}
-									position++
-									if buffer[position] != rune('p') {
-										goto l48
-									}
-									position++
-									if buffer[position] != rune('a') {
-										goto l48
-									}
-									position++
-									if buffer[position] != rune('i') {
-										goto l48
-									}
-									position++
-									if buffer[position] != rune('r') {
-										goto l48
-									}
-									position++
-									break
 								case 'p':
 									if buffer[position] != rune('p') {
 										goto l48
@@ -1732,6 +2643,15 @@ func (p *Peg) Init() {
 											l93:
 												position, tokenIndex = position93, tokenIndex93
 											}
+											{
+												position1280, tokenIndex1280 := position, tokenIndex
+												if !_rules[ruleStringValue]() {
+													goto l1280
+												}
+												goto l81
+											l1280:
+												position, tokenIndex = position1280, tokenIndex1280
+											}
 											add(ruleCidrValue, position83)
 										}
 										add(rulePegText, position82)
@@ -1815,6 +2735,15 @@ func (p *Peg) Init() {
 											l105:
 												position, tokenIndex = position105, tokenIndex105
 											}
+											{
+												position1281, tokenIndex1281 := position, tokenIndex
+												if !_rules[ruleStringValue]() {
+													goto l1281
+												}
+												goto l95
+											l1281:
+												position, tokenIndex = position1281, tokenIndex1281
+											}
 											add(ruleIpValue, position97)
 										}
 										add(rulePegText, position96)
@@ -1913,6 +2842,15 @@ func (p *Peg) Init() {
 											l119:
 												position, tokenIndex = position119, tokenIndex119
 											}
+											{
+												position1276, tokenIndex1276 := position, tokenIndex
+												if !_rules[ruleStringValue]() {
+													goto l1276
+												}
+												goto l113
+											l1276:
+												position, tokenIndex = position1276, tokenIndex1276
+											}
 											add(ruleIntRangeValue, position115)
 										}
 										add(rulePegText, position114)
@@ -1942,6 +2880,15 @@ func (p *Peg) Init() {
 											l125:
 												position, tokenIndex = position125, tokenIndex125
 											}
+											{
+												position1277, tokenIndex1277 := position, tokenIndex
+												if !_rules[ruleStringValue]() {
+													goto l1277
+												}
+												goto l121
+											l1277:
+												position, tokenIndex = position1277, tokenIndex1277
+											}
 											add(ruleIntValue, position123)
 										}
 										add(rulePegText, position122)
@@ -1994,6 +2941,52 @@ func (p *Peg) Init() {
 												add(ruleAction6, position)
 											}
 											break
+										case '"':
+											if buffer[position] != rune('"') {
+												goto l71
+											}
+											position++
+											{
+												position1284 := position
+											l1285:
+												{
+													position1286, tokenIndex1286 := position, tokenIndex
+													{
+														position1287, tokenIndex1287 := position, tokenIndex
+														if buffer[position] != rune('"') {
+															goto l1287
+														}
+														position++
+														goto l1286
+													l1287:
+														position, tokenIndex = position1287, tokenIndex1287
+													}
+													{
+														position1288, tokenIndex1288 := position, tokenIndex
+														if !_rules[ruleEndOfLine]() {
+															goto l1288
+														}
+														goto l1286
+													l1288:
+														position, tokenIndex = position1288, tokenIndex1288
+													}
+													if !matchDot() {
+														goto l1286
+													}
+													goto l1285
+												l1286:
+													position, tokenIndex = position1286, tokenIndex1286
+												}
+												add(rulePegText, position1284)
+											}
+											if buffer[position] != rune('"') {
+												goto l71
+											}
+											position++
+											{
+												add(ruleAction15, position)
+											}
+											break
 										case '{':
 											{
 												position134 := position
@@ -2162,6 +3155,15 @@ func (p *Peg) Init() {
 												l156:
 													position, tokenIndex = position156, tokenIndex156
 												}
+												{
+													position1282, tokenIndex1282 := position, tokenIndex
+													if !_rules[ruleStringValue]() {
+														goto l1282
+													}
+													goto l144
+												l1282:
+													position, tokenIndex = position1282, tokenIndex1282
+												}
 												add(ruleCidrValue, position146)
 											}
 											add(rulePegText, position145)
@@ -2245,6 +3247,15 @@ func (p *Peg) Init() {
 												l168:
 													position, tokenIndex = position168, tokenIndex168
 												}
+												{
+													position1283, tokenIndex1283 := position, tokenIndex
+													if !_rules[ruleStringValue]() {
+														goto l1283
+													}
+													goto l158
+												l1283:
+													position, tokenIndex = position1283, tokenIndex1283
+												}
 												add(ruleIpValue, position160)
 											}
 											add(rulePegText, position159)
@@ -2343,6 +3354,15 @@ func (p *Peg) Init() {
 												l182:
 													position, tokenIndex = position182, tokenIndex182
 												}
+												{
+													position1278, tokenIndex1278 := position, tokenIndex
+													if !_rules[ruleStringValue]() {
+														goto l1278
+													}
+													goto l176
+												l1278:
+													position, tokenIndex = position1278, tokenIndex1278
+												}
 												add(ruleIntRangeValue, position178)
 											}
 											add(rulePegText, position177)
@@ -2372,6 +3392,15 @@ func (p *Peg) Init() {
 												l188:
 													position, tokenIndex = position188, tokenIndex188
 												}
+												{
+													position1279, tokenIndex1279 := position, tokenIndex
+													if !_rules[ruleStringValue]() {
+														goto l1279
+													}
+													goto l184
+												l1279:
+													position, tokenIndex = position1279, tokenIndex1279
+												}
 												add(ruleIntValue, position186)
 											}
 											add(rulePegText, position185)
@@ -2424,6 +3453,52 @@ func (p *Peg) Init() {
 													add(ruleAction6, position)
 												}
 												break
+											case '"':
+												if buffer[position] != rune('"') {
+													goto l75
+												}
+												position++
+												{
+													position1289 := position
+												l1290:
+													{
+														position1291, tokenIndex1291 := position, tokenIndex
+														{
+															position1292, tokenIndex1292 := position, tokenIndex
+															if buffer[position] != rune('"') {
+																goto l1292
+															}
+															position++
+															goto l1291
+														l1292:
+															position, tokenIndex = position1292, tokenIndex1292
+														}
+														{
+															position1293, tokenIndex1293 := position, tokenIndex
+															if !_rules[ruleEndOfLine]() {
+																goto l1293
+															}
+															goto l1291
+														l1293:
+															position, tokenIndex = position1293, tokenIndex1293
+														}
+														if !matchDot() {
+															goto l1291
+														}
+														goto l1290
+													l1291:
+														position, tokenIndex = position1291, tokenIndex1291
+													}
+													add(rulePegText, position1289)
+												}
+												if buffer[position] != rune('"') {
+													goto l75
+												}
+												position++
+												{
+													add(ruleAction15, position)
+												}
+												break
 											case '{':
 												{
 													position197 := position
@@ -2604,7 +3679,7 @@ func (p *Peg) Init() {
 			position, tokenIndex = position205, tokenIndex205
 			return false
 		},
-		/* 9 Value <- <((<CidrValue> Action8) / (<IpValue> Action9) / (<CSVValue> Action10) / (<IntRangeValue> Action11) / (<IntValue> Action12) / ((&('$') (RefValue Action7)) | (&('@') (AliasValue Action6)) | (&('{') (HoleValue Action5)) | (&('-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') (<StringValue> Action13))))> */
+		/* 9 Value <- <((<CidrValue> Action8) / (<IpValue> Action9) / (<CSVValue> Action10) / (<IntRangeValue> Action11) / (<IntValue> Action12) / ((&('$') (RefValue Action7)) | (&('@') (AliasValue Action6)) | (&('{') (HoleValue Action5)) | (&('"') ('"' <(!'"' !EndOfLine .)*> '"' Action15)) | (&('-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') (<StringValue> Action13))))> */
 		nil,
 		/* 10 StringValue <- <((&('/') '/') | (&(':') ':') | (&('_') '_') | (&('.') '.') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> */
 		func() bool {
@@ -2733,13 +3808,13 @@ func (p *Peg) Init() {
 		},
 		/* 11 CSVValue <- <((StringValue WhiteSpacing ',' WhiteSpacing)+ StringValue)> */
 		nil,
-		/* 12 CidrValue <- <([0-9]+ . [0-9]+ . [0-9]+ . [0-9]+ '/' [0-9]+)> */
+		/* 12 CidrValue <- <([0-9]+ . [0-9]+ . [0-9]+ . [0-9]+ '/' [0-9]+ !StringValue)> */
 		nil,
-		/* 13 IpValue <- <([0-9]+ . [0-9]+ . [0-9]+ . [0-9]+)> */
+		/* 13 IpValue <- <([0-9]+ . [0-9]+ . [0-9]+ . [0-9]+ !StringValue)> */
 		nil,
-		/* 14 IntValue <- <[0-9]+> */
+		/* 14 IntValue <- <([0-9]+ !StringValue)> */
 		nil,
-		/* 15 IntRangeValue <- <([0-9]+ '-' [0-9]+)> */
+		/* 15 IntRangeValue <- <([0-9]+ '-' [0-9]+ !StringValue)> */
 		nil,
 		/* 16 RefValue <- <('$' <Identifier>)> */
 		nil,
@@ -2946,6 +4021,8 @@ func (p *Peg) Init() {
 		nil,
 		/* 44 Action14 <- <{ p.LineDone() }> */
 		nil,
+		/* 45 Action15 <- <{ p.addParamValue(text) }> */
+		nil,
 	}
 	p.rules = _rules
 }
@@ -1,6 +1,7 @@
 package template
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -60,6 +61,7 @@ func Compile(tpl *Template, env *Env) (*Template, *Env, error) {
 		resolveAliasPass,
 		resolveMissingHolesPass,
 		resolveAliasPass,
+		validateParamsPass,
 	)
 
 	return pass.compile(tpl, env)
@@ -230,6 +232,19 @@ func resolveAliasPass(tpl *Template, env *Env) (*Template, *Env, error) {
 	return tpl, env, nil
 }
 
+func validateParamsPass(tpl *Template, env *Env) (*Template, *Env, error) {
+	errs := tpl.Validate(&ParamsValidator{LookupDef: env.DefLookupFunc})
+	if len(errs) > 0 {
+		var msgs []string
+		for _, err := range errs {
+			msgs = append(msgs, err.Error())
+		}
+		return tpl, env, errors.New(strings.Join(msgs, "\n"))
+	}
+
+	return tpl, env, nil
+}
+
 func mergeExternalParamsPass(tpl *Template, env *Env) (*Template, *Env, error) {
 	each := func(cmd *ast.CommandNode) {
 		for k, v := range env.externalParams {
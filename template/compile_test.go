@@ -14,6 +14,10 @@ var defs = map[string]TemplateDefinition{
 		RequiredParams: []string{"image", "count", "count", "type", "subnet"},
 		ExtraParams:    []string{"key", "ip", "userdata", "group", "lock"},
 		TagsMapping:    []string{"name"},
+		Params: map[string]ParamDefinition{
+			"count": {Type: IntParam},
+			"type":  {Type: StringParam, Regex: `^[a-z0-9]+\.[a-z0-9]+$`},
+		},
 	},
 	"createkeypair": {
 		Action:         "create",
@@ -69,6 +73,31 @@ func TestResolveAgainstDefinitionsPass(t *testing.T) {
 	})
 }
 
+func TestValidateParamsPass(t *testing.T) {
+	env := NewEnv()
+	env.DefLookupFunc = func(in string) (TemplateDefinition, bool) {
+		t, ok := defs[in]
+		return t, ok
+	}
+
+	tpl := MustParse(`create instance type=t2.micro count=2
+	create keypair name=mykey`)
+	if _, _, err := validateParamsPass(tpl, env); err != nil {
+		t.Fatal(err)
+	}
+
+	tpl = MustParse(`create instance type=t2.micro count=two
+	create instance type=micro count=1`)
+	_, _, err := validateParamsPass(tpl, env)
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	exp := "create instance: invalid param 'count': 'two' is not an integer\ncreate instance: invalid param 'type': 'micro' does not match ^[a-z0-9]+\\.[a-z0-9]+$"
+	if got, want := err.Error(), exp; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
 func TestMergeExternalParamsPass(t *testing.T) {
 	extTpl := MustParse(`create instance subnet=@my-subnet count=4`)
 	tpl := MustParse(`create instance ami=r45ty3`)
@@ -0,0 +1,197 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/wallix/awless/logger"
+)
+
+// An Interaction is a recorded call to a driver function
+type Interaction struct {
+	Lookup []string
+	DryRun bool                   `json:",omitempty"`
+	Params map[string]interface{} `json:",omitempty"`
+	Result interface{}            `json:",omitempty"`
+	Err    string                 `json:",omitempty"`
+}
+
+func (i *Interaction) String() string {
+	var mode string
+	if i.DryRun {
+		mode = " (dry run)"
+	}
+	return fmt.Sprintf("%s%s", strings.Join(i.Lookup, " "), mode)
+}
+
+// A Cassette holds the ordered driver function calls of a template run
+type Cassette struct {
+	Interactions []*Interaction
+}
+
+func LoadCassette(path string) (*Cassette, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &Cassette{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("cassette %s: %s", path, err)
+	}
+	return c, nil
+}
+
+func (c *Cassette) Save(path string) error {
+	b, err := json.MarshalIndent(c, "", " ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0600)
+}
+
+// A Recorder wraps a driver and records every driver function call it serves into a cassette
+type Recorder struct {
+	Driver
+
+	mu       sync.Mutex
+	dryRun   bool
+	cassette *Cassette
+}
+
+func NewRecorder(d Driver) *Recorder {
+	return &Recorder{Driver: d, cassette: &Cassette{}}
+}
+
+func (r *Recorder) SetDryRun(dry bool) {
+	r.mu.Lock()
+	r.dryRun = dry
+	r.mu.Unlock()
+	r.Driver.SetDryRun(dry)
+}
+
+func (r *Recorder) Lookup(lookups ...string) (DriverFn, error) {
+	fn, err := r.Driver.Lookup(lookups...)
+	if err != nil {
+		return fn, err
+	}
+
+	return func(params map[string]interface{}) (interface{}, error) {
+		result, err := fn(params)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		inter := &Interaction{Lookup: lookups, DryRun: r.dryRun, Params: normalizeParams(params), Result: result}
+		if err != nil {
+			inter.Err = err.Error()
+		}
+		r.cassette.Interactions = append(r.cassette.Interactions, inter)
+
+		return result, err
+	}, nil
+}
+
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette
+}
+
+// A Replayer serves back, in order, the driver function calls recorded in a cassette.
+// It fails when the template run diverges from the recorded one.
+type Replayer struct {
+	mu       sync.Mutex
+	dryRun   bool
+	cursor   int
+	cassette *Cassette
+	logger   *logger.Logger
+}
+
+func NewReplayer(c *Cassette) *Replayer {
+	return &Replayer{cassette: c, logger: logger.DiscardLogger}
+}
+
+func (r *Replayer) SetDryRun(dry bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dryRun = dry
+}
+
+func (r *Replayer) SetLogger(l *logger.Logger) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.logger = l
+}
+
+func (r *Replayer) Lookup(lookups ...string) (DriverFn, error) {
+	return func(params map[string]interface{}) (interface{}, error) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		call := &Interaction{Lookup: lookups, DryRun: r.dryRun, Params: normalizeParams(params)}
+		if r.cursor >= len(r.cassette.Interactions) {
+			return nil, fmt.Errorf("replay: unexpected call '%s': no more recorded interactions", call)
+		}
+		inter := r.cassette.Interactions[r.cursor]
+
+		if !reflect.DeepEqual(inter.Lookup, call.Lookup) || inter.DryRun != call.DryRun {
+			return nil, fmt.Errorf("replay: unexpected call '%s', recorded '%s'", call, inter)
+		}
+		if !reflect.DeepEqual(inter.Params, call.Params) {
+			return nil, fmt.Errorf("replay: '%s': unexpected params %v, recorded %v", call, call.Params, inter.Params)
+		}
+		r.cursor++
+
+		r.logger.ExtraVerbosef("replay: %s", inter)
+		if inter.Err != "" {
+			return inter.Result, errors.New(inter.Err)
+		}
+		return inter.Result, nil
+	}, nil
+}
+
+// Done returns an error when some recorded interactions have not been replayed
+func (r *Replayer) Done() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if left := len(r.cassette.Interactions) - r.cursor; left > 0 {
+		return fmt.Errorf("replay: %d recorded interaction(s) not replayed, next is '%s'", left, r.cassette.Interactions[r.cursor])
+	}
+	return nil
+}
+
+// normalizeParams makes params comparable with the ones read from a cassette
+func normalizeParams(params map[string]interface{}) map[string]interface{} {
+	if len(params) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(params)
+	if err != nil {
+		return params
+	}
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(b, &normalized); err != nil {
+		return params
+	}
+	return normalized
+}
@@ -0,0 +1,103 @@
+package driver_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template/driver"
+)
+
+func TestRecordAndReplayCassette(t *testing.T) {
+	calls := 0
+	mock := &mockDriver{
+		lookupFn: func(lookups ...string) (driverFn driver.DriverFn, err error) {
+			switch strings.Join(lookups, "") {
+			case "createvpc":
+				return func(map[string]interface{}) (interface{}, error) { calls++; return "vpc-1234", nil }, nil
+			case "deletevpc":
+				return func(map[string]interface{}) (interface{}, error) {
+					calls++
+					return nil, errors.New("dependency violation")
+				}, nil
+			default:
+				return nil, driver.ErrDriverFnNotFound
+			}
+		},
+	}
+
+	recorder := driver.NewRecorder(mock)
+	recorder.SetDryRun(true)
+	if got, want := mock.dryRun, true; got != want {
+		t.Fatalf("got %t, want %t", got, want)
+	}
+	fn, err := recorder.Lookup("create", "vpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn(map[string]interface{}{"cidr": "10.0.0.0/16", "count": 2})
+	recorder.SetDryRun(false)
+	fn(map[string]interface{}{"cidr": "10.0.0.0/16", "count": 2})
+	fn, err = recorder.Lookup("delete", "vpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn(map[string]interface{}{"id": "vpc-1234"})
+	if _, err = recorder.Lookup("stop", "vpc"); err != driver.ErrDriverFnNotFound {
+		t.Fatalf("got %v, want %v", err, driver.ErrDriverFnNotFound)
+	}
+
+	if got, want := len(recorder.Cassette().Interactions), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+	if err = recorder.Cassette().Save(path); err != nil {
+		t.Fatal(err)
+	}
+	cassette, err := driver.LoadCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replayer := driver.NewReplayer(cassette)
+	replayer.SetDryRun(true)
+	fn, _ = replayer.Lookup("create", "vpc")
+	if res, err := fn(map[string]interface{}{"cidr": "10.0.0.0/16", "count": 2}); err != nil {
+		t.Fatal(err)
+	} else if got, want := res, "vpc-1234"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	replayer.SetDryRun(false)
+	if _, err := fn(map[string]interface{}{"cidr": "10.0.0.0/24", "count": 2}); err == nil {
+		t.Fatal("expected error got none")
+	}
+	fn(map[string]interface{}{"cidr": "10.0.0.0/16", "count": 2})
+	if err := replayer.Done(); err == nil {
+		t.Fatal("expected error got none")
+	}
+	fn, _ = replayer.Lookup("delete", "vpc")
+	if _, err := fn(map[string]interface{}{"id": "vpc-1234"}); err == nil {
+		t.Fatal("expected error got none")
+	} else if got, want := err.Error(), "dependency violation"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if err := replayer.Done(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fn(map[string]interface{}{"id": "vpc-1234"}); err == nil {
+		t.Fatal("expected error got none")
+	}
+
+	if got, want := calls, 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
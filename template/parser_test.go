@@ -183,6 +183,30 @@ func TestTemplateParsing(t *testing.T) {
 					return nil
 				},
 			},
+			{
+				input: `delete key id=12345678-1234-5678-9abc-567890abcdef`,
+				verifyFn: func(n ast.Node) error {
+					return assertParams(n, map[string]interface{}{"id": "12345678-1234-5678-9abc-567890abcdef"})
+				},
+			},
+			{
+				input: `create keyalias name=alias/backups key=1234abcd-12ab-34cd-56ef-1234567890ab`,
+				verifyFn: func(n ast.Node) error {
+					return assertParams(n, map[string]interface{}{"name": "alias/backups", "key": "1234abcd-12ab-34cd-56ef-1234567890ab"})
+				},
+			},
+			{
+				input: `send queue url=https://sqs.eu-west-1.amazonaws.com/123456789012/jobs message="disk full: /var on web-1, 98%" delay=5`,
+				verifyFn: func(n ast.Node) error {
+					return assertParams(n, map[string]interface{}{"url": "https://sqs.eu-west-1.amazonaws.com/123456789012/jobs", "message": "disk full: /var on web-1, 98%", "delay": 5})
+				},
+			},
+			{
+				input: `publish topic arn=arn:aws:sns:eu-west-1:123456789012:ops message=""`,
+				verifyFn: func(n ast.Node) error {
+					return assertParams(n, map[string]interface{}{"arn": "arn:aws:sns:eu-west-1:123456789012:ops", "message": ""})
+				},
+			},
 			{
 				input: `create vpc array=test1,test2, 20 , my-array-elem4 ip=127.0.0.1`,
 				verifyFn: func(n ast.Node) error {
@@ -19,6 +19,7 @@ package template
 import (
 	"crypto/rand"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -138,6 +139,21 @@ func (s *Template) GetParams() map[string]interface{} {
 	return params
 }
 
+func (s *Template) GetHoles() (holes []string) {
+	uniq := make(map[string]struct{})
+	each := func(expr *ast.CommandNode) {
+		for _, v := range expr.Holes {
+			uniq[v] = struct{}{}
+		}
+	}
+	s.visitCommandNodes(each)
+	for k := range uniq {
+		holes = append(holes, k)
+	}
+	sort.Strings(holes)
+	return
+}
+
 func (s *Template) visitCommandNodes(fn func(n *ast.CommandNode)) {
 	for _, cmd := range s.CommandNodesIterator() {
 		fn(cmd)
@@ -174,6 +190,8 @@ func (s *Template) CommandNodesIterator() (nodes []*ast.CommandNode) {
 type TemplateExecution struct {
 	ID       string
 	Executed []*ExecutedStatement
+	// Source is where the template was read from (filepath, url, registry name, ...) and SHA256 the checksum of its content
+	Source, SHA256 string
 }
 
 type ExecutedStatement struct {
@@ -184,10 +202,27 @@ func (ex *ExecutedStatement) IsRevertible() bool {
 	if ex.Err != "" {
 		return false
 	}
+	// messages published, sent, received or purged cannot be taken back
+	switch strings.SplitN(ex.Line, " ", 2)[0] {
+	case "publish", "send", "receive", "purge":
+		return false
+	}
 	if ex.Result != "" {
+		// a stopped task cannot be started again, only a new one from its task definition
+		if strings.Contains(ex.Line, "stop task") {
+			return false
+		}
 		if strings.Contains(ex.Line, "create") || strings.Contains(ex.Line, "start") || strings.Contains(ex.Line, "stop") {
 			return true
 		}
+		// a copied image is reverted by deleting the copy
+		if strings.Contains(ex.Line, "copy image") {
+			return true
+		}
+		// the result of an update of a scaling group holds its previous values
+		if strings.Contains(ex.Line, "update scalinggroup") {
+			return true
+		}
 	} else {
 		return strings.Contains(ex.Line, "attach") || strings.Contains(ex.Line, "detach")
 	}
@@ -248,6 +283,18 @@ func (te *TemplateExecution) lines() (lines []string) {
 	return
 }
 
+func formatParams(params map[string]interface{}) (all []string) {
+	for k, v := range params {
+		switch vv := v.(type) {
+		case []string:
+			all = append(all, fmt.Sprintf("%s=%s", k, strings.Join(vv, ",")))
+		default:
+			all = append(all, fmt.Sprintf("%s=%v", k, v))
+		}
+	}
+	return
+}
+
 func (te *TemplateExecution) Revert() (*Template, error) {
 	var lines []string
 
@@ -264,7 +311,7 @@ func (te *TemplateExecution) Revert() (*Template, error) {
 				var revertAction string
 				var params []string
 				switch node.Action {
-				case "create":
+				case "create", "copy":
 					revertAction = "delete"
 				case "start":
 					revertAction = "stop"
@@ -274,15 +321,42 @@ func (te *TemplateExecution) Revert() (*Template, error) {
 					revertAction = "attach"
 				case "attach":
 					revertAction = "detach"
+				case "update":
+					revertAction = "update"
 				}
 
-				switch node.Action {
-				case "start", "stop", "attach", "detach":
-					for k, v := range node.Params {
-						params = append(params, fmt.Sprintf("%s=%s", k, v))
-					}
-				case "create":
+				switch {
+				case node.Action == "start" && node.Entity == "task":
+					// a task is stopped given its id within its cluster
+					params = append(params, fmt.Sprintf("cluster=%v", node.Params["cluster"]), fmt.Sprintf("id=%s", exec.Result))
+				case node.Action == "start", node.Action == "stop", node.Action == "attach", node.Action == "detach":
+					params = formatParams(node.Params)
+				case node.Action == "create" && node.Entity == "record":
+					// a record set is deleted given all its values
+					params = formatParams(node.Params)
+				case node.Action == "update":
+					params = append(params, fmt.Sprintf("name=%v", node.Params["name"]), exec.Result)
+				case node.Action == "copy":
+					params = append(params, fmt.Sprintf("id=%s", exec.Result))
+				case node.Action == "create" && (node.Entity == "role" || node.Entity == "instanceprofile" || node.Entity == "keyalias"):
+					// IAM roles, instance profiles and KMS aliases are deleted by name
+					params = append(params, fmt.Sprintf("name=%v", node.Params["name"]))
+				case node.Action == "create" && node.Entity == "policy":
+					params = append(params, fmt.Sprintf("arn=%s", exec.Result))
+				case node.Action == "create":
 					params = append(params, fmt.Sprintf("id=%s", exec.Result))
+					switch node.Entity {
+					case "database":
+						params = append(params, "skipsnapshot=true")
+					case "scalinggroup":
+						params = append(params, "force=true")
+					case "accesskey":
+						if user, ok := node.Params["user"]; ok {
+							params = append(params, fmt.Sprintf("user=%v", user))
+						}
+					case "service":
+						params = append(params, fmt.Sprintf("cluster=%v", node.Params["cluster"]))
+					}
 				}
 
 				lines = append(lines, fmt.Sprintf("%s %s %s", revertAction, node.Entity, strings.Join(params, " ")))
@@ -290,6 +364,14 @@ func (te *TemplateExecution) Revert() (*Template, error) {
 				if node.Action == "create" && node.Entity == "instance" {
 					lines = append(lines, fmt.Sprintf("check instance id=%s state=terminated timeout=180", exec.Result))
 				}
+				// the elastic IP of a NAT gateway can only be released once the gateway is deleted
+				if node.Action == "create" && node.Entity == "natgateway" {
+					lines = append(lines, fmt.Sprintf("check natgateway id=%s state=deleted timeout=180", exec.Result))
+				}
+				// a launch configuration can only be deleted once the group using it is gone
+				if node.Action == "create" && node.Entity == "scalinggroup" {
+					lines = append(lines, fmt.Sprintf("check scalinggroup id=%s count=0 timeout=600", exec.Result))
+				}
 			default:
 				return nil, fmt.Errorf("cannot parse [%s] as expression node", exec.Line)
 			}
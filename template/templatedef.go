@@ -18,6 +18,9 @@ package template
 
 import (
 	"fmt"
+	"net"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -26,6 +29,7 @@ type LookupTemplateDefFunc func(key string) (TemplateDefinition, bool)
 type TemplateDefinition struct {
 	Action, Entity, Api                      string
 	RequiredParams, ExtraParams, TagsMapping []string
+	Params                                   map[string]ParamDefinition
 }
 
 func (def TemplateDefinition) Name() string {
@@ -55,3 +59,89 @@ func (def TemplateDefinition) Required() []string {
 func (def TemplateDefinition) Extra() []string {
 	return append(def.ExtraParams, def.TagsMapping...)
 }
+
+func (def TemplateDefinition) Param(name string) (ParamDefinition, bool) {
+	p, ok := def.Params[name]
+	return p, ok
+}
+
+const (
+	StringParam = "awsstr"
+	IntParam    = "awsint"
+	BoolParam   = "awsbool"
+	CidrParam   = "cidr"
+	EnumParam   = "enum"
+)
+
+// A ParamDefinition describes the values a template param accepts
+type ParamDefinition struct {
+	Type          string
+	AllowedValues []string
+	Regex         string
+	Description   string
+}
+
+func (p ParamDefinition) Validate(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	if values, ok := value.([]string); ok {
+		for _, v := range values {
+			if err := p.Validate(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	str := fmt.Sprint(value)
+	// aliases are only known once resolved against the local graph
+	if strings.HasPrefix(str, "@") {
+		return nil
+	}
+	switch p.Type {
+	case IntParam:
+		if _, isInt := value.(int); !isInt {
+			if _, err := strconv.Atoi(str); err != nil {
+				return fmt.Errorf("'%s' is not an integer", str)
+			}
+		}
+	case BoolParam:
+		if _, isBool := value.(bool); !isBool {
+			if _, err := strconv.ParseBool(str); err != nil {
+				return fmt.Errorf("'%s' is not a boolean", str)
+			}
+		}
+	case CidrParam:
+		if _, _, err := net.ParseCIDR(str); err != nil {
+			return fmt.Errorf("'%s' is not a valid CIDR", str)
+		}
+	}
+
+	if len(p.AllowedValues) > 0 && !sliceContains(str, p.AllowedValues) {
+		return fmt.Errorf("'%s' is not one of %s", str, strings.Join(p.AllowedValues, ", "))
+	}
+	if p.Regex != "" {
+		if match, err := regexp.MatchString(p.Regex, str); err != nil {
+			return err
+		} else if !match {
+			return fmt.Errorf("'%s' does not match %s", str, p.Regex)
+		}
+	}
+
+	return nil
+}
+
+func (p ParamDefinition) String() string {
+	var buff []string
+	switch {
+	case len(p.AllowedValues) > 0:
+		buff = append(buff, fmt.Sprintf("[%s]", strings.Join(p.AllowedValues, "|")))
+	case p.Type != "" && p.Type != StringParam:
+		buff = append(buff, fmt.Sprintf("[%s]", p.Type))
+	}
+	if p.Description != "" {
+		buff = append(buff, p.Description)
+	}
+	return strings.Join(buff, " ")
+}
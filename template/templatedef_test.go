@@ -17,3 +17,38 @@ func TestGetTemplateFromDef(t *testing.T) {
 		t.Fatalf("\ngot\n%q\n\nwant\n%q\n", got, want)
 	}
 }
+
+func TestValidateParamDefinition(t *testing.T) {
+	tcases := []struct {
+		def    ParamDefinition
+		value  interface{}
+		expErr string
+	}{
+		{def: ParamDefinition{Type: StringParam}, value: "anything"},
+		{def: ParamDefinition{Type: IntParam}, value: 3},
+		{def: ParamDefinition{Type: IntParam}, value: "3"},
+		{def: ParamDefinition{Type: IntParam}, value: "three", expErr: "'three' is not an integer"},
+		{def: ParamDefinition{Type: BoolParam}, value: "true"},
+		{def: ParamDefinition{Type: BoolParam}, value: "yes", expErr: "'yes' is not a boolean"},
+		{def: ParamDefinition{Type: CidrParam}, value: "10.0.0.0/16"},
+		{def: ParamDefinition{Type: CidrParam}, value: "10.0.0.0", expErr: "'10.0.0.0' is not a valid CIDR"},
+		{def: ParamDefinition{Type: EnumParam, AllowedValues: []string{"tcp", "udp"}}, value: "udp"},
+		{def: ParamDefinition{Type: EnumParam, AllowedValues: []string{"tcp", "udp"}}, value: "http", expErr: "'http' is not one of tcp, udp"},
+		{def: ParamDefinition{Type: StringParam, Regex: `^ami-`}, value: []string{"ami-12", "ami-34"}},
+		{def: ParamDefinition{Type: StringParam, Regex: `^ami-`}, value: []string{"ami-12", "i-34"}, expErr: "'i-34' does not match ^ami-"},
+		{def: ParamDefinition{Type: StringParam, Regex: `^vpc-`}, value: "@myvpc"},
+		{def: ParamDefinition{Type: StringParam, Regex: `^sg-`}, value: []string{"sg-12", "@web"}},
+	}
+
+	for i, tcase := range tcases {
+		err := tcase.def.Validate(tcase.value)
+		switch {
+		case tcase.expErr == "" && err != nil:
+			t.Fatalf("%d: unexpected error: %s", i+1, err)
+		case tcase.expErr != "" && err == nil:
+			t.Fatalf("%d: expected error, got none", i+1)
+		case tcase.expErr != "" && err.Error() != tcase.expErr:
+			t.Fatalf("%d: got %q, want %q", i+1, err, tcase.expErr)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/wallix/awless/graph"
@@ -52,6 +53,37 @@ func (v *DefinitionValidator) Execute(t *Template) (errs []error) {
 	return
 }
 
+type ParamsValidator struct {
+	LookupDef LookupTemplateDefFunc
+}
+
+func (v *ParamsValidator) Execute(t *Template) (errs []error) {
+	for _, cmd := range t.CommandNodesIterator() {
+		def, ok := v.LookupDef(fmt.Sprintf("%s%s", cmd.Action, cmd.Entity))
+		if !ok {
+			continue
+		}
+
+		var names []string
+		for p := range cmd.Params {
+			names = append(names, p)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			paramDef, ok := def.Param(name)
+			if !ok {
+				continue
+			}
+			if err := paramDef.Validate(cmd.Params[name]); err != nil {
+				errs = append(errs, fmt.Errorf("%s %s: invalid param '%s': %s", cmd.Action, cmd.Entity, name, err))
+			}
+		}
+	}
+
+	return
+}
+
 type LookupGraphFunc func(key string) (*graph.Graph, bool)
 
 type UniqueNameValidator struct {
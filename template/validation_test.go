@@ -36,6 +36,55 @@ func TestValidation(t *testing.T) {
 		}
 	})
 
+	t.Run("Validate params", func(t *testing.T) {
+		text := `create vpc cidr=10.0.0.0
+    update securitygroup id=sg-1234 cidr=0.0.0.0/0 protocol=http inbound=authorize portrange=22
+    create instance image=ami-1234 count=two type=t2.micro subnet=subnet-1234`
+
+		tpl := template.MustParse(text)
+
+		lookup := func(key string) (t template.TemplateDefinition, ok bool) {
+			t, ok = aws.AWSTemplatesDefinitions[key]
+			return
+		}
+		rule := &template.ParamsValidator{lookup}
+
+		errs := tpl.Validate(rule)
+		if got, want := len(errs), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		exps := []string{
+			"create vpc: invalid param 'cidr': '10.0.0.0' is not a valid CIDR",
+			"update securitygroup: invalid param 'protocol': 'http' does not match ^(tcp|udp|icmp|any|-1|\\d{1,3})$",
+			"create instance: invalid param 'count': 'two' is not an integer",
+		}
+		for i, exp := range exps {
+			if got, want := errs[i].Error(), exp; got != want {
+				t.Fatalf("got %q, want %q", got, want)
+			}
+		}
+	})
+
+	t.Run("Validate params with protocol numbers and aliases", func(t *testing.T) {
+		text := `update securitygroup id=sg-1234 cidr=0.0.0.0/0 protocol=6 inbound=authorize portrange=22
+    update securitygroup id=sg-1234 cidr=0.0.0.0/0 protocol=-1 outbound=revoke
+    update securitygroup id=sg-1234 cidr=0.0.0.0/0 protocol=any inbound=authorize
+    delete vpc id=@my-vpc
+    delete vpc id=vpc-12ab`
+
+		tpl := template.MustParse(text)
+
+		lookup := func(key string) (t template.TemplateDefinition, ok bool) {
+			t, ok = aws.AWSTemplatesDefinitions[key]
+			return
+		}
+		rule := &template.ParamsValidator{lookup}
+
+		if errs := tpl.Validate(rule); len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+	})
+
 	t.Run("Validate name unique", func(t *testing.T) {
 		text := "create instance name=instance1_name"
 